@@ -0,0 +1,82 @@
+/*
+ * The cli package implements the command-line interface of the tutorial.
+ *
+ * Every subcommand lives in its own file and registers itself from init(),
+ * the same way lesson packages register their lessons.
+ *
+ * Exit codes:
+ * 1. 0: Success
+ * 2. 1: The command ran but failed (e.g., an unknown lesson was asked for)
+ * 3. 2: The command line itself is wrong (unknown command or bad flags) */
+package cli
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+)
+
+const (
+	EXIT_OK      = 0
+	EXIT_FAILURE = 1
+	EXIT_USAGE   = 2
+)
+
+/*
+ * A command is one subcommand of the CLI:
+ * 1. name   : Word typed after the program name (e.g., list)
+ * 2. usage  : Arguments the command accepts, shown in the help text
+ * 3. summary: One-line description shown in the help text
+ * 4. run    : Function that runs the command and returns the exit code */
+type command struct {
+	name    string
+	usage   string
+	summary string
+	run     func(args []string, stdout, stderr io.Writer) int
+}
+
+var commands []command
+
+func register(c command) {
+	commands = append(commands, c)
+}
+
+/*
+ * Run parses the command line (without the program name) and runs the matching command.
+ * With no arguments at all, every lesson is run, which is what main.go used to do. */
+func Run(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		return runLessons([]string{"--all"}, stdout, stderr)
+	}
+
+	switch args[0] {
+	case "help", "-h", "-help", "--help":
+		printUsage(stdout)
+		return EXIT_OK
+	}
+
+	for _, c := range commands {
+		if c.name == args[0] {
+			return c.run(args[1:], stdout, stderr)
+		}
+	}
+
+	fmt.Fprintf(stderr, "unknown command %q\n\n", args[0])
+	printUsage(stderr)
+	return EXIT_USAGE
+}
+
+func printUsage(w io.Writer) {
+	fmt.Fprintln(w, "Usage: go run . <command> [arguments]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Commands:")
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for _, c := range commands {
+		fmt.Fprintf(tw, "  %s %s\t%s\n", c.name, c.usage, c.summary)
+	}
+	tw.Flush()
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Running without a command runs every lesson.")
+}
@@ -0,0 +1,70 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"math/cmplx"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/fajarstrtn/golang-tutorial/data_types"
+)
+
+func init() {
+	register(command{
+		name:    "complex",
+		usage:   "<expression>...",
+		summary: "Compute complex expressions with math/cmplx, in rectangular and polar form (e.g., 'exp(i*pi)')",
+		run:     calculateComplex,
+	})
+}
+
+/*
+ * complex computes every expression with complex64 and with complex128 values
+ * and prints both results side by side, in rectangular form (real and imaginary parts)
+ * and in polar form (modulus and phase). It exits with EXIT_FAILURE when an expression cannot be computed. */
+func calculateComplex(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprintf(stderr, "complex: give at least one expression (e.g., '(3+4i)*(1-2i)', 'abs(3+4i)', 'sqrt(-1)', 'exp(i*pi)')\n")
+		fmt.Fprintf(stderr, "functions: %s\n", strings.Join(data_types.ComplexFunctionNames(), ", "))
+		return EXIT_USAGE
+	}
+
+	code := EXIT_OK
+
+	for i, src := range args {
+		expr, err := data_types.ParseComplexExpression(src)
+		if err != nil {
+			fmt.Fprintf(stderr, "complex: %v\n", err)
+			code = EXIT_FAILURE
+			continue
+		}
+
+		if i > 0 {
+			fmt.Fprintln(stdout)
+		}
+
+		c64, c128 := expr.Complex64, expr.Complex128
+		r64, theta64 := cmplx.Polar(complex128(c64))
+		r128, theta128 := cmplx.Polar(c128)
+
+		fmt.Fprintln(stdout, src)
+		tw := tabwriter.NewWriter(stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, "  \tcomplex64\tcomplex128")
+		fmt.Fprintf(tw, "  rectangular\t%v\t%v\n", c64, c128)
+		fmt.Fprintf(tw, "  real\t%v\t%v\n", real(c64), real(c128))
+		fmt.Fprintf(tw, "  imag\t%v\t%v\n", imag(c64), imag(c128))
+		fmt.Fprintf(tw, "  modulus\t%v\t%v\n", float32(r64), r128)
+		fmt.Fprintf(tw, "  phase\t%v rad\t%v rad\n", float32(theta64), theta128)
+		fmt.Fprintf(tw, "  degrees\t%v°\t%v°\n", float32(theta64*180/math.Pi), theta128*180/math.Pi)
+		fmt.Fprintf(tw, "  polar\t%v∠%v\t%v∠%v\n", float32(r64), float32(theta64), r128, theta128)
+		tw.Flush()
+
+		if diff := cmplx.Abs(complex128(c64) - c128); diff != 0 && !math.IsNaN(diff) && !math.IsInf(diff, 0) {
+			fmt.Fprintf(stdout, "  complex64 is off by %.3g (relative error %.3g)\n", diff, diff/r128)
+		}
+	}
+
+	return code
+}
@@ -0,0 +1,103 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
+	"unicode"
+
+	"github.com/fajarstrtn/golang-tutorial/golden"
+	"github.com/fajarstrtn/golang-tutorial/lesson"
+)
+
+func init() {
+	register(command{
+		name:    "examples",
+		usage:   "",
+		summary: "Generate example_test.go files with runnable Example functions for every lesson",
+		run:     generateExamples,
+	})
+}
+
+/*
+ * examples writes one example_test.go per lesson package.
+ * Only lessons registered with an exported package-level function can become examples,
+ * the others are skipped with a note on stderr. */
+func generateExamples(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("examples", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+
+	if err := flags.Parse(args); err != nil {
+		return EXIT_USAGE
+	}
+
+	if flags.NArg() > 0 {
+		fmt.Fprintf(stderr, "examples: unexpected argument %q\n", flags.Arg(0))
+		return EXIT_USAGE
+	}
+
+	root, err := moduleRoot()
+	if err != nil {
+		fmt.Fprintf(stderr, "examples: %v\n", err)
+		return EXIT_FAILURE
+	}
+
+	var lessons []golden.ExampleLesson
+	for _, l := range lesson.All() {
+		importPath, name, ok := exportedFunc(l.Run)
+		if !ok {
+			fmt.Fprintf(stderr, "examples: skipping %s, it does not run an exported function\n", l.ID)
+			continue
+		}
+
+		lessons = append(lessons, golden.ExampleLesson{LessonID: l.ID, ImportPath: importPath, Func: name})
+	}
+
+	files, err := golden.GenerateExamples(root, lessons)
+	if err != nil {
+		fmt.Fprintf(stderr, "examples: %v\n", err)
+		return EXIT_FAILURE
+	}
+
+	for dir, src := range files {
+		file := filepath.Join(root, dir, golden.EXAMPLES_FILE)
+		if err := os.WriteFile(file, src, 0o644); err != nil {
+			fmt.Fprintf(stderr, "examples: %v\n", err)
+			return EXIT_FAILURE
+		}
+
+		fmt.Fprintf(stdout, "wrote %s\n", filepath.ToSlash(filepath.Join(dir, golden.EXAMPLES_FILE)))
+	}
+
+	return EXIT_OK
+}
+
+/*
+ * exportedFunc finds the package and name of the function behind a func value,
+ * e.g., github.com/fajarstrtn/golang-tutorial/format and PrintSomething.
+ * Closures and methods are reported as not exported. */
+func exportedFunc(fn any) (importPath, name string, ok bool) {
+	f := runtime.FuncForPC(reflect.ValueOf(fn).Pointer())
+	if f == nil {
+		return "", "", false
+	}
+
+	full := f.Name()
+	slash := strings.LastIndex(full, "/")
+	dot := strings.Index(full[slash+1:], ".")
+	if dot < 0 {
+		return "", "", false
+	}
+
+	importPath, name = full[:slash+1+dot], full[slash+1+dot+1:]
+	if strings.Contains(name, ".") || !unicode.IsUpper([]rune(name)[0]) {
+		return "", "", false
+	}
+
+	return importPath, name, true
+}
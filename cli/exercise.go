@@ -0,0 +1,204 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"text/tabwriter"
+	"time"
+
+	"github.com/fajarstrtn/golang-tutorial/exercise"
+	"github.com/fajarstrtn/golang-tutorial/progress"
+)
+
+const (
+	// Directory (relative to the working directory) where the starter files are written.
+	WORKSPACE_DIR = "workspace"
+
+	// The workspace is its own module, so the learner's code never becomes part of the tutorial's build.
+	WORKSPACE_GO_MOD = "module workspace\n\ngo " + exercise.MODULE_GO_VERSION + "\n"
+)
+
+func init() {
+	register(command{
+		name:    "exercise",
+		usage:   "list [--lesson <id|glob>] | start <id> | check <id> [--file <path>]",
+		summary: "List, start and check the exercises of the lessons",
+		run:     runExercise,
+	})
+}
+
+/*
+ * The exercise command has three subcommands:
+ * 1. list : Show the exercises, optionally only those of some lessons
+ * 2. start: Write the starter file to workspace/<id>/main.go and show the prompt
+ * 3. check: Compile, run and grade the learner's file, and record a pass in the learner's progress */
+func runExercise(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprintln(stderr, "exercise: missing subcommand (list, start or check)")
+		return EXIT_USAGE
+	}
+
+	switch args[0] {
+	case "list":
+		return listExercises(args[1:], stdout, stderr)
+	case "start":
+		return startExercise(args[1:], stdout, stderr)
+	case "check":
+		return checkExercise(args[1:], stdout, stderr)
+	}
+
+	fmt.Fprintf(stderr, "exercise: unknown subcommand %q\n", args[0])
+	return EXIT_USAGE
+}
+
+func listExercises(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("exercise list", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	lessonPattern := flags.String("lesson", "", "only list the exercises of the lessons matching this ID or glob")
+
+	if err := flags.Parse(args); err != nil {
+		return EXIT_USAGE
+	}
+
+	tw := tabwriter.NewWriter(stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "ID\tLESSON\tTITLE")
+
+	for _, e := range exercise.All() {
+		if *lessonPattern != "" {
+			ok, err := path.Match(*lessonPattern, e.Lesson)
+			if err != nil {
+				fmt.Fprintf(stderr, "exercise list: bad lesson pattern %q: %v\n", *lessonPattern, err)
+				return EXIT_USAGE
+			}
+			if !ok {
+				continue
+			}
+		}
+
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", e.ID, e.Lesson, e.Title)
+	}
+	tw.Flush()
+
+	return EXIT_OK
+}
+
+func startExercise(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("exercise start", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	force := flags.Bool("force", false, "overwrite the file if it already exists")
+
+	e, code := exerciseArg(flags, args, stderr)
+	if code != EXIT_OK {
+		return code
+	}
+
+	file := workspaceFile(e)
+	if _, err := os.Stat(file); err == nil && !*force {
+		fmt.Fprintf(stderr, "exercise start: %s already exists (use --force to start over)\n", file)
+		return EXIT_FAILURE
+	}
+
+	if err := os.MkdirAll(filepath.Dir(file), 0o755); err != nil {
+		fmt.Fprintf(stderr, "exercise start: %v\n", err)
+		return EXIT_FAILURE
+	}
+
+	goMod := filepath.Join(WORKSPACE_DIR, "go.mod")
+	if _, err := os.Stat(goMod); os.IsNotExist(err) {
+		if err := os.WriteFile(goMod, []byte(WORKSPACE_GO_MOD), 0o644); err != nil {
+			fmt.Fprintf(stderr, "exercise start: %v\n", err)
+			return EXIT_FAILURE
+		}
+	}
+
+	if err := os.WriteFile(file, []byte(e.Starter), 0o644); err != nil {
+		fmt.Fprintf(stderr, "exercise start: %v\n", err)
+		return EXIT_FAILURE
+	}
+
+	fmt.Fprintf(stdout, "%s\n\n%s\n\n", e.Title, e.Prompt)
+	fmt.Fprintf(stdout, "Edit %s, then run: go run . exercise check %s\n", file, e.ID)
+
+	return EXIT_OK
+}
+
+func checkExercise(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("exercise check", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	fileFlag := flags.String("file", "", "file to check (default workspace/<id>/main.go)")
+
+	e, code := exerciseArg(flags, args, stderr)
+	if code != EXIT_OK {
+		return code
+	}
+
+	file := *fileFlag
+	if file == "" {
+		file = workspaceFile(e)
+	}
+
+	src, err := os.ReadFile(file)
+	if err != nil {
+		fmt.Fprintf(stderr, "exercise check: %v (run \"go run . exercise start %s\" first)\n", err, e.ID)
+		return EXIT_FAILURE
+	}
+
+	result, err := exercise.Grade(e, src)
+	if err != nil {
+		fmt.Fprintf(stderr, "exercise check: %v\n", err)
+		return EXIT_FAILURE
+	}
+
+	if !result.Passed {
+		fmt.Fprintf(stdout, "FAIL %s\n", e.ID)
+		for _, feedback := range result.Feedback {
+			fmt.Fprintf(stdout, "\n%s\n", feedback)
+		}
+		return EXIT_FAILURE
+	}
+
+	recordProgress(stderr, func(store *progress.Store, now time.Time) {
+		store.MarkPassed(e.ID, now)
+	})
+
+	fmt.Fprintf(stdout, "PASS %s\n", e.ID)
+	return EXIT_OK
+}
+
+// exerciseArg parses the flags of a subcommand and looks up its single exercise ID argument.
+func exerciseArg(flags *flag.FlagSet, args []string, stderr io.Writer) (exercise.Exercise, int) {
+	/*
+	 * The flag package stops at the first argument that is not a flag,
+	 * so the ID is taken out first to allow flags on both sides of it. */
+	var id string
+	if len(args) > 0 && len(args[0]) > 0 && args[0][0] != '-' {
+		id, args = args[0], args[1:]
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return exercise.Exercise{}, EXIT_USAGE
+	}
+
+	if id == "" && flags.NArg() == 1 {
+		id = flags.Arg(0)
+	} else if flags.NArg() > 0 || id == "" {
+		fmt.Fprintf(stderr, "%s: give exactly one exercise ID\n", flags.Name())
+		return exercise.Exercise{}, EXIT_USAGE
+	}
+
+	e, ok := exercise.Lookup(id)
+	if !ok {
+		fmt.Fprintf(stderr, "%s: unknown exercise %q\n", flags.Name(), id)
+		return exercise.Exercise{}, EXIT_FAILURE
+	}
+
+	return e, EXIT_OK
+}
+
+func workspaceFile(e exercise.Exercise) string {
+	return filepath.Join(WORKSPACE_DIR, filepath.FromSlash(e.ID), "main.go")
+}
@@ -0,0 +1,82 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"text/tabwriter"
+
+	"github.com/fajarstrtn/golang-tutorial/data_types"
+)
+
+// MAX_EXACT_LENGTH is the longest exact value printed in full; tiny and huge numbers have hundreds of digits.
+const MAX_EXACT_LENGTH = 60
+
+func init() {
+	register(command{
+		name:    "float",
+		usage:   "<expression>...",
+		summary: "Break a float into IEEE 754 bits and compare float32 with float64 (e.g., '0.1 + 0.2')",
+		run:     inspectFloats,
+	})
+}
+
+/*
+ * float computes every expression with float32 and with float64 variables
+ * and prints both results side by side: value, exact decimal value, bits, neighbours and ULP.
+ * It exits with EXIT_FAILURE when an expression cannot be computed. */
+func inspectFloats(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprintln(stderr, "float: give at least one expression (e.g., 0.1, '0.1 + 0.2', -0, Inf, NaN, 5e-324)")
+		return EXIT_USAGE
+	}
+
+	code := EXIT_OK
+
+	for i, src := range args {
+		expr, err := data_types.ParseFloatExpression(src)
+		if err != nil {
+			fmt.Fprintf(stderr, "float: %v\n", err)
+			code = EXIT_FAILURE
+			continue
+		}
+
+		if i > 0 {
+			fmt.Fprintln(stdout)
+		}
+
+		f32, f64 := data_types.InspectFloat32(expr.Float32), data_types.InspectFloat64(expr.Float64)
+
+		fmt.Fprintln(stdout, src)
+		tw := tabwriter.NewWriter(stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintf(tw, "  \t%s\t%s\n", f32.Format.Name, f64.Format.Name)
+		fmt.Fprintf(tw, "  value\t%s\t%s\n", f32.Short(), f64.Short())
+		fmt.Fprintf(tw, "  exact\t%s\t%s\n", shortExact(f32), shortExact(f64))
+		fmt.Fprintf(tw, "  class\t%s\t%s\n", f32.Class, f64.Class)
+		fmt.Fprintf(tw, "  bits\t%s\t%s\n", f32.BitString(), f64.BitString())
+		fmt.Fprintf(tw, "  sign\t%d\t%d\n", f32.Sign, f64.Sign)
+		fmt.Fprintf(tw, "  exponent\t%d\t%d\n", f32.Exponent, f64.Exponent)
+		fmt.Fprintf(tw, "  mantissa\t%d\t%d\n", f32.Mantissa, f64.Mantissa)
+		fmt.Fprintf(tw, "  formula\t%s\t%s\n", f32.Formula(), f64.Formula())
+		fmt.Fprintf(tw, "  previous\t%v\t%v\n", float32(f32.Previous), f64.Previous)
+		fmt.Fprintf(tw, "  next\t%v\t%v\n", float32(f32.Next), f64.Next)
+		fmt.Fprintf(tw, "  ulp\t%v\t%v\n", f32.ULP, f64.ULP)
+		tw.Flush()
+
+		if diff := f32.Value - f64.Value; diff != 0 && !math.IsNaN(diff) && !math.IsInf(diff, 0) {
+			fmt.Fprintf(stdout, "  float32 is off by %v, that is %.2f float32 ULPs\n", diff, math.Abs(diff)/f32.ULP)
+		}
+	}
+
+	return code
+}
+
+func shortExact(i data_types.FloatInspection) string {
+	exact := i.Exact()
+	if len(exact) <= MAX_EXACT_LENGTH {
+		return exact
+	}
+
+	return new(big.Float).SetFloat64(i.Value).Text('e', 30) + " (rounded, " + fmt.Sprint(len(exact)) + " characters in full)"
+}
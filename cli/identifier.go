@@ -0,0 +1,66 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/fajarstrtn/golang-tutorial/identifier"
+)
+
+func init() {
+	register(command{
+		name:    "identifier",
+		usage:   "<name>...",
+		summary: "Check whether names are valid Go identifiers and which convention they follow",
+		run:     validateIdentifiers,
+	})
+}
+
+// identifier exits with EXIT_FAILURE when at least one name is not a valid identifier.
+func validateIdentifiers(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprintln(stderr, "identifier: give at least one name to check")
+		return EXIT_USAGE
+	}
+
+	code := EXIT_OK
+
+	for i, name := range args {
+		report := identifier.ValidateIdentifier(name)
+		if !report.Valid {
+			code = EXIT_FAILURE
+		}
+
+		if i > 0 {
+			fmt.Fprintln(stdout)
+		}
+
+		fmt.Fprintf(stdout, "%q\n", name)
+		tw := tabwriter.NewWriter(stdout, 0, 0, 1, ' ', 0)
+		fmt.Fprintf(tw, "  valid\t: %s\n", yesNo(report.Valid))
+
+		for _, problem := range report.Problems {
+			fmt.Fprintf(tw, "  problem\t: %s\n", problem)
+		}
+
+		if report.Valid {
+			fmt.Fprintf(tw, "  exported\t: %s\n", yesNo(report.Exported))
+			fmt.Fprintf(tw, "  convention\t: %s\n", report.Convention)
+		}
+
+		for _, warning := range report.Warnings {
+			fmt.Fprintf(tw, "  warning\t: %s\n", warning)
+		}
+		tw.Flush()
+	}
+
+	return code
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
@@ -0,0 +1,115 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/fajarstrtn/golang-tutorial/data_types"
+)
+
+func init() {
+	register(command{
+		name:    "layout",
+		usage:   "[-arch <GOARCH>] <struct type | file.go>...",
+		summary: "Show the size, alignment, field offsets and padding of structs, and a smaller field order",
+		run:     showLayout,
+	})
+}
+
+/*
+ * layout prints, for every struct type given (e.g., 'struct{ a bool; b int64 }')
+ * or declared in a .go file or in type declarations:
+ * 1. Its size, alignment and padding, and a table of its fields with unsafe.Offsetof, Sizeof and Alignof
+ * 2. A diagram of its memory, a letter per field byte and a dot per padding byte
+ * 3. A field order that minimises the size, when it is smaller than the current one
+ *
+ * -arch computes the layout for another architecture (e.g., 386 or arm for 32-bit systems).
+ * It exits with EXIT_FAILURE when a struct cannot be type checked. */
+func showLayout(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("layout", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	arch := flags.String("arch", "", "GOARCH to compute the layout for (default: this machine)")
+	if err := flags.Parse(args); err != nil {
+		return EXIT_USAGE
+	}
+	if flags.NArg() == 0 {
+		fmt.Fprintln(stderr, "layout: give at least one struct type or .go file (e.g., 'struct{ a bool; b int64; c bool }')")
+		return EXIT_USAGE
+	}
+
+	code := EXIT_OK
+	first := true
+
+	for _, arg := range flags.Args() {
+		src := arg
+		if strings.HasSuffix(arg, ".go") {
+			data, err := os.ReadFile(arg)
+			if err != nil {
+				fmt.Fprintf(stderr, "layout: %v\n", err)
+				code = EXIT_FAILURE
+				continue
+			}
+			src = string(data)
+		}
+
+		layouts, err := data_types.ParseStructLayouts(src, *arch)
+		if err != nil {
+			fmt.Fprintf(stderr, "layout: %v\n", err)
+			code = EXIT_FAILURE
+			continue
+		}
+
+		for _, l := range layouts {
+			if !first {
+				fmt.Fprintln(stdout)
+			}
+			first = false
+			printLayout(stdout, l)
+		}
+	}
+
+	return code
+}
+
+func printLayout(w io.Writer, l data_types.StructLayout) {
+	fmt.Fprintf(w, "%s on %s: %d bytes, align %d, %d bytes of padding\n", l.Name, l.Arch, l.Size, l.Align, l.Padding())
+	printFields(w, l)
+	fmt.Fprint(w, l.Diagram())
+
+	reordered := l.Reordered()
+	if reordered.Size >= l.Size {
+		fmt.Fprintln(w, "no other field order is smaller")
+		return
+	}
+
+	fmt.Fprintf(w, "reordered: %d bytes, %d bytes smaller\n", reordered.Size, l.Size-reordered.Size)
+	if l.Name == "struct" {
+		fmt.Fprintln(w, "  struct {")
+	} else {
+		fmt.Fprintf(w, "  type %s struct {\n", l.Name)
+	}
+	tw := tabwriter.NewWriter(w, 0, 0, 1, ' ', 0)
+	for _, f := range reordered.Fields {
+		if f.Embedded {
+			fmt.Fprintf(tw, "      %s\n", f.Type)
+			continue
+		}
+		fmt.Fprintf(tw, "      %s\t%s\n", f.Name, f.Type)
+	}
+	tw.Flush()
+	fmt.Fprintln(w, "  }")
+	fmt.Fprint(w, reordered.Diagram())
+}
+
+func printFields(w io.Writer, l data_types.StructLayout) {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "  \tfield\ttype\toffset\tsize\talign\tpadding")
+	for _, f := range l.Fields {
+		fmt.Fprintf(tw, "  %c\t%s\t%s\t%d\t%d\t%d\t%d\n", f.Symbol, f.Name, f.Type, f.Offset, f.Size, f.Align, f.Padding)
+	}
+	tw.Flush()
+}
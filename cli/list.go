@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/fajarstrtn/golang-tutorial/lesson"
+)
+
+func init() {
+	register(command{
+		name:    "list",
+		usage:   "[--topic <topic>]",
+		summary: "List the registered lessons",
+		run:     listLessons,
+	})
+}
+
+func listLessons(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("list", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	topic := flags.String("topic", "", "only list the lessons of this topic (e.g., data_types)")
+
+	if err := flags.Parse(args); err != nil {
+		return EXIT_USAGE
+	}
+
+	if flags.NArg() > 0 {
+		fmt.Fprintf(stderr, "list: unexpected argument %q\n", flags.Arg(0))
+		return EXIT_USAGE
+	}
+
+	lessons := lesson.All()
+	if *topic != "" {
+		lessons = lesson.ByTopic(*topic)
+		if len(lessons) == 0 {
+			fmt.Fprintf(stderr, "list: unknown topic %q\n", *topic)
+			return EXIT_FAILURE
+		}
+	}
+
+	tw := tabwriter.NewWriter(stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "ID\tTOPIC\tTITLE")
+	for _, l := range lessons {
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", l.ID, l.Topic, l.Title)
+	}
+	tw.Flush()
+
+	return EXIT_OK
+}
@@ -0,0 +1,169 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"math/big"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/fajarstrtn/golang-tutorial/data_types"
+)
+
+func init() {
+	register(command{
+		name:    "overflow",
+		usage:   "[<type> [<a> [<operator> <b>]]]",
+		summary: "Show integer limits, bit patterns, overflow and narrowing for your own operands",
+		run:     visualiseOverflow,
+	})
+}
+
+/*
+ * overflow shows, depending on the number of arguments:
+ * 1. Nothing            : The limits of every integer type
+ * 2. <type>             : The limits of one type and their bit patterns
+ * 3. <type> <a>         : What is left of a when it is converted to the type (narrowing)
+ * 4. <type> <a> <op> <b>: a op b computed on two variables of the type (e.g., int16 16000 '*' 120)
+ *
+ * Operands are integers in any Go notation (e.g., -5, 0xFF, 0b1010, 1_000).
+ * An unknown type, operand or operator is a usage error (EXIT_USAGE). */
+func visualiseOverflow(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		tw := tabwriter.NewWriter(stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, "type\tbits\tmin\tmax")
+		for _, t := range data_types.INT_TYPES {
+			fmt.Fprintf(tw, "%s\t%d\t%s\t%s\n", typeName(t), t.Size, t.Min(), t.Max())
+		}
+		tw.Flush()
+		return EXIT_OK
+	}
+
+	t, ok := data_types.LookupIntType(args[0])
+	if !ok {
+		fmt.Fprintf(stderr, "overflow: %q is not an integer type (run go run . overflow to list them)\n", args[0])
+		return EXIT_USAGE
+	}
+
+	operands := make([]*big.Int, 0, 2)
+	for _, arg := range []string{at(args, 1), at(args, 3)} {
+		if arg == "" {
+			continue
+		}
+		x, ok := new(big.Int).SetString(arg, 0)
+		if !ok {
+			fmt.Fprintf(stderr, "overflow: %q is not an integer\n", arg)
+			return EXIT_USAGE
+		}
+		operands = append(operands, x)
+	}
+
+	tw := tabwriter.NewWriter(stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+	var notes []string
+
+	switch len(args) {
+	case 1:
+		fmt.Fprintf(stdout, "%s: %d bits, %s to %s\n", typeName(t), t.Size, t.Min(), t.Max())
+		printBits(tw, t, "min", t.Min())
+		printBits(tw, t, "max", t.Max())
+		printBits(tw, t, "0", new(big.Int))
+		if t.Signed {
+			printBits(tw, t, "-1", big.NewInt(-1))
+		}
+
+	case 2:
+		x := operands[0]
+		fmt.Fprintf(stdout, "%s(%s)\n", t.Name, x)
+		from := smallestTypeFor(x)
+		printBits(tw, from, "value", x)
+		printBits(tw, t, t.Name, t.Wrap(x))
+		if !t.Fits(x) {
+			notes = append(notes, wrapExplanation(t, x, t.Wrap(x)))
+		}
+
+	case 4:
+		a, op, b := operands[0], args[2], operands[1]
+		if !slices.Contains(strings.Fields(data_types.INT_OPERATORS), op) {
+			fmt.Fprintf(stderr, "overflow: %q is not an operator (use one of %s)\n", op, data_types.INT_OPERATORS)
+			return EXIT_USAGE
+		}
+
+		result, err := t.Calculate(a, op, b)
+		if err != nil {
+			fmt.Fprintf(stderr, "overflow: %v\n", err)
+			return EXIT_FAILURE
+		}
+
+		fmt.Fprintf(stdout, "%s: %s %s %s\n", typeName(t), a, op, b)
+		printBits(tw, t, "a", a)
+		printBits(tw, t, "b", b)
+		if result.Panic != "" {
+			notes = append(notes, "panic: "+result.Panic)
+			break
+		}
+
+		if result.Overflow {
+			fmt.Fprintf(tw, "  exact\t%s\t\n", result.Exact)
+			notes = append(notes, fmt.Sprintf("%s does not fit in %s (%s to %s)", result.Exact, t.Name, t.Min(), t.Max()))
+			notes = append(notes, wrapExplanation(t, result.Exact, result.Result))
+		}
+		printBits(tw, t, "result", result.Result)
+
+	default:
+		fmt.Fprintln(stderr, "overflow: expected <type>, <type> <a> or <type> <a> <operator> <b>, e.g. int8 127 + 1")
+		return EXIT_USAGE
+	}
+
+	tw.Flush()
+	for _, note := range notes {
+		fmt.Fprintf(stdout, "  %s\n", note)
+	}
+
+	return EXIT_OK
+}
+
+func printBits(w io.Writer, t data_types.IntType, label string, x *big.Int) {
+	fmt.Fprintf(w, "  %s\t%s\t%s\t\n", label, x, t.Bits(x))
+}
+
+// wrapExplanation tells how many times 2^size was added or removed to wrap exact into result.
+func wrapExplanation(t data_types.IntType, exact, result *big.Int) string {
+	modulus := new(big.Int).Lsh(big.NewInt(1), t.Size)
+	times := new(big.Int).Quo(new(big.Int).Sub(exact, result), modulus)
+
+	sign := "-"
+	if times.Sign() < 0 {
+		sign = "+"
+		times.Neg(times)
+	}
+
+	return fmt.Sprintf("only the lowest %d bits are kept, so it wraps around to %s %s %s x 2^%d", t.Size, exact, sign, times, t.Size)
+}
+
+// smallestTypeFor returns the smallest sized integer type able to hold x, to show its bits before narrowing.
+func smallestTypeFor(x *big.Int) data_types.IntType {
+	for _, t := range data_types.INT_TYPES {
+		if t.Alias == "" && t.Size <= 64 && (t.Signed == (x.Sign() < 0)) && t.Fits(x) {
+			return t
+		}
+	}
+
+	// Wider than 64 bits: show every bit of the number.
+	size := uint(x.BitLen()+1+7) / 8 * 8
+	return data_types.IntType{Name: "untyped", Size: size, Signed: true}
+}
+
+func typeName(t data_types.IntType) string {
+	if t.Alias != "" {
+		return fmt.Sprintf("%s (%s)", t.Name, t.Alias)
+	}
+	return t.Name
+}
+
+func at(args []string, i int) string {
+	if i < len(args) {
+		return args[i]
+	}
+	return ""
+}
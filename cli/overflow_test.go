@@ -0,0 +1,28 @@
+package cli_test
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/fajarstrtn/golang-tutorial/cli"
+)
+
+func TestOverflowExitCodes(t *testing.T) {
+	tests := []struct {
+		args []string
+		want int
+	}{
+		{[]string{"overflow", "int8", "127", "+", "1"}, cli.EXIT_OK},
+		{[]string{"overflow", "int7"}, cli.EXIT_USAGE},
+		{[]string{"overflow", "int8", "x"}, cli.EXIT_USAGE},
+		{[]string{"overflow", "int8", "127", "^", "1"}, cli.EXIT_USAGE},
+		{[]string{"overflow", "int8", "1", "<<", "100000"}, cli.EXIT_FAILURE},
+	}
+
+	for _, tt := range tests {
+		if got := cli.Run(tt.args, io.Discard, io.Discard); got != tt.want {
+			t.Errorf("%s exited with %d, want %d", strings.Join(tt.args, " "), got, tt.want)
+		}
+	}
+}
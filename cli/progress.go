@@ -0,0 +1,162 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/fajarstrtn/golang-tutorial/exercise"
+	"github.com/fajarstrtn/golang-tutorial/lesson"
+	"github.com/fajarstrtn/golang-tutorial/progress"
+)
+
+const TIME_LAYOUT = "2006-01-02 15:04"
+
+func init() {
+	register(command{
+		name:    "progress",
+		usage:   "[--details] | reset",
+		summary: "Show which lessons were viewed and which exercises were passed",
+		run:     showProgress,
+	})
+}
+
+/*
+ * progress prints one line per topic:
+ * how many of its lessons were viewed, how many of its exercises were passed,
+ * and how complete the topic is overall. With --details, every lesson and exercise is listed. */
+func showProgress(args []string, stdout, stderr io.Writer) int {
+	if len(args) > 0 && args[0] == "reset" {
+		return resetProgress(args[1:], stdout, stderr)
+	}
+
+	flags := flag.NewFlagSet("progress", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	details := flags.Bool("details", false, "list every lesson and exercise with its timestamp")
+
+	if err := flags.Parse(args); err != nil {
+		return EXIT_USAGE
+	}
+
+	store, err := progress.OpenDefault()
+	if err != nil {
+		fmt.Fprintf(stderr, "progress: %v\n", err)
+		return EXIT_FAILURE
+	}
+
+	tw := tabwriter.NewWriter(stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "TOPIC\tLESSONS\tEXERCISES\tCOMPLETE")
+
+	var lessonsDone, lessonsAll, exercisesDone, exercisesAll int
+
+	for _, topic := range lesson.Topics() {
+		var viewed, passed, exercises int
+
+		for _, l := range lesson.ByTopic(topic) {
+			if _, ok := store.Viewed[l.ID]; ok {
+				viewed++
+			}
+
+			for _, e := range exercise.ForLesson(l.ID) {
+				exercises++
+				if _, ok := store.Passed[e.ID]; ok {
+					passed++
+				}
+			}
+		}
+
+		lessons := len(lesson.ByTopic(topic))
+		fmt.Fprintf(tw, "%s\t%d/%d\t%d/%d\t%s\n", topic, viewed, lessons, passed, exercises, percent(viewed+passed, lessons+exercises))
+
+		lessonsDone += viewed
+		lessonsAll += lessons
+		exercisesDone += passed
+		exercisesAll += exercises
+	}
+
+	fmt.Fprintf(tw, "TOTAL\t%d/%d\t%d/%d\t%s\n", lessonsDone, lessonsAll, exercisesDone, exercisesAll, percent(lessonsDone+exercisesDone, lessonsAll+exercisesAll))
+	tw.Flush()
+
+	if *details {
+		fmt.Fprintln(stdout)
+		printDetails(stdout, store)
+	}
+
+	return EXIT_OK
+}
+
+func printDetails(w io.Writer, store *progress.Store) {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	for _, l := range lesson.All() {
+		fmt.Fprintf(tw, "%s\t%s%s\n", checkbox(store.Viewed, l.ID), l.ID, timestamp("viewed", store.Viewed, l.ID))
+
+		for _, e := range exercise.ForLesson(l.ID) {
+			fmt.Fprintf(tw, "%s\t  %s%s\n", checkbox(store.Passed, e.ID), e.ID, timestamp("passed", store.Passed, e.ID))
+		}
+	}
+
+	tw.Flush()
+}
+
+func resetProgress(args []string, stdout, stderr io.Writer) int {
+	if len(args) > 0 {
+		fmt.Fprintf(stderr, "progress reset: unexpected argument %q\n", args[0])
+		return EXIT_USAGE
+	}
+
+	store, err := progress.OpenDefault()
+	if err != nil {
+		fmt.Fprintf(stderr, "progress reset: %v\n", err)
+		return EXIT_FAILURE
+	}
+
+	store.Reset()
+	if err := store.Save(); err != nil {
+		fmt.Fprintf(stderr, "progress reset: %v\n", err)
+		return EXIT_FAILURE
+	}
+
+	fmt.Fprintf(stdout, "progress in %s has been reset\n", store.Path())
+	return EXIT_OK
+}
+
+/*
+ * recordProgress opens the store, lets update change it and saves it.
+ * Progress is a convenience, so a store that cannot be read or written
+ * only prints a warning; it never makes the command itself fail. */
+func recordProgress(stderr io.Writer, update func(store *progress.Store, now time.Time)) {
+	store, err := progress.OpenDefault()
+	if err == nil {
+		update(store, time.Now())
+		err = store.Save()
+	}
+
+	if err != nil {
+		fmt.Fprintf(stderr, "warning: progress not saved: %v\n", err)
+	}
+}
+
+func checkbox(done map[string]time.Time, id string) string {
+	if _, ok := done[id]; ok {
+		return "[x]"
+	}
+	return "[ ]"
+}
+
+// timestamp returns the last column of a details line, including its leading tab, or nothing.
+func timestamp(verb string, done map[string]time.Time, id string) string {
+	if at, ok := done[id]; ok {
+		return "\t" + verb + " " + at.Local().Format(TIME_LAYOUT)
+	}
+	return ""
+}
+
+func percent(done, all int) string {
+	if all == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%d%%", done*100/all)
+}
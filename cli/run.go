@@ -0,0 +1,174 @@
+package cli
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+
+	"github.com/fajarstrtn/golang-tutorial/lesson"
+	"github.com/fajarstrtn/golang-tutorial/progress"
+)
+
+// RACE_EXIT_CODE is the exit status of a program built with -race that saw a data race (GORACE=exitcode=66 by default).
+const RACE_EXIT_CODE = 66
+
+func init() {
+	register(command{
+		name:    "run",
+		usage:   "[--deterministic] [--race] <id|glob>... | --topic <topic> | --all",
+		summary: "Run the selected lessons (e.g., run 'data_types/*')",
+		run:     runLessons,
+	})
+}
+
+/*
+ * The lessons to run can be selected in three ways:
+ * 1. By ID or glob : run format/verbs 'identifier/*'
+ * 2. By topic      : run --topic data_types
+ * 3. Everything    : run --all
+ *
+ * Asking for a lesson or topic that does not exist exits with EXIT_FAILURE
+ * before any lesson runs, so a typo never prints half of the tutorial.
+ *
+ * Lessons print to stdout and log to stderr.
+ * Lessons selected by ID, glob or topic are marked as viewed in the learner's progress.
+ * --all (also what running without a command does) records nothing:
+ * printing the whole tutorial does not mean every lesson was read, and it must not write any file.
+ * With --deterministic, log timestamps and memory addresses are replaced
+ * with fixed values (see lesson.NewDeterministicEnv).
+ * With --race, the lessons run in a copy of the tutorial built with the race detector (go build -race),
+ * with Env.Races set, so the concurrency/races lesson runs its data races and the detector reports them. */
+func runLessons(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("run", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	topic := flags.String("topic", "", "run every lesson of this topic (e.g., data_types)")
+	all := flags.Bool("all", false, "run every lesson")
+	deterministic := flags.Bool("deterministic", false, "print a fixed log timestamp and placeholder addresses, so the output is the same on every run")
+	race := flags.Bool("race", false, "build the tutorial with the race detector (go build -race) and run the lessons in it")
+
+	if err := flags.Parse(args); err != nil {
+		return EXIT_USAGE
+	}
+
+	selectors := 0
+	if *topic != "" {
+		selectors++
+	}
+	if *all {
+		selectors++
+	}
+	if flags.NArg() > 0 {
+		selectors++
+	}
+
+	if selectors != 1 {
+		fmt.Fprintln(stderr, "run: give lesson IDs or globs, --topic or --all (exactly one of them)")
+		return EXIT_USAGE
+	}
+
+	var lessons []lesson.Lesson
+	switch {
+	case *all:
+		lessons = lesson.All()
+	case *topic != "":
+		lessons = lesson.ByTopic(*topic)
+		if len(lessons) == 0 {
+			fmt.Fprintf(stderr, "run: unknown topic %q\n", *topic)
+			return EXIT_FAILURE
+		}
+	default:
+		var err error
+		lessons, err = lesson.Match(flags.Args()...)
+		if err != nil {
+			fmt.Fprintf(stderr, "run: %v\n", err)
+			return EXIT_FAILURE
+		}
+	}
+
+	if *race && !lesson.RACE_DETECTOR {
+		rerun := []string{"run", "--race"}
+		if *deterministic {
+			rerun = append(rerun, "--deterministic")
+		}
+		switch {
+		case *all:
+			rerun = append(rerun, "--all")
+		case *topic != "":
+			rerun = append(rerun, "--topic", *topic)
+		default:
+			rerun = append(rerun, flags.Args()...)
+		}
+		return runWithRaceDetector(rerun, stdout, stderr)
+	}
+
+	env := lesson.NewEnv(stdout, stderr)
+	if *deterministic {
+		env = lesson.NewDeterministicEnv(stdout, stderr)
+	}
+	env.Races = *race
+
+	for _, l := range lessons {
+		l.Run(env)
+	}
+
+	if !*all {
+		recordProgress(stderr, func(store *progress.Store, now time.Time) {
+			for _, l := range lessons {
+				store.MarkViewed(l.ID, now)
+			}
+		})
+	}
+
+	return EXIT_OK
+}
+
+/*
+ * runWithRaceDetector builds the tutorial with -race (go build -race) and runs it with the given arguments.
+ * Like verify, it needs the source code and the go tool.
+ *
+ * The race detector prints a report to stderr for every data race it sees,
+ * and makes the program exit with RACE_EXIT_CODE at the end when it saw one. */
+func runWithRaceDetector(args []string, stdout, stderr io.Writer) int {
+	root, err := moduleRoot()
+	if err != nil {
+		fmt.Fprintf(stderr, "run: %v\n", err)
+		return EXIT_FAILURE
+	}
+
+	dir, err := os.MkdirTemp("", "race")
+	if err != nil {
+		fmt.Fprintf(stderr, "run: %v\n", err)
+		return EXIT_FAILURE
+	}
+	defer os.RemoveAll(dir)
+
+	binary := filepath.Join(dir, "tutorial")
+	build := exec.Command("go", "build", "-race", "-o", binary, ".")
+	build.Dir = root
+	if out, err := build.CombinedOutput(); err != nil {
+		fmt.Fprintf(stderr, "run: go build -race: %v\n%s", err, out)
+		return EXIT_FAILURE
+	}
+
+	cmd := exec.Command(binary, args...)
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+
+	err = cmd.Run()
+	var exitErr *exec.ExitError
+	switch {
+	case err == nil:
+		return EXIT_OK
+	case errors.As(err, &exitErr) && exitErr.ExitCode() == RACE_EXIT_CODE:
+		fmt.Fprintln(stderr, "run: the race detector found data races (see the reports above)")
+		return EXIT_FAILURE
+	default:
+		fmt.Fprintf(stderr, "run: %v\n", err)
+		return EXIT_FAILURE
+	}
+}
@@ -0,0 +1,39 @@
+package cli_test
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/fajarstrtn/golang-tutorial/cli"
+	"github.com/fajarstrtn/golang-tutorial/progress"
+
+	_ "github.com/fajarstrtn/golang-tutorial/introduction"
+)
+
+// Running the whole tutorial must not write anything, while running a chosen lesson records it as viewed.
+func TestRunRecordsOnlySelectedLessons(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "progress.json")
+	t.Setenv(progress.PROGRESS_FILE_ENV, path)
+
+	for _, args := range [][]string{nil, {"run", "--all"}} {
+		if code := cli.Run(args, io.Discard, io.Discard); code != cli.EXIT_OK {
+			t.Fatalf("%q exited with %d", args, code)
+		}
+		if _, err := os.Stat(path); !os.IsNotExist(err) {
+			t.Fatalf("%q wrote the progress file (stat: %v)", args, err)
+		}
+	}
+
+	if code := cli.Run([]string{"run", "introduction/greet"}, io.Discard, io.Discard); code != cli.EXIT_OK {
+		t.Fatalf("run introduction/greet exited with %d", code)
+	}
+	store, err := progress.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := store.Viewed["introduction/greet"]; !ok {
+		t.Errorf("introduction/greet is not marked as viewed")
+	}
+}
@@ -0,0 +1,130 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/fajarstrtn/golang-tutorial/data_types"
+)
+
+// DOTTED_CIRCLE is the base that combining marks are drawn on when they are shown alone.
+const DOTTED_CIRCLE = "◌"
+
+func init() {
+	register(command{
+		name:    "utf8",
+		usage:   "<string>...",
+		summary: "Show the bytes, runes, names, widths and grapheme clusters of a string (e.g., 'é', '\"\\xff\"')",
+		run:     inspectUTF8,
+	})
+}
+
+/*
+ * utf8 prints, for every string:
+ * 1. A table with one row per rune: byte offset, bytes, code point, width, cluster, name and the rune itself
+ * 2. The grapheme clusters, with the reason their runes belong together (e.g., emoji ZWJ sequence, flag)
+ * 3. What is wrong with each byte that is not valid UTF-8
+ *
+ * A string written as a Go string literal (e.g., '"a\xffb"') is unquoted first, so any bytes can be given.
+ * It exits with EXIT_FAILURE when a string is not valid UTF-8 or a literal cannot be unquoted. */
+func inspectUTF8(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprintln(stderr, "utf8: give at least one string (e.g., 'héllo', '👩‍💻', '\"\\xff\"')")
+		return EXIT_USAGE
+	}
+
+	code := EXIT_OK
+
+	for i, arg := range args {
+		s := arg
+		if strings.HasPrefix(arg, `"`) || strings.HasPrefix(arg, "`") {
+			unquoted, err := strconv.Unquote(arg)
+			if err != nil {
+				fmt.Fprintf(stderr, "utf8: %s is not a valid Go string literal\n", arg)
+				code = EXIT_FAILURE
+				continue
+			}
+			s = unquoted
+		}
+
+		if i > 0 {
+			fmt.Fprintln(stdout)
+		}
+
+		report := data_types.InspectUTF8(s)
+		fmt.Fprintf(stdout, "%+q: %d byte(s), %d rune(s), %d grapheme cluster(s)\n", s, len(s), len(report.Runes), len(report.Graphemes))
+
+		tw := tabwriter.NewWriter(stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, "  offset\tbytes\trune\twidth\tcluster\tname\tchar")
+		for _, info := range report.Runes {
+			fmt.Fprintf(tw, "  %d\t% X\t%s\t%s\t%d\t%s\t%s\n", info.Offset, info.Bytes, codePoint(info), showWidth(info), info.Cluster, info.Name, showRune(info))
+		}
+		tw.Flush()
+
+		fmt.Fprintln(stdout, "grapheme clusters:")
+		for i, g := range report.Graphemes {
+			fmt.Fprintf(stdout, "  %d: %s %+q at byte %d, %d rune(s), width %d", i, showText(g.Text), g.Text, g.Offset, g.Runes, g.Width)
+			if g.Kind != "" {
+				fmt.Fprintf(stdout, ", %s", g.Kind)
+			}
+			fmt.Fprintln(stdout)
+		}
+
+		if !report.Valid {
+			fmt.Fprintln(stdout, "invalid UTF-8:")
+			for _, info := range report.Runes {
+				if info.Invalid {
+					fmt.Fprintf(stdout, "  byte %d: %s\n", info.Offset, info.Problem)
+				}
+			}
+			code = EXIT_FAILURE
+		}
+	}
+
+	return code
+}
+
+func codePoint(info data_types.RuneInfo) string {
+	if info.Invalid {
+		return "-"
+	}
+	return fmt.Sprintf("%U", info.Rune)
+}
+
+/*
+ * showRune returns something printable for a rune: invisible runes are escaped and marks are put on ◌.
+ * It is the last column of the table, because tabwriter counts runes, not terminal columns. */
+func showRune(info data_types.RuneInfo) string {
+	switch {
+	case info.Invalid:
+		return "�"
+	case unicode.In(info.Rune, unicode.Mn, unicode.Me, unicode.Mc):
+		return DOTTED_CIRCLE + string(info.Rune)
+	case info.Width <= 0, unicode.IsSpace(info.Rune):
+		return strings.Trim(strconv.QuoteRuneToASCII(info.Rune), "'")
+	}
+	return string(info.Rune)
+}
+
+// showText returns the text of a grapheme cluster, or a placeholder when it cannot be printed as is.
+func showText(text string) string {
+	switch {
+	case !utf8.ValidString(text):
+		return "�"
+	case strings.IndexFunc(text, unicode.IsControl) >= 0:
+		return "-"
+	}
+	return text
+}
+
+func showWidth(info data_types.RuneInfo) string {
+	if info.Invalid || info.Width < 0 {
+		return "-"
+	}
+	return strconv.Itoa(info.Width)
+}
@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/fajarstrtn/golang-tutorial/format"
+)
+
+func init() {
+	register(command{
+		name:    "verbs",
+		usage:   "<literal>...",
+		summary: "Print a Go literal with every Printf verb, flag, width and precision",
+		run:     exploreVerbs,
+	})
+}
+
+/*
+ * verbs prints one table per literal (e.g., 42, '"héllo"', 3.141, 'struct{ Name string }{"Ann"}').
+ * The outputs are wrapped in | so padding stays visible, and verbs that do not apply are marked.
+ * It exits with EXIT_FAILURE when a literal cannot be parsed. */
+func exploreVerbs(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprintln(stderr, "verbs: give at least one Go literal (e.g., 42, 3.141 or '\"héllo\"')")
+		return EXIT_USAGE
+	}
+
+	code := EXIT_OK
+
+	for i, src := range args {
+		value, err := format.ParseLiteral(src)
+		if err != nil {
+			fmt.Fprintf(stderr, "verbs: %v\n", err)
+			code = EXIT_FAILURE
+			continue
+		}
+
+		if i > 0 {
+			fmt.Fprintln(stdout)
+		}
+
+		fmt.Fprintf(stdout, "%s (%T)\n", src, value)
+		tw := tabwriter.NewWriter(stdout, 0, 0, 2, ' ', 0)
+		for _, r := range format.ExploreVerbs(value) {
+			if r.Bad {
+				fmt.Fprintf(tw, "  %s\t%s\tdoes not apply to %T\n", r.Format, r.Output, value)
+				continue
+			}
+			fmt.Fprintf(tw, "  %s\t|%s|\n", r.Format, r.Output)
+		}
+		tw.Flush()
+	}
+
+	return code
+}
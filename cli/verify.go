@@ -0,0 +1,87 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/fajarstrtn/golang-tutorial/golden"
+	"github.com/fajarstrtn/golang-tutorial/lesson"
+)
+
+func init() {
+	register(command{
+		name:    "verify",
+		usage:   "[id|glob]...",
+		summary: "Check the \"// Output:\" comments against what the lessons print",
+		run:     verifyLessons,
+	})
+}
+
+/*
+ * verify needs the source code and the go tool,
+ * so it must be run from inside the module (e.g., go run . verify). */
+func verifyLessons(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("verify", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+
+	if err := flags.Parse(args); err != nil {
+		return EXIT_USAGE
+	}
+
+	lessons := lesson.All()
+	if flags.NArg() > 0 {
+		var err error
+		lessons, err = lesson.Match(flags.Args()...)
+		if err != nil {
+			fmt.Fprintf(stderr, "verify: %v\n", err)
+			return EXIT_FAILURE
+		}
+	}
+
+	root, err := moduleRoot()
+	if err != nil {
+		fmt.Fprintf(stderr, "verify: %v\n", err)
+		return EXIT_FAILURE
+	}
+
+	var ids []string
+	for _, l := range lessons {
+		ids = append(ids, l.ID)
+	}
+
+	report, err := golden.Verify(root, ids)
+	if err != nil {
+		fmt.Fprintf(stderr, "verify: %v\n", err)
+		return EXIT_FAILURE
+	}
+
+	report.Write(stdout)
+	if !report.OK() {
+		return EXIT_FAILURE
+	}
+
+	return EXIT_OK
+}
+
+// moduleRoot walks up from the working directory to the directory holding go.mod.
+func moduleRoot() (string, error) {
+	dir, err := os.Getwd()
+	if err != nil {
+		return "", err
+	}
+
+	for {
+		if _, err := os.Stat(filepath.Join(dir, "go.mod")); err == nil {
+			return dir, nil
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", fmt.Errorf("go.mod not found; run this command from inside the module")
+		}
+		dir = parent
+	}
+}
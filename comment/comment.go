@@ -1,14 +1,18 @@
 package comment
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/fajarstrtn/golang-tutorial/lesson"
+)
 
 // This is a single-line comment.
-func ReadSingleLineComment() {
-	fmt.Println("Hello John Doe!") // Output: Hello John Doe!
+func ReadSingleLineComment(env *lesson.Env) {
+	fmt.Fprintln(env.Out, "Hello John Doe!") // Output: Hello John Doe!
 }
 
 /*
  * This is a multi-line comment. */
-func ReadMultiLineComment() {
-	fmt.Println("Hello Jakarta!") // Output: Hello Jakarta!
+func ReadMultiLineComment(env *lesson.Env) {
+	fmt.Fprintln(env.Out, "Hello Jakarta!") // Output: Hello Jakarta!
 }
@@ -0,0 +1,24 @@
+// Code generated by "go run . examples"; DO NOT EDIT.
+
+package comment_test
+
+import (
+	"os"
+
+	"github.com/fajarstrtn/golang-tutorial/comment"
+	"github.com/fajarstrtn/golang-tutorial/lesson"
+)
+
+// ExampleReadMultiLineComment runs the comment/multi-line lesson.
+func ExampleReadMultiLineComment() {
+	comment.ReadMultiLineComment(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// Hello Jakarta!
+}
+
+// ExampleReadSingleLineComment runs the comment/single-line lesson.
+func ExampleReadSingleLineComment() {
+	comment.ReadSingleLineComment(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// Hello John Doe!
+}
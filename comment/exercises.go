@@ -0,0 +1,45 @@
+package comment
+
+import "github.com/fajarstrtn/golang-tutorial/exercise"
+
+func init() {
+	exercise.Register(exercise.Exercise{
+		ID:     "comment/single-line/explain-the-line",
+		Lesson: "comment/single-line",
+		Title:  "Explain a line with a single-line comment",
+		Prompt: "Add a single-line comment (// ...) that explains what the Println call does. Do not change the output.",
+		Starter: `package main
+
+import "fmt"
+
+func main() {
+	fmt.Println("Hello John Doe!")
+}
+`,
+		Checks: []exercise.Check{
+			exercise.HasComment("//"),
+			exercise.OutputIs("Hello John Doe!"),
+		},
+	})
+
+	exercise.Register(exercise.Exercise{
+		ID:     "comment/multi-line/comment-out-code",
+		Lesson: "comment/multi-line",
+		Title:  "Comment out code with a multi-line comment",
+		Prompt: "Use one multi-line comment (/* ... */) to switch off the two lines that print Bandung and Surabaya, so only Hello Jakarta! is printed.",
+		Starter: `package main
+
+import "fmt"
+
+func main() {
+	fmt.Println("Hello Jakarta!")
+	fmt.Println("Hello Bandung!")
+	fmt.Println("Hello Surabaya!")
+}
+`,
+		Checks: []exercise.Check{
+			exercise.HasComment("/*"),
+			exercise.OutputIs("Hello Jakarta!"),
+		},
+	})
+}
@@ -0,0 +1,8 @@
+package comment
+
+import "github.com/fajarstrtn/golang-tutorial/lesson"
+
+func init() {
+	lesson.Register(lesson.Lesson{ID: "comment/single-line", Title: "Single-line comments", Topic: "comment", Order: 200, Run: ReadSingleLineComment})
+	lesson.Register(lesson.Lesson{ID: "comment/multi-line", Title: "Multi-line comments", Topic: "comment", Order: 210, Run: ReadMultiLineComment})
+}
@@ -0,0 +1,87 @@
+package composite_types
+
+import (
+	"fmt"
+	"io"
+	"unsafe"
+)
+
+func getArrays(w io.Writer) {
+	/*
+	 * There are three ways to declare an array:
+	 * 1. var a [3]int          : Every element is the zero value
+	 * 2. b := [3]int{1, 2, 3}  : With its elements
+	 * 3. c := [...]int{1, 2, 3}: The compiler counts the elements
+	 *
+	 * Elements can also be given by index; the missing ones are zero values. */
+	var a [3]int
+	b := [3]int{1, 2, 3}
+	c := [...]string{"Jakarta", "Bandung", "Surabaya", "Medan"}
+	d := [5]int{1: 10, 3: 30}
+
+	fmt.Fprintln(w, a, b, d)                 // Output: [0 0 0] [1 2 3] [0 10 0 30 0]
+	fmt.Fprintln(w, len(c), c[len(c)-1])     // Output: 4 Medan
+	fmt.Fprintf(w, "%T %T\n", b, c)          // Output: [3]int [4]string
+	fmt.Fprintln(w, unsafe.Sizeof(b))        // Output: 24
+	fmt.Fprintln(w, unsafe.Sizeof([0]int{})) // Output: 0
+
+	// Elements are read and written by index, from 0 to len - 1.
+	a[0] = 7
+	a[len(a)-1] = 9
+	fmt.Fprintln(w, a) // Output: [7 0 9]
+
+	/*
+	 * An index out of range is a compile error when the index is a constant,
+	 * and a panic at run time when it is a variable.
+	 *
+	 * Arrays can have more than one dimension: an array of arrays. */
+	var grid [2][3]int
+	grid[1][2] = 5
+	fmt.Fprintln(w, grid, len(grid), len(grid[0])) // Output: [[0 0 0] [0 0 5]] 2 3
+}
+
+func getArrayValues(w io.Writer) {
+	/*
+	 * An array is a value, not a reference: assigning it or passing it to a function
+	 * copies all of its elements. Changing the copy does not change the original. */
+	original := [3]int{1, 2, 3}
+	copied := original
+	copied[0] = 100
+	fmt.Fprintln(w, original, copied) // Output: [1 2 3] [100 2 3]
+
+	double(original)
+	fmt.Fprintln(w, original) // Output: [1 2 3]
+
+	// A pointer to the array lets a function change the original.
+	doubleInPlace(&original)
+	fmt.Fprintln(w, original) // Output: [2 4 6]
+
+	// Arrays of comparable elements are compared element by element.
+	fmt.Fprintln(w, original == [3]int{2, 4, 6}, original == copied) // Output: true false
+
+	/*
+	 * for range gives the index and a copy of each element; the array itself is copied
+	 * before the loop starts, so changing it inside the loop does not change the values seen.
+	 *
+	 * Output:
+	 * 0 2
+	 * 1 4
+	 * 2 6 */
+	for i, v := range original {
+		original[2] = 0
+		fmt.Fprintln(w, i, v)
+	}
+}
+
+// double receives a copy of the array, so the caller does not see the change.
+func double(numbers [3]int) {
+	for i := range numbers {
+		numbers[i] *= 2
+	}
+}
+
+func doubleInPlace(numbers *[3]int) {
+	for i := range numbers {
+		numbers[i] *= 2
+	}
+}
@@ -0,0 +1,60 @@
+/*
+ * Composite types are built from other types. This package covers three of them:
+ * 1. Array: A fixed number of elements of one type, stored one after the other. Its length is part of its type.
+ * 2. Slice: A window on an array (the backing array): a pointer, a length and a capacity.
+ * 3. Map  : A hash table from keys to values.
+ *
+ * An array is a value: assigning or passing it copies every element.
+ * Slices and maps are references: copying one copies the reference,
+ * so both copies see the same elements.
+ *
+ * The slice examples print the backing array of every slice, after a | for the part beyond its length,
+ * so what append, copy and slicing really do can be seen (e.g., [1 2 3 | 0] len 3 cap 4). */
+package composite_types
+
+import "github.com/fajarstrtn/golang-tutorial/lesson"
+
+/*
+ * An array has a fixed length, given in its type: [3]int and [4]int are different types.
+ * Its elements are zero values until they are set,
+ * and arrays of comparable elements can be compared with ==. */
+func GenerateArrays(env *lesson.Env) {
+	getArrays(env.Out)
+	getArrayValues(env.Out)
+}
+
+/*
+ * A slice describes a part of a backing array:
+ * 1. Length  : The number of elements it shows, len(s)
+ * 2. Capacity: The number of elements from its start to the end of the backing array, cap(s)
+ *
+ * Slicing, append and copy all work on the backing array, so two slices can share elements.
+ * This lesson looks at:
+ * 1. Length and capacity, and slicing
+ * 2. append: when it writes into the backing array, and when it allocates a new one
+ * 3. copy
+ * 4. Full slice expressions (s[low:high:max]), which limit the capacity
+ * 5. nil and empty slices */
+func GenerateSlices(env *lesson.Env) {
+	getSliceBasics(env.Out)
+	getAppend(env.Out)
+	getCopy(env.Out)
+	getFullSliceExpressions(env.Out)
+	getNilAndEmptySlices(env.Out)
+}
+
+/*
+ * A map stores values by key. Any comparable type can be a key (e.g., string, int, a struct of them),
+ * but not a slice, a map or a function.
+ *
+ * This lesson looks at:
+ * 1. Reading, writing, deleting, and telling a missing key from a zero value
+ * 2. Iteration order, which is random on purpose
+ * 3. Deleting and adding while ranging over a map
+ * 4. nil and empty maps */
+func GenerateMaps(env *lesson.Env) {
+	getMaps(env.Out)
+	getMapIteration(env.Out)
+	getMapDeletionDuringRange(env.Out)
+	getNilAndEmptyMaps(env.Out)
+}
@@ -0,0 +1,100 @@
+// Code generated by "go run . examples"; DO NOT EDIT.
+
+package composite_types_test
+
+import (
+	"os"
+
+	"github.com/fajarstrtn/golang-tutorial/composite_types"
+	"github.com/fajarstrtn/golang-tutorial/lesson"
+)
+
+// ExampleGenerateArrays runs the composite_types/arrays lesson.
+func ExampleGenerateArrays() {
+	composite_types.GenerateArrays(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// [0 0 0] [1 2 3] [0 10 0 30 0]
+	// 4 Medan
+	// [3]int [4]string
+	// 24
+	// 0
+	// [7 0 9]
+	// [[0 0 0] [0 0 5]] 2 3
+	// [1 2 3] [100 2 3]
+	// [1 2 3]
+	// [2 4 6]
+	// true false
+	// 0 2
+	// 1 4
+	// 2 6
+}
+
+// ExampleGenerateMaps runs the composite_types/maps lesson.
+func ExampleGenerateMaps() {
+	composite_types.GenerateMaps(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// 3 10679951
+	// 0 0
+	// true false
+	// 2 1 0
+	// 2
+	// 3 2
+	// true
+	// 1 one
+	// 2 two
+	// 3 three
+	// map[a:1 b:2 c:3]
+	// map[apple:3 durian:5]
+	// map[apple:5 banana:2]
+	// [1 2 10 20]
+	// true false
+	// 0 0
+	// map[string]int(nil) map[string]int{}
+	// assignment to entry in nil map
+	// <nil>
+	// map[answer:42]
+}
+
+// ExampleGenerateSlices runs the composite_types/slices lesson.
+func ExampleGenerateSlices() {
+	composite_types.GenerateSlices(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// s [10 20 30 | 40 50] len 3 cap 5
+	// [0 11 20 30 40 50]
+	// t [20 | 30 40 50] len 1 cap 4
+	// t [20 30 40 50] len 4 cap 4
+	// true
+	// made [0 0 0 | 0 0] len 3 cap 5
+	// literal [a b c] len 3 cap 3
+	// len 2 cap 2
+	// len 3 cap 4
+	// len 5 cap 8
+	// len 9 cap 16
+	// base [0 0 0 | 2] len 3 cap 4
+	// a [0 0 0 2] len 4 cap 4
+	// b [0 0 0 2] len 4 cap 4
+	// true
+	// a [0 0 0 2] len 4 cap 4
+	// c [99 0 0 2 3 | 0 0 0] len 5 cap 8
+	// false
+	// scores [0 0 | 7] len 2 cap 3
+	// [0 0 7]
+	// scores [0 0 8] len 3 cap 3
+	// 3 [1 2 3]
+	// [2 3 4 5 5]
+	// 4 [104 195 169 108] hé
+	// [100 2 3] [1 2 3] [1 2 3]
+	// false
+	// unlimited [1 2 | 3 4 5] len 2 cap 5
+	// limited [1 2] len 2 cap 2
+	// [1 2 3 4 5] false
+	// [1 2 100 4 5] true
+	// middle [2 100 | 4] len 2 cap 3
+	// true false
+	// 0 0 0
+	// [] []
+	// []int(nil) []int{}
+	// false true
+	// null []
+	// [1] false
+}
@@ -0,0 +1,81 @@
+package composite_types
+
+import "github.com/fajarstrtn/golang-tutorial/exercise"
+
+func init() {
+	exercise.Register(exercise.Exercise{
+		ID:     "composite_types/arrays/double-in-place",
+		Lesson: "composite_types/arrays",
+		Title:  "Change an array in a function",
+		Prompt: "double receives a copy of the array, so main still prints [1 2 3]. Change double to take a pointer to the array (*[3]int) so the program prints: [2 4 6]",
+		Starter: `package main
+
+import "fmt"
+
+func double(numbers [3]int) {
+	for i := range numbers {
+		numbers[i] *= 2
+	}
+}
+
+func main() {
+	numbers := [3]int{1, 2, 3}
+	double(numbers)
+	fmt.Println(numbers)
+}
+`,
+		Checks: []exercise.Check{
+			exercise.Calls("double", 1),
+			exercise.OutputIs("[2 4 6]"),
+		},
+	})
+
+	exercise.Register(exercise.Exercise{
+		ID:     "composite_types/slices/limit-capacity",
+		Lesson: "composite_types/slices",
+		Title:  "Append without overwriting the backing array",
+		Prompt: "Appending to head writes 100 into the backing array of base. Use a full slice expression for head, so append has to allocate a new array. The output must be: [1 2 3 4 5] [1 2 100]",
+		Starter: `package main
+
+import "fmt"
+
+func main() {
+	base := []int{1, 2, 3, 4, 5}
+	head := base[0:2]
+	head = append(head, 100)
+
+	fmt.Println(base, head)
+}
+`,
+		Checks: []exercise.Check{
+			exercise.Calls("append", 1),
+			exercise.OutputIs("[1 2 3 4 5] [1 2 100]"),
+		},
+	})
+
+	exercise.Register(exercise.Exercise{
+		ID:     "composite_types/maps/comma-ok",
+		Lesson: "composite_types/maps",
+		Title:  "Tell a missing key from a zero value",
+		Prompt: "The starter treats every zero value as a missing key. Use the comma ok form (count, ok := stock[fruit]) so only the keys that are not in the map print missing. The output must be:\napple 0\nbanana 4\ncherry missing",
+		Starter: `package main
+
+import "fmt"
+
+func main() {
+	stock := map[string]int{"apple": 0, "banana": 4}
+
+	for _, fruit := range []string{"apple", "banana", "cherry"} {
+		if stock[fruit] == 0 {
+			fmt.Println(fruit, "missing")
+		} else {
+			fmt.Println(fruit, stock[fruit])
+		}
+	}
+}
+`,
+		Checks: []exercise.Check{
+			exercise.OutputIs("apple 0\nbanana 4\ncherry missing"),
+		},
+	})
+}
@@ -0,0 +1,9 @@
+package composite_types
+
+import "github.com/fajarstrtn/golang-tutorial/lesson"
+
+func init() {
+	lesson.Register(lesson.Lesson{ID: "composite_types/arrays", Title: "Arrays", Topic: "composite_types", Order: 600, Run: GenerateArrays})
+	lesson.Register(lesson.Lesson{ID: "composite_types/slices", Title: "Slices: length, capacity, append and copy", Topic: "composite_types", Order: 610, Run: GenerateSlices})
+	lesson.Register(lesson.Lesson{ID: "composite_types/maps", Title: "Maps", Topic: "composite_types", Order: 620, Run: GenerateMaps})
+}
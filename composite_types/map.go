@@ -0,0 +1,141 @@
+package composite_types
+
+import (
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+)
+
+func getMaps(w io.Writer) {
+	/*
+	 * A map literal gives the first keys and values; make(map[K]V) creates an empty map.
+	 * m[key] = value adds or replaces, and len(m) is the number of keys. */
+	population := map[string]int{
+		"Jakarta":  10_562_088,
+		"Surabaya": 2_874_314,
+	}
+	population["Bandung"] = 2_444_160
+	population["Jakarta"] = 10_679_951
+	fmt.Fprintln(w, len(population), population["Jakarta"]) // Output: 3 10679951
+
+	/*
+	 * Reading a missing key gives the zero value of the value type, not an error.
+	 * The second result (comma ok) tells a missing key from a key whose value is the zero value. */
+	visits := map[string]int{"Medan": 0}
+	fmt.Fprintln(w, visits["Medan"], visits["Bogor"]) // Output: 0 0
+
+	_, okMedan := visits["Medan"]
+	_, okBogor := visits["Bogor"]
+	fmt.Fprintln(w, okMedan, okBogor) // Output: true false
+
+	// The zero value makes counting simple: a missing key starts at 0.
+	words := map[string]int{}
+	for _, word := range strings.Fields("go is fun and go is fast") {
+		words[word]++
+	}
+	fmt.Fprintln(w, words["go"], words["fun"], words["slow"]) // Output: 2 1 0
+
+	// delete removes a key; deleting a missing key does nothing.
+	delete(population, "Surabaya")
+	delete(population, "Semarang")
+	fmt.Fprintln(w, len(population)) // Output: 2
+
+	/*
+	 * A map is a reference: a copy of the variable (or a parameter) is the same map.
+	 * maps.Clone makes an independent copy. */
+	alias := population
+	cloned := maps.Clone(population)
+	alias["Medan"] = 2_435_252
+	fmt.Fprintln(w, len(population), len(cloned)) // Output: 3 2
+}
+
+func getMapIteration(w io.Writer) {
+	/*
+	 * The order of for range over a map is not specified, and the runtime picks
+	 * a random starting point on purpose, so programs cannot depend on it.
+	 * Ranging over the same map 100 times gives more than one order. */
+	digits := map[int]string{1: "one", 2: "two", 3: "three", 4: "four", 5: "five", 6: "six", 7: "seven", 8: "eight"}
+
+	orders := make(map[string]bool)
+	for range 100 {
+		var order []string
+		for key := range digits {
+			order = append(order, fmt.Sprint(key))
+		}
+		orders[strings.Join(order, " ")] = true
+	}
+	fmt.Fprintln(w, len(orders) > 1) // Output: true
+
+	/*
+	 * To get a stable order, sort the keys and range over them instead.
+	 *
+	 * Output:
+	 * 1 one
+	 * 2 two
+	 * 3 three */
+	for _, key := range slices.Sorted(maps.Keys(digits))[:3] {
+		fmt.Fprintln(w, key, digits[key])
+	}
+
+	// The fmt package prints maps with their keys sorted, so printing a map is stable.
+	fmt.Fprintln(w, map[string]int{"b": 2, "c": 3, "a": 1}) // Output: map[a:1 b:2 c:3]
+}
+
+func getMapDeletionDuringRange(w io.Writer) {
+	/*
+	 * Deleting keys while ranging over a map is safe:
+	 * a key deleted before the loop reaches it is not produced. */
+	stock := map[string]int{"apple": 3, "banana": 0, "cherry": 0, "durian": 5}
+	for fruit, count := range stock {
+		if count == 0 {
+			delete(stock, fruit)
+		}
+	}
+	fmt.Fprintln(w, stock) // Output: map[apple:3 durian:5]
+
+	// maps.DeleteFunc does the same in one call.
+	prices := map[string]int{"apple": 5, "banana": 2, "cherry": 12}
+	maps.DeleteFunc(prices, func(fruit string, price int) bool { return price > 10 })
+	fmt.Fprintln(w, prices) // Output: map[apple:5 banana:2]
+
+	/*
+	 * Adding keys while ranging is allowed too, but a key added during the loop
+	 * may or may not be produced by it: the result can change from run to run.
+	 * Collect the new keys first and add them after the loop. */
+	doubled := map[int]bool{1: true, 2: true}
+	var added []int
+	for key := range doubled {
+		added = append(added, key*10)
+	}
+	for _, key := range added {
+		doubled[key] = true
+	}
+	fmt.Fprintln(w, slices.Sorted(maps.Keys(doubled))) // Output: [1 2 10 20]
+}
+
+func getNilAndEmptyMaps(w io.Writer) {
+	/*
+	 * A map that was never set is nil. Reading a nil map, len and range work like an empty map,
+	 * but writing to it panics: a map must be made (make or a literal) before it is written. */
+	var nilMap map[string]int
+	empty := map[string]int{}
+
+	fmt.Fprintln(w, nilMap == nil, empty == nil)      // Output: true false
+	fmt.Fprintln(w, len(nilMap), nilMap["missing"])   // Output: 0 0
+	fmt.Fprintf(w, "%#v %#v\n", nilMap, empty)        // Output: map[string]int(nil) map[string]int{}
+	fmt.Fprintln(w, writeToMap(nilMap, "answer", 42)) // Output: assignment to entry in nil map
+	fmt.Fprintln(w, writeToMap(empty, "answer", 42))  // Output: <nil>
+	fmt.Fprintln(w, empty)                            // Output: map[answer:42]
+}
+
+// writeToMap writes a key and returns the panic it caused, if any (a nil map panics).
+func writeToMap(m map[string]int, key string, value int) (err any) {
+	defer func() {
+		err = recover()
+	}()
+
+	m[key] = value
+	return nil
+}
@@ -0,0 +1,223 @@
+package composite_types
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"slices"
+	"strings"
+	"unsafe"
+)
+
+func getSliceBasics(w io.Writer) {
+	/*
+	 * a[low:high] makes a slice of the elements low to high - 1 of an array (or of another slice).
+	 * It does not copy anything: the slice points into the same backing array,
+	 * so writing through the slice writes into the array.
+	 *
+	 * printSlice shows the whole backing array a slice can reach: its elements,
+	 * then after the | the elements between its length and its capacity. */
+	numbers := [6]int{0, 10, 20, 30, 40, 50}
+	s := numbers[1:4]
+	printSlice(w, "s", s) // Output: s [10 20 30 | 40 50] len 3 cap 5
+
+	s[0] = 11
+	fmt.Fprintln(w, numbers) // Output: [0 11 20 30 40 50]
+
+	/*
+	 * The capacity counts from the start of the slice to the end of the backing array,
+	 * so a slice can be resliced beyond its length, up to its capacity (never beyond). */
+	t := s[1:2]
+	printSlice(w, "t", t) // Output: t [20 | 30 40 50] len 1 cap 4
+
+	t = t[:cap(t)]
+	printSlice(w, "t", t)                    // Output: t [20 30 40 50] len 4 cap 4
+	fmt.Fprintln(w, shareBackingArray(s, t)) // Output: true
+
+	/*
+	 * make creates a backing array and a slice of it in one step: make([]T, length, capacity).
+	 * A slice literal ([]int{...}) does the same with its elements, with the capacity equal to the length. */
+	made := make([]int, 3, 5)
+	printSlice(w, "made", made) // Output: made [0 0 0 | 0 0] len 3 cap 5
+
+	literal := []string{"a", "b", "c"}
+	printSlice(w, "literal", literal) // Output: literal [a b c] len 3 cap 3
+}
+
+func getAppend(w io.Writer) {
+	grow := make([]int, 0, 1)
+
+	/*
+	 * append adds elements at the end of a slice and returns the new slice:
+	 * 1. When the capacity is large enough, it writes into the same backing array
+	 * 2. Otherwise it allocates a larger array (about twice as large for small slices),
+	 *    copies the elements there, and the new slice points to it
+	 *
+	 * The loop starts with a capacity of 1 and prints every time append allocates.
+	 * The exact growth is up to the runtime and can change between Go versions.
+	 *
+	 * Output:
+	 * len 2 cap 2
+	 * len 3 cap 4
+	 * len 5 cap 8
+	 * len 9 cap 16 */
+	for i := range 9 {
+		before := cap(grow)
+		grow = append(grow, i)
+		if cap(grow) != before {
+			fmt.Fprintf(w, "len %d cap %d\n", len(grow), cap(grow))
+		}
+	}
+
+	/*
+	 * Two appends to the same slice can write into the same backing array (aliasing):
+	 * base has room for one more element, so a and b both put their element at index 3,
+	 * and b overwrites the element of a. */
+	base := make([]int, 3, 4)
+	a := append(base, 1)
+	b := append(base, 2)
+	printSlice(w, "base", base)              // Output: base [0 0 0 | 2] len 3 cap 4
+	printSlice(w, "a", a)                    // Output: a [0 0 0 2] len 4 cap 4
+	printSlice(w, "b", b)                    // Output: b [0 0 0 2] len 4 cap 4
+	fmt.Fprintln(w, shareBackingArray(a, b)) // Output: true
+
+	// a is full, so appending to it allocates a new backing array: c no longer shares with a.
+	c := append(a, 3)
+	c[0] = 99
+	printSlice(w, "a", a)                    // Output: a [0 0 0 2] len 4 cap 4
+	printSlice(w, "c", c)                    // Output: c [99 0 0 2 3 | 0 0 0] len 5 cap 8
+	fmt.Fprintln(w, shareBackingArray(a, c)) // Output: false
+
+	/*
+	 * So always assign the result of append to the slice it was called on (s = append(s, x)).
+	 * A function that appends to a slice parameter changes its own copy of the length, not the caller's:
+	 * the caller only sees the element if there was room in the shared backing array, and only by reslicing. */
+	scores := make([]int, 2, 3)
+	addScore(scores, 7)
+	printSlice(w, "scores", scores) // Output: scores [0 0 | 7] len 2 cap 3
+	fmt.Fprintln(w, scores[:3])     // Output: [0 0 7]
+
+	scores = addScoreAndReturn(scores, 8)
+	printSlice(w, "scores", scores) // Output: scores [0 0 8] len 3 cap 3
+}
+
+// addScore appends to its own copy of the slice header: the caller's length stays the same.
+func addScore(scores []int, score int) {
+	scores = append(scores, score)
+}
+
+// addScoreAndReturn returns the new slice, like append does, so the caller can keep it.
+func addScoreAndReturn(scores []int, score int) []int {
+	return append(scores, score)
+}
+
+func getCopy(w io.Writer) {
+	/*
+	 * copy(dst, src) copies elements from src into the existing elements of dst,
+	 * as many as the shorter of the two has, and returns that number.
+	 * It never changes the length of dst and never allocates. */
+	src := []int{1, 2, 3, 4, 5}
+	dst := make([]int, 3)
+	n := copy(dst, src)
+	fmt.Fprintln(w, n, dst) // Output: 3 [1 2 3]
+
+	// copy works even when the two slices overlap (e.g., to shift elements to the left).
+	copy(src, src[1:])
+	fmt.Fprintln(w, src) // Output: [2 3 4 5 5]
+
+	// A string can be copied into a byte slice.
+	buffer := make([]byte, 4)
+	n = copy(buffer, "héllo")
+	fmt.Fprintln(w, n, buffer, string(buffer[:3])) // Output: 4 [104 195 169 108] hé
+
+	/*
+	 * To get an independent slice, copy it into a new backing array:
+	 * slices.Clone, or append to a nil slice. The clone does not see later changes to the original. */
+	original := []int{1, 2, 3}
+	cloned := slices.Clone(original)
+	appended := append([]int(nil), original...)
+	original[0] = 100
+	fmt.Fprintln(w, original, cloned, appended)          // Output: [100 2 3] [1 2 3] [1 2 3]
+	fmt.Fprintln(w, shareBackingArray(original, cloned)) // Output: false
+}
+
+func getFullSliceExpressions(w io.Writer) {
+	/*
+	 * A full slice expression a[low:high:max] also sets the capacity, to max - low.
+	 * With no room left, the next append has to allocate a new backing array,
+	 * so it can never overwrite the elements after high. */
+	backing := []int{1, 2, 3, 4, 5}
+	unlimited := backing[0:2]
+	limited := backing[0:2:2]
+	printSlice(w, "unlimited", unlimited) // Output: unlimited [1 2 | 3 4 5] len 2 cap 5
+	printSlice(w, "limited", limited)     // Output: limited [1 2] len 2 cap 2
+
+	limited = append(limited, 100)
+	fmt.Fprintln(w, backing, shareBackingArray(backing, limited)) // Output: [1 2 3 4 5] false
+
+	unlimited = append(unlimited, 100)
+	fmt.Fprintln(w, backing, shareBackingArray(backing, unlimited)) // Output: [1 2 100 4 5] true
+
+	// The capacity can be limited but never extended: max must not be larger than the capacity.
+	middle := backing[1:3:4]
+	printSlice(w, "middle", middle) // Output: middle [2 100 | 4] len 2 cap 3
+}
+
+func getNilAndEmptySlices(w io.Writer) {
+	/*
+	 * A slice that was never set is nil: it has no backing array.
+	 * An empty slice ([]int{} or make([]int, 0)) has a backing array, of length 0.
+	 *
+	 * Both have length 0 and capacity 0, range over nothing, and work with append,
+	 * so most code does not need to tell them apart (check len(s) == 0, not s == nil).
+	 * They differ in a few places: == nil, %#v, reflect.DeepEqual and encoding/json. */
+	var nilSlice []int
+	empty := []int{}
+
+	fmt.Fprintln(w, nilSlice == nil, empty == nil)            // Output: true false
+	fmt.Fprintln(w, len(nilSlice), cap(nilSlice), len(empty)) // Output: 0 0 0
+	fmt.Fprintln(w, nilSlice, empty)                          // Output: [] []
+	fmt.Fprintf(w, "%#v %#v\n", nilSlice, empty)              // Output: []int(nil) []int{}
+
+	fmt.Fprintln(w, reflect.DeepEqual(nilSlice, empty), slices.Equal(nilSlice, empty)) // Output: false true
+
+	nilJSON, _ := json.Marshal(nilSlice)
+	emptyJSON, _ := json.Marshal(empty)
+	fmt.Fprintln(w, string(nilJSON), string(emptyJSON)) // Output: null []
+
+	nilSlice = append(nilSlice, 1)
+	fmt.Fprintln(w, nilSlice, nilSlice == nil) // Output: [1] false
+}
+
+/*
+ * printSlice prints a slice with the whole backing array it can reach:
+ * its elements, then after a | the elements between its length and its capacity. */
+func printSlice[T any](w io.Writer, name string, s []T) {
+	var sb strings.Builder
+	for i, v := range s[:cap(s)] {
+		switch {
+		case i == len(s):
+			sb.WriteString(" | ")
+		case i > 0:
+			sb.WriteByte(' ')
+		}
+		fmt.Fprint(&sb, v)
+	}
+
+	fmt.Fprintf(w, "%s [%s] len %d cap %d\n", name, sb.String(), len(s), cap(s))
+}
+
+// shareBackingArray reports whether two slices can reach the same element of one backing array.
+func shareBackingArray[T any](a, b []T) bool {
+	if cap(a) == 0 || cap(b) == 0 {
+		return false
+	}
+
+	var element T
+	size := unsafe.Sizeof(element)
+	startA, startB := uintptr(unsafe.Pointer(unsafe.SliceData(a))), uintptr(unsafe.Pointer(unsafe.SliceData(b)))
+	endA, endB := startA+uintptr(cap(a))*size, startB+uintptr(cap(b))*size
+
+	return startA < endB && startB < endA
+}
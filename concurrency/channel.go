@@ -0,0 +1,120 @@
+package concurrency
+
+import (
+	"fmt"
+	"io"
+)
+
+func getUnbufferedChannels(w io.Writer) {
+	/*
+	 * make(chan T) makes an unbuffered channel: a send (ch <- v) waits until another goroutine receives (<-ch),
+	 * and a receive waits until another goroutine sends. The two goroutines meet at the channel,
+	 * so everything the sender did before the send is visible to the receiver after the receive. */
+	ch := make(chan int)
+
+	var steps []string
+	go func() {
+		steps = append(steps, "sending")
+		ch <- 42
+	}()
+
+	v := <-ch
+	steps = append(steps, fmt.Sprint("received ", v))
+	fmt.Fprintln(w, steps) // Output: [sending received 42]
+
+	/*
+	 * A send or receive that no other goroutine will ever match blocks forever.
+	 * When every goroutine is blocked, the runtime stops the program with
+	 * "fatal error: all goroutines are asleep - deadlock!", which cannot be recovered.
+	 * So an unbuffered send in the same goroutine as its receive (ch <- 1; <-ch) always deadlocks. */
+}
+
+func getBufferedChannels(w io.Writer) {
+	/*
+	 * make(chan T, n) makes a channel with a buffer of n values: a send waits only when the buffer is full,
+	 * and a receive only when it is empty. len is the number of values in the buffer, cap its size. */
+	jobs := make(chan string, 3)
+	jobs <- "build"
+	jobs <- "test"
+	fmt.Fprintln(w, len(jobs), cap(jobs)) // Output: 2 3
+
+	first := <-jobs
+	fmt.Fprintln(w, first, len(jobs)) // Output: build 1
+
+	/*
+	 * close(ch) says that no more values will be sent. The receivers still get the values in the buffer;
+	 * after them, a receive returns the zero value at once, and the comma ok form tells it apart (ok is false).
+	 * for range over a channel receives until it is closed and empty. */
+	jobs <- "deploy"
+	close(jobs)
+
+	var remaining []string
+	for job := range jobs {
+		remaining = append(remaining, job)
+	}
+	fmt.Fprintln(w, remaining) // Output: [test deploy]
+
+	job, ok := <-jobs
+	fmt.Fprintf(w, "%q %v\n", job, ok) // Output: "" false
+
+	/*
+	 * Only the sender closes a channel, once: sending on a closed channel panics,
+	 * and so does closing it again. A receiver never closes it, since a sender could still be sending. */
+	panicked := recovered(func() { jobs <- "release" })
+	fmt.Fprintln(w, panicked) // Output: send on closed channel
+
+	panicked = recovered(func() { close(jobs) })
+	fmt.Fprintln(w, panicked) // Output: close of closed channel
+}
+
+// recovered calls f and returns the value it panicked with, or nil.
+func recovered(f func()) (value any) {
+	defer func() {
+		value = recover()
+	}()
+
+	f()
+	return nil
+}
+
+/*
+ * generate sends the numbers from 1 to n, then closes the channel.
+ * Its result is a receive-only channel (<-chan int): the caller can receive from it,
+ * but sending on it or closing it does not compile. */
+func generate(n int) <-chan int {
+	out := make(chan int)
+	go func() {
+		defer close(out)
+		for i := 1; i <= n; i++ {
+			out <- i
+		}
+	}()
+	return out
+}
+
+// square receives numbers until in is closed, and sends their squares on the channel it returns.
+func square(in <-chan int) <-chan int {
+	out := make(chan int)
+	go func() {
+		defer close(out)
+		for n := range in {
+			out <- n * n
+		}
+	}()
+	return out
+}
+
+func getPipeline(w io.Writer) {
+	/*
+	 * A pipeline is a chain of stages, each one a goroutine that receives from the previous stage
+	 * and sends to the next. Each stage closes its channel when it is done, so the end of the input
+	 * flows through the whole chain and every range loop ends.
+	 *
+	 * The channel types give the direction: chan<- int can only send, <-chan int can only receive.
+	 * A chan int converts to either one, never the other way around. */
+	var results []int
+	for n := range square(generate(5)) {
+		results = append(results, n)
+	}
+	fmt.Fprintln(w, results) // Output: [1 4 9 16 25]
+}
@@ -0,0 +1,76 @@
+/*
+ * Concurrency is doing several things at the same time, or at least structuring a program as if it did.
+ * Go has it in the language:
+ * 1. go f()   : Runs f in a new goroutine, a function running at the same time as the caller
+ * 2. chan T   : A channel, which goroutines use to send values of type T to each other
+ * 3. select   : Waits on several channel operations at once (see also control_flow/select)
+ *
+ * and the sync and sync/atomic packages add locks, wait groups and atomic values.
+ *
+ * "Do not communicate by sharing memory; instead, share memory by communicating":
+ * when two goroutines use the same variable without a channel or a lock, the program has a data race,
+ * and its behavior is undefined. The concurrency/races lesson shows some, for the race detector to report.
+ *
+ * The goroutines of the examples run in any order, so they never print directly:
+ * they put their results in their own element of a slice, or send them on a channel,
+ * and the output is printed in an order that does not depend on the scheduler. */
+package concurrency
+
+import "github.com/fajarstrtn/golang-tutorial/lesson"
+
+/*
+ * A goroutine is started with the go keyword and ends when its function returns.
+ * Nothing waits for it: the caller uses a channel or a sync.WaitGroup to wait. */
+func GenerateGoroutines(env *lesson.Env) {
+	getGoroutines(env.Out)
+	getWaitGroup(env.Out)
+}
+
+/*
+ * A channel sends values from one goroutine to another:
+ * 1. Unbuffered (make(chan T))   : A send waits for a receiver, so the two goroutines meet
+ * 2. Buffered (make(chan T, n))  : A send waits only when the n places of the buffer are full
+ *
+ * close tells the receivers that no more values will come, and range receives until then. */
+func GenerateChannels(env *lesson.Env) {
+	getUnbufferedChannels(env.Out)
+	getBufferedChannels(env.Out)
+	getPipeline(env.Out)
+}
+
+/*
+ * select waits for the first of several channel operations.
+ * With time.After or a context, one of them is a timeout, so a slow operation cannot block forever. */
+func GenerateSelect(env *lesson.Env) {
+	getTimeouts(env.Out)
+	getContextTimeout(env.Out)
+}
+
+/*
+ * The sync package has the tools for goroutines that share memory:
+ * 1. Mutex and RWMutex: One goroutine at a time (or many readers) in a piece of code
+ * 2. Once             : A function that runs only once, whoever calls it first
+ * 3. WaitGroup        : Waiting for a group of goroutines
+ *
+ * sync/atomic has counters and flags that need no lock. */
+func GenerateSync(env *lesson.Env) {
+	getMutex(env.Out)
+	getAtomic(env.Out)
+	getOnce(env.Out)
+}
+
+/*
+ * Fan-out runs independent tasks in goroutines, and fan-in collects their results.
+ * A Group, like golang.org/x/sync/errgroup, does both: it waits for the tasks,
+ * returns the first error, cancels the others, and can limit how many run at once. */
+func GenerateFanOut(env *lesson.Env) {
+	getFanOut(env.Out)
+	getFirstError(env.Out)
+}
+
+/*
+ * A data race is two goroutines using the same memory at the same time, with at least one write,
+ * and nothing to order them. The race detector (-race) finds the races that happen while a program runs. */
+func GenerateRaces(env *lesson.Env) {
+	getDataRaces(env)
+}
@@ -0,0 +1,109 @@
+package concurrency
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/fajarstrtn/golang-tutorial/lesson"
+)
+
+func getDataRaces(env *lesson.Env) {
+	/*
+	 * A data race is two goroutines accessing the same variable at the same time, at least one of them writing,
+	 * with nothing (a channel, a lock, a WaitGroup, an atomic) to order the two accesses.
+	 * The program is then wrong in ways that change from run to run:
+	 * 1. Lost updates: counter++ is a read and a write, and two goroutines can read the same value
+	 * 2. Stale values: a goroutine may never see a write of another one
+	 * 3. Crashes: the runtime stops a program that writes a map from two goroutines ("concurrent map writes")
+	 *
+	 * The race detector finds them: go run -race, go build -race or go test -race
+	 * build a program that watches every memory access and prints a "WARNING: DATA RACE" report
+	 * (the two accesses and the goroutines that made them) for each race that happens while it runs.
+	 * It finds only the races that happen during the run, and makes the program slower, so it is for tests and development.
+	 *
+	 * The racy functions of this file only run when they are asked for, with:
+	 *
+	 *	go run . run --race concurrency/races
+	 *
+	 * which builds the tutorial with -race, prints a report for each of them,
+	 * and exits with status 1 because races were found.
+	 * Anywhere else (e.g., go test -race ./...), they are skipped, so the tutorial itself stays race-free. */
+	if env.Races {
+		fmt.Fprintln(env.Out, "racy counter:", racyCounter())
+		fmt.Fprintln(env.Out, "racy append:", racyAppend())
+		fmt.Fprintln(env.Out, "racy sleep:", racySleep())
+	}
+
+	// The same counter with an atomic has no race, and always counts every increment.
+	fmt.Fprintln(env.Out, "atomic counter:", atomicCounter()) // Output: atomic counter: 2000
+
+	/*
+	 * Each racy function has a fixed version in the other lessons:
+	 * 1. racyCounter: a Mutex or an atomic (concurrency/sync)
+	 * 2. racyAppend : each goroutine writes its own index, or sends its value on a channel (concurrency/goroutines)
+	 * 3. racySleep  : wait with a channel or a WaitGroup, never with time.Sleep (concurrency/channels) */
+}
+
+// racyCounter increments a shared counter from two goroutines without synchronization: some increments are lost.
+func racyCounter() int {
+	counter := 0
+
+	var wg sync.WaitGroup
+	for range 2 {
+		wg.Go(func() {
+			for range 1000 {
+				counter++
+			}
+		})
+	}
+	wg.Wait()
+
+	return counter
+}
+
+// racyAppend appends to a shared slice from several goroutines: appends can overwrite each other.
+func racyAppend() int {
+	var ids []int
+
+	var wg sync.WaitGroup
+	for i := range 10 {
+		wg.Go(func() {
+			ids = append(ids, i)
+		})
+	}
+	wg.Wait()
+
+	return len(ids)
+}
+
+/*
+ * racySleep waits for a goroutine with time.Sleep. The write usually happens first,
+ * but sleeping does not order the two accesses, so it is still a race (on a busy machine it can print nothing). */
+func racySleep() string {
+	var message string
+	go func() {
+		message = "ready"
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	return message
+}
+
+// atomicCounter is racyCounter with an atomic.Int64: every increment is counted.
+func atomicCounter() int64 {
+	var counter atomic.Int64
+
+	var wg sync.WaitGroup
+	for range 2 {
+		wg.Go(func() {
+			for range 1000 {
+				counter.Add(1)
+			}
+		})
+	}
+	wg.Wait()
+
+	return counter.Load()
+}
@@ -0,0 +1,60 @@
+package concurrency
+
+import (
+	"errors"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/fajarstrtn/golang-tutorial/cli"
+	"github.com/fajarstrtn/golang-tutorial/progress"
+)
+
+// Every racy function of the races lesson must be reported by the race detector, and nothing else.
+func TestRacesAreDetected(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds the tutorial with -race")
+	}
+
+	binary := filepath.Join(t.TempDir(), "tutorial")
+	if out, err := exec.Command("go", "build", "-race", "-o", binary, "..").CombinedOutput(); err != nil {
+		if strings.Contains(string(out), "-race") {
+			t.Skipf("the race detector is not available: %s", out)
+		}
+		t.Fatalf("go build -race: %v\n%s", err, out)
+	}
+
+	cmd := exec.Command(binary, "run", "--race", "concurrency/races")
+	cmd.Env = append(cmd.Environ(), progress.PROGRESS_FILE_ENV+"="+progress.PROGRESS_OFF)
+	out, err := cmd.CombinedOutput()
+
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) || exitErr.ExitCode() != cli.RACE_EXIT_CODE {
+		t.Fatalf("the tutorial should exit with status %d when races are found, got %v\n%s", cli.RACE_EXIT_CODE, err, out)
+	}
+
+	reported := map[string]bool{}
+	for _, report := range strings.Split(string(out), "==================") {
+		if !strings.Contains(report, "WARNING: DATA RACE") {
+			continue
+		}
+
+		found := false
+		for _, name := range []string{"racyCounter", "racyAppend", "racySleep"} {
+			if strings.Contains(report, "concurrency."+name) {
+				reported[name] = true
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("a race outside of the racy functions was reported:%s", report)
+		}
+	}
+
+	for _, name := range []string{"racyCounter", "racyAppend", "racySleep"} {
+		if !reported[name] {
+			t.Errorf("%s was not reported by the race detector", name)
+		}
+	}
+}
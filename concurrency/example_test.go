@@ -0,0 +1,76 @@
+// Code generated by "go run . examples"; DO NOT EDIT.
+
+package concurrency_test
+
+import (
+	"os"
+
+	"github.com/fajarstrtn/golang-tutorial/concurrency"
+	"github.com/fajarstrtn/golang-tutorial/lesson"
+)
+
+// ExampleGenerateChannels runs the concurrency/channels lesson.
+func ExampleGenerateChannels() {
+	concurrency.GenerateChannels(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// [sending received 42]
+	// 2 3
+	// build 1
+	// [test deploy]
+	// "" false
+	// send on closed channel
+	// close of closed channel
+	// [1 4 9 16 25]
+}
+
+// ExampleGenerateFanOut runs the concurrency/fan-out lesson.
+func ExampleGenerateFanOut() {
+	concurrency.GenerateFanOut(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// [512 1024 4096 256 8192] <nil>
+	// true
+	// fetch /missing: page not found
+	// 1
+	// true
+}
+
+// ExampleGenerateGoroutines runs the concurrency/goroutines lesson.
+func ExampleGenerateGoroutines() {
+	concurrency.GenerateGoroutines(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// hello from a goroutine
+	// 49995000
+	// [1 4 9]
+	// [2 2 3 6]
+	// [4 4 6 12]
+}
+
+// ExampleGenerateRaces runs the concurrency/races lesson.
+func ExampleGenerateRaces() {
+	concurrency.GenerateRaces(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// atomic counter: 2000
+}
+
+// ExampleGenerateSelect runs the concurrency/select lesson.
+func ExampleGenerateSelect() {
+	concurrency.GenerateSelect(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// "" lookup slow: timed out after 10ms
+	// "fast: found" <nil>
+	// [1 2 3]
+	// context deadline exceeded true
+	// context canceled
+	// <nil>
+}
+
+// ExampleGenerateSync runs the concurrency/sync lesson.
+func ExampleGenerateSync() {
+	concurrency.GenerateSync(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// 10000 0
+	// 1000
+	// true
+	// 1
+	// 8080 8080 1
+}
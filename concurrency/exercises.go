@@ -0,0 +1,218 @@
+package concurrency
+
+import "github.com/fajarstrtn/golang-tutorial/exercise"
+
+func init() {
+	exercise.Register(exercise.Exercise{
+		ID:     "concurrency/goroutines/wait-group",
+		Lesson: "concurrency/goroutines",
+		Title:  "Wait for goroutines with a WaitGroup",
+		Prompt: "main prints lengths before the goroutines have written it. Wait for them with a sync.WaitGroup. The program is built with the race detector. The output must be:\n[2 2 3 6]",
+		Starter: `package main
+
+import "fmt"
+
+func main() {
+	words := []string{"go", "is", "fun", "gopher"}
+	lengths := make([]int, len(words))
+
+	for i, word := range words {
+		go func() {
+			lengths[i] = len(word)
+		}()
+	}
+
+	fmt.Println(lengths)
+}
+`,
+		Checks: []exercise.Check{
+			exercise.UsesKeyword("go"),
+			exercise.OutputIs("[2 2 3 6]"),
+		},
+		Race: true,
+	})
+
+	exercise.Register(exercise.Exercise{
+		ID:     "concurrency/channels/pipeline-stage",
+		Lesson: "concurrency/channels",
+		Title:  "Write a pipeline stage",
+		Prompt: "Write double(in <-chan int) <-chan int: a goroutine receives every number from in, sends it multiplied by 2 on a new channel, and closes that channel when in is closed. The output must be:\n[2 4 6 8]",
+		Starter: `package main
+
+import "fmt"
+
+func generate(n int) <-chan int {
+	out := make(chan int)
+	go func() {
+		defer close(out)
+		for i := 1; i <= n; i++ {
+			out <- i
+		}
+	}()
+	return out
+}
+
+func main() {
+	var results []int
+	for n := range double(generate(4)) {
+		results = append(results, n)
+	}
+	fmt.Println(results)
+}
+`,
+		Checks: []exercise.Check{
+			exercise.UsesKeyword("go", "chan"),
+			exercise.Calls("close", 2),
+			exercise.OutputIs("[2 4 6 8]"),
+		},
+		Race: true,
+	})
+
+	exercise.Register(exercise.Exercise{
+		ID:     "concurrency/select/timeout",
+		Lesson: "concurrency/select",
+		Title:  "Give up after a timeout",
+		Prompt: "receive waits forever on a channel nobody sends on. Use select with time.After so it returns an error \"timed out\" after d. The output must be:\nhello <nil>\n timed out",
+		Starter: `package main
+
+import (
+	"fmt"
+	"time"
+)
+
+func receive(ch <-chan string, d time.Duration) (string, error) {
+	return <-ch, nil
+}
+
+func main() {
+	ready := make(chan string, 1)
+	ready <- "hello"
+	fmt.Println(receive(ready, time.Second))
+
+	never := make(chan string)
+	fmt.Println(receive(never, 10*time.Millisecond))
+}
+`,
+		Checks: []exercise.Check{
+			exercise.UsesKeyword("select"),
+			exercise.Calls("time.After", 1),
+			exercise.OutputIs("hello <nil>\n timed out"),
+		},
+	})
+
+	exercise.Register(exercise.Exercise{
+		ID:     "concurrency/sync/mutex",
+		Lesson: "concurrency/sync",
+		Title:  "Guard a counter with a Mutex",
+		Prompt: "The goroutines increment counter at the same time, which is a data race. Guard it with a sync.Mutex. The program is built with the race detector. The output must be:\n2000",
+		Starter: `package main
+
+import (
+	"fmt"
+	"sync"
+)
+
+func main() {
+	counter := 0
+
+	var wg sync.WaitGroup
+	for range 2 {
+		wg.Go(func() {
+			for range 1000 {
+				counter++
+			}
+		})
+	}
+	wg.Wait()
+
+	fmt.Println(counter)
+}
+`,
+		Checks: []exercise.Check{
+			exercise.OutputIs("2000"),
+		},
+		Race: true,
+	})
+
+	exercise.Register(exercise.Exercise{
+		ID:     "concurrency/fan-out/first-error",
+		Lesson: "concurrency/fan-out",
+		Title:  "Fan out and return the first error",
+		Prompt: "fetchAll fetches the paths one after the other, which is too slow. Fetch each one in its own goroutine, store each size at the index of its path, wait for all of them, and return the first error (nil sizes with it). The program is built with the race detector. The output must be:\n[512 1024 256] <nil>\n[] fetch /missing: not found\nfast: true",
+		Starter: `package main
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var ErrNotFound = errors.New("not found")
+
+var SIZES = map[string]int{"/": 512, "/about": 1024, "/contact": 256}
+
+func fetch(path string) (int, error) {
+	time.Sleep(100 * time.Millisecond)
+	size, ok := SIZES[path]
+	if !ok {
+		return 0, fmt.Errorf("fetch %s: %w", path, ErrNotFound)
+	}
+	return size, nil
+}
+
+func fetchAll(paths []string) ([]int, error) {
+	sizes := make([]int, len(paths))
+	for i, path := range paths {
+		size, err := fetch(path)
+		if err != nil {
+			return nil, err
+		}
+		sizes[i] = size
+	}
+	return sizes, nil
+}
+
+func main() {
+	start := time.Now()
+	fmt.Println(fetchAll([]string{"/", "/about", "/contact"}))
+	fmt.Println(fetchAll([]string{"/", "/missing", "/contact"}))
+
+	// One after the other, the two calls take 500ms; in parallel, 200ms.
+	fmt.Println("fast:", time.Since(start) < 350*time.Millisecond)
+}
+`,
+		Checks: []exercise.Check{
+			exercise.OutputIs("[512 1024 256] <nil>\n[] fetch /missing: not found\nfast: true"),
+		},
+		Race: true,
+	})
+
+	exercise.Register(exercise.Exercise{
+		ID:     "concurrency/races/no-sleep",
+		Lesson: "concurrency/races",
+		Title:  "Replace time.Sleep with a channel",
+		Prompt: "main waits for the goroutine with time.Sleep, which does not order the two accesses to message: the race detector reports a data race. Wait with a channel instead, and remove time.Sleep. The output must be:\nready",
+		Starter: `package main
+
+import (
+	"fmt"
+	"time"
+)
+
+func main() {
+	var message string
+	go func() {
+		message = "ready"
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	fmt.Println(message)
+}
+`,
+		Checks: []exercise.Check{
+			exercise.NoCalls("time.Sleep"),
+			exercise.OutputIs("ready"),
+		},
+		Race: true,
+	})
+}
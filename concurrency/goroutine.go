@@ -0,0 +1,87 @@
+package concurrency
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"sync"
+)
+
+func getGoroutines(w io.Writer) {
+	/*
+	 * go f(x) evaluates f and x, starts f(x) in a new goroutine and goes on at once, without waiting for it.
+	 * A goroutine costs a few kilobytes of stack, so a program can run thousands of them.
+	 *
+	 * Nothing waits for a goroutine: when main returns, the program ends, even if goroutines are still running.
+	 * Here the caller waits for the value the goroutine sends on a channel. */
+	done := make(chan string)
+	go func() {
+		done <- "hello from a goroutine"
+	}()
+	fmt.Fprintln(w, <-done) // Output: hello from a goroutine
+
+	/*
+	 * 10,000 goroutines each send their number, and the caller receives 10,000 values.
+	 * The order in which they arrive changes from run to run, but their sum does not. */
+	numbers := make(chan int)
+	for i := range 10_000 {
+		go func() {
+			numbers <- i
+		}()
+	}
+
+	total := 0
+	for range 10_000 {
+		total += <-numbers
+	}
+	fmt.Fprintln(w, total) // Output: 49995000
+
+	/*
+	 * The results of goroutines arrive in any order: sort them (or store each one at its own index)
+	 * to print them in an order that does not depend on the scheduler. */
+	squares := make(chan int)
+	for _, n := range []int{3, 1, 2} {
+		go func() {
+			squares <- n * n
+		}()
+	}
+
+	received := []int{<-squares, <-squares, <-squares}
+	fmt.Fprintln(w, slices.Sorted(slices.Values(received))) // Output: [1 4 9]
+}
+
+func getWaitGroup(w io.Writer) {
+	/*
+	 * A sync.WaitGroup waits for a group of goroutines: wg.Go(f) starts f in a new goroutine and counts it (Go 1.25),
+	 * and wg.Wait blocks until every counted goroutine has returned.
+	 *
+	 * Each goroutine writes its own element of lengths, so they never write the same memory,
+	 * and wg.Wait makes their writes visible to the caller. Since Go 1.22, every iteration has its own i and word,
+	 * so each goroutine sees the values of its iteration. */
+	words := []string{"go", "is", "fun", "gopher"}
+	lengths := make([]int, len(words))
+
+	var wg sync.WaitGroup
+	for i, word := range words {
+		wg.Go(func() {
+			lengths[i] = len(word)
+		})
+	}
+	wg.Wait()
+	fmt.Fprintln(w, lengths) // Output: [2 2 3 6]
+
+	/*
+	 * Before wg.Go, the counting was written by hand, and still is in a lot of code:
+	 * 1. wg.Add(1) before the go statement (inside the goroutine, Wait could run before it)
+	 * 2. defer wg.Done() as the first line of the goroutine, so it is called even on a panic */
+	doubled := make([]int, len(lengths))
+	for i, n := range lengths {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			doubled[i] = n * 2
+		}()
+	}
+	wg.Wait()
+	fmt.Fprintln(w, doubled) // Output: [4 4 6 12]
+}
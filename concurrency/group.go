@@ -0,0 +1,180 @@
+package concurrency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+/*
+ * A Group runs tasks in goroutines and waits for them, like golang.org/x/sync/errgroup
+ * (the tutorial uses the standard library only, so it has its own small version):
+ * 1. Go starts a task; after SetLimit(n), it waits while n tasks are running
+ * 2. Wait waits for every task, and returns the first error one of them returned
+ * 3. The context of WithContext is canceled at the first error, so the other tasks can stop early
+ *
+ * The zero value is a Group without a limit and without a context. */
+type Group struct {
+	wg     sync.WaitGroup
+	cancel context.CancelCauseFunc
+	limit  chan struct{}
+
+	errOnce sync.Once
+	err     error
+}
+
+// WithContext returns a Group and a context derived from ctx, canceled at the first error or when Wait returns.
+func WithContext(ctx context.Context) (*Group, context.Context) {
+	ctx, cancel := context.WithCancelCause(ctx)
+	return &Group{cancel: cancel}, ctx
+}
+
+// SetLimit limits the number of tasks running at once to n. It must be called before the first Go.
+func (g *Group) SetLimit(n int) {
+	g.limit = make(chan struct{}, n)
+}
+
+/*
+ * Go runs task in a new goroutine. With a limit, it first waits for a free place:
+ * the buffered channel limit holds one value per running task. */
+func (g *Group) Go(task func() error) {
+	if g.limit != nil {
+		g.limit <- struct{}{}
+	}
+
+	g.wg.Go(func() {
+		if g.limit != nil {
+			defer func() { <-g.limit }()
+		}
+
+		if err := task(); err != nil {
+			g.errOnce.Do(func() {
+				g.err = err
+				if g.cancel != nil {
+					g.cancel(err)
+				}
+			})
+		}
+	})
+}
+
+// Wait waits for every task started with Go and returns the first error.
+func (g *Group) Wait() error {
+	g.wg.Wait()
+	if g.cancel != nil {
+		g.cancel(g.err)
+	}
+	return g.err
+}
+
+// ErrPageNotFound is returned by fetch for a path that is not in PAGES.
+var ErrPageNotFound = errors.New("page not found")
+
+// A Page is a page of the fake web site of the examples: its size, and how long fetching it takes.
+type Page struct {
+	Size  int
+	Delay time.Duration
+}
+
+// PAGES is the fake web site the examples fetch from.
+var PAGES = map[string]Page{
+	"/":        {Size: 512},
+	"/about":   {Size: 1024, Delay: 3 * time.Millisecond},
+	"/blog":    {Size: 4096, Delay: 1 * time.Millisecond},
+	"/contact": {Size: 256, Delay: 2 * time.Millisecond},
+	"/docs":    {Size: 8192, Delay: 1 * time.Millisecond},
+	"/slow":    {Size: 128, Delay: time.Minute},
+}
+
+// fetch returns the size of a page after its delay, or stops early with the error of ctx.
+func fetch(ctx context.Context, path string) (int, error) {
+	page, ok := PAGES[path]
+	if !ok {
+		return 0, fmt.Errorf("fetch %s: %w", path, ErrPageNotFound)
+	}
+
+	if page.Delay > 0 {
+		if err := sleep(ctx, page.Delay); err != nil {
+			return 0, fmt.Errorf("fetch %s: %w", path, err)
+		}
+	}
+
+	return page.Size, nil
+}
+
+// A meter counts the tasks running at the same time, and remembers the highest count (the peak).
+type meter struct {
+	running, peak atomic.Int64
+}
+
+func (m *meter) start() {
+	n := m.running.Add(1)
+	for {
+		peak := m.peak.Load()
+		if n <= peak || m.peak.CompareAndSwap(peak, n) {
+			return
+		}
+	}
+}
+
+func (m *meter) stop() {
+	m.running.Add(-1)
+}
+
+func getFanOut(w io.Writer) {
+	/*
+	 * Fan-out: every page is fetched in its own task, at the same time as the others.
+	 * Fan-in: each task stores its result at the index of its path, so the results are in the order of paths,
+	 * whatever the order the tasks finish in. SetLimit(2) lets at most two fetches run at once. */
+	paths := []string{"/", "/about", "/blog", "/contact", "/docs"}
+	sizes := make([]int, len(paths))
+
+	var g Group
+	g.SetLimit(2)
+
+	var m meter
+	for i, path := range paths {
+		g.Go(func() error {
+			m.start()
+			defer m.stop()
+
+			size, err := fetch(context.Background(), path)
+			sizes[i] = size
+			return err
+		})
+	}
+
+	err := g.Wait()
+	fmt.Fprintln(w, sizes, err)         // Output: [512 1024 4096 256 8192] <nil>
+	fmt.Fprintln(w, m.peak.Load() <= 2) // Output: true
+}
+
+func getFirstError(w io.Writer) {
+	/*
+	 * With WithContext, the first error cancels the context the tasks were given:
+	 * /missing fails at once, so the fetch of /slow stops instead of taking a minute.
+	 * Wait returns the first error only; the error of the canceled task is dropped. */
+	g, ctx := WithContext(context.Background())
+
+	var canceled atomic.Int64
+	for _, path := range []string{"/", "/missing", "/slow"} {
+		g.Go(func() error {
+			_, err := fetch(ctx, path)
+			if errors.Is(err, context.Canceled) {
+				canceled.Add(1)
+			}
+			return err
+		})
+	}
+
+	err := g.Wait()
+	fmt.Fprintln(w, err)             // Output: fetch /missing: page not found
+	fmt.Fprintln(w, canceled.Load()) // Output: 1
+
+	// context.Cause tells why the context was canceled: the first error.
+	fmt.Fprintln(w, errors.Is(context.Cause(ctx), ErrPageNotFound)) // Output: true
+}
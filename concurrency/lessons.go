@@ -0,0 +1,12 @@
+package concurrency
+
+import "github.com/fajarstrtn/golang-tutorial/lesson"
+
+func init() {
+	lesson.Register(lesson.Lesson{ID: "concurrency/goroutines", Title: "Goroutines and sync.WaitGroup", Topic: "concurrency", Order: 1400, Run: GenerateGoroutines})
+	lesson.Register(lesson.Lesson{ID: "concurrency/channels", Title: "Unbuffered and buffered channels", Topic: "concurrency", Order: 1410, Run: GenerateChannels})
+	lesson.Register(lesson.Lesson{ID: "concurrency/select", Title: "select with timeouts", Topic: "concurrency", Order: 1420, Run: GenerateSelect})
+	lesson.Register(lesson.Lesson{ID: "concurrency/sync", Title: "Mutex, atomic and Once", Topic: "concurrency", Order: 1430, Run: GenerateSync})
+	lesson.Register(lesson.Lesson{ID: "concurrency/fan-out", Title: "Fan-out with an errgroup-style Group", Topic: "concurrency", Order: 1440, Run: GenerateFanOut})
+	lesson.Register(lesson.Lesson{ID: "concurrency/races", Title: "Data races and the race detector", Topic: "concurrency", Order: 1450, Run: GenerateRaces})
+}
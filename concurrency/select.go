@@ -0,0 +1,105 @@
+package concurrency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+/*
+ * lookup waits for the answer of a search on found, and gives up after timeout.
+ * found needs a buffer of one value, so the search can still send its answer and end
+ * after lookup has given up: with an unbuffered channel it would block forever (a goroutine leak). */
+func lookup(name string, found <-chan string, timeout time.Duration) (string, error) {
+	select {
+	case result := <-found:
+		return result, nil
+	case <-time.After(timeout):
+		return "", fmt.Errorf("lookup %s: timed out after %v", name, timeout)
+	}
+}
+
+func getTimeouts(w io.Writer) {
+	/*
+	 * select blocks until one of its cases can run. time.After(d) returns a channel that receives after d,
+	 * so a case on it is a timeout: whichever comes first wins.
+	 * The slow search answers only after its lookup gave up; the fast one has answered before its lookup starts. */
+	slow := make(chan string, 1)
+	result, err := lookup("slow", slow, 10*time.Millisecond)
+	fmt.Fprintf(w, "%q %v\n", result, err) // Output: "" lookup slow: timed out after 10ms
+
+	// Nobody receives the late answer anymore, but it fits in the buffer, so sending it does not block.
+	slow <- "slow: found"
+
+	fast := make(chan string, 1)
+	fast <- "fast: found"
+	result, err = lookup("fast", fast, time.Second)
+	fmt.Fprintf(w, "%q %v\n", result, err) // Output: "fast: found" <nil>
+
+	/*
+	 * In a loop, the timer is made once, before the loop, so it limits the whole loop:
+	 * time.After inside the select would start a new timer on every iteration.
+	 * Here the producer sends three values and closes the channel, which ends the loop;
+	 * the deadline only stops the loop if the producer hangs. */
+	values := make(chan int)
+	go func() {
+		defer close(values)
+		for i := 1; i <= 3; i++ {
+			values <- i
+		}
+	}()
+
+	deadline := time.After(time.Second)
+	var got []int
+loop:
+	for {
+		select {
+		case v, ok := <-values:
+			if !ok {
+				break loop
+			}
+			got = append(got, v)
+		case <-deadline:
+			break loop
+		}
+	}
+	fmt.Fprintln(w, got) // Output: [1 2 3]
+}
+
+/*
+ * sleep waits for d, or returns early with the error of the context when it is canceled or its deadline passes.
+ * Functions that can take long take a context.Context as their first parameter, so the caller decides when to stop. */
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func getContextTimeout(w io.Writer) {
+	/*
+	 * context.WithTimeout returns a context whose Done channel is closed when the time is up
+	 * (or when cancel is called, which must always happen: defer cancel()).
+	 * A closed channel is received from by every goroutine waiting on it, so one context stops them all. */
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	// The sleep is far longer than the deadline, so only the deadline can end it.
+	err := sleep(ctx, time.Hour)
+	fmt.Fprintln(w, err, errors.Is(err, context.DeadlineExceeded)) // Output: context deadline exceeded true
+
+	// A context canceled by hand reports context.Canceled instead.
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	fmt.Fprintln(w, sleep(ctx, time.Second)) // Output: context canceled
+
+	// With time to spare, sleep returns nil.
+	fmt.Fprintln(w, sleep(context.Background(), time.Millisecond)) // Output: <nil>
+}
@@ -0,0 +1,113 @@
+package concurrency
+
+import (
+	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
+)
+
+/*
+ * A SafeCounter counts by key, and can be used by many goroutines at once.
+ * Its mutex guards counts: every access to counts happens between Lock and Unlock.
+ * A mutex must not be copied once used (go vet's copylocks check reports it),
+ * so the methods have pointer receivers. */
+type SafeCounter struct {
+	mu     sync.RWMutex
+	counts map[string]int
+}
+
+// Increment adds one to the count of key.
+func (c *SafeCounter) Increment(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.counts == nil {
+		c.counts = map[string]int{}
+	}
+	c.counts[key]++
+}
+
+// Value returns the count of key. It only reads, so several goroutines can hold the read lock at once.
+func (c *SafeCounter) Value(key string) int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.counts[key]
+}
+
+func getMutex(w io.Writer) {
+	/*
+	 * counts[key]++ reads the count, adds one and writes it back: two goroutines doing it at once
+	 * can both read the same value, and one update is lost (a data race, see concurrency/races).
+	 * sync.Mutex lets one goroutine at a time run the code between Lock and Unlock (the critical section);
+	 * the others wait in Lock. The zero value of a Mutex is unlocked, ready to use.
+	 *
+	 * sync.RWMutex also has RLock and RUnlock: any number of readers at once, or one writer. */
+	var counter SafeCounter
+	var wg sync.WaitGroup
+	for range 100 {
+		wg.Go(func() {
+			for range 100 {
+				counter.Increment("hits")
+			}
+		})
+	}
+	wg.Wait()
+	fmt.Fprintln(w, counter.Value("hits"), counter.Value("misses")) // Output: 10000 0
+}
+
+func getAtomic(w io.Writer) {
+	/*
+	 * For a single number or flag, the types of sync/atomic (Int64, Bool, Pointer[T], ...) need no lock:
+	 * Add, Load, Store and CompareAndSwap each happen as one indivisible step. */
+	var hits atomic.Int64
+	var wg sync.WaitGroup
+	for range 1000 {
+		wg.Go(func() {
+			hits.Add(1)
+		})
+	}
+	wg.Wait()
+	fmt.Fprintln(w, hits.Load()) // Output: 1000
+
+	// CompareAndSwap writes only if the value is still the expected one: only one goroutine can win.
+	var winner atomic.Int64
+	for i := 1; i <= 5; i++ {
+		wg.Go(func() {
+			winner.CompareAndSwap(0, int64(i))
+		})
+	}
+	wg.Wait()
+	fmt.Fprintln(w, winner.Load() != 0) // Output: true
+}
+
+func getOnce(w io.Writer) {
+	/*
+	 * sync.Once runs a function once, however many goroutines call Do and however often.
+	 * The other callers wait until it has returned, so they all see what it did (e.g., a loaded configuration). */
+	var once sync.Once
+	loads := 0
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Go(func() {
+			once.Do(func() {
+				loads++
+			})
+		})
+	}
+	wg.Wait()
+	fmt.Fprintln(w, loads) // Output: 1
+
+	/*
+	 * sync.OnceValue (and OnceValues, for a value and an error) wraps a function
+	 * so that it runs on the first call, and every call returns its result. */
+	calls := 0
+	config := sync.OnceValue(func() map[string]string {
+		calls++
+		return map[string]string{"port": "8080"}
+	})
+	first, second := config()["port"], config()["port"]
+	fmt.Fprintln(w, first, second, calls) // Output: 8080 8080 1
+}
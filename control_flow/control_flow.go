@@ -0,0 +1,74 @@
+/*
+ * Control flow decides which statement runs next. The identifier/keywords lesson lists the keywords;
+ * this package runs an example of every one of them:
+ * 1. if, else, return          : The if lesson
+ * 2. for, range, break, continue: The for lesson, with labels and the loop variable of Go 1.22
+ * 3. switch, case, default, fallthrough: The switch lesson
+ * 4. goto                      : The goto lesson
+ * 5. defer                     : The defer lesson
+ * 6. select, go                : The select lesson
+ *
+ * Every example prints what it did, and the output is checked by go run . verify. */
+package control_flow
+
+import "github.com/fajarstrtn/golang-tutorial/lesson"
+
+/*
+ * if runs a block when a condition is true, else when it is false.
+ * There are no parentheses around the condition, and the braces are required. */
+func GenerateIf(env *lesson.Env) {
+	getIfElse(env.Out)
+	getIfWithStatement(env.Out)
+	getEarlyReturn(env.Out)
+}
+
+/*
+ * for is the only loop of Go: it covers the for, while and do-while loops of other languages.
+ * This lesson looks at:
+ * 1. The three forms of for, and for range
+ * 2. break and continue, with and without labels
+ * 3. The loop variable, a new one in every iteration since Go 1.22 */
+func GenerateFor(env *lesson.Env) {
+	getForForms(env.Out)
+	getForRange(env.Out)
+	getBreakAndContinue(env.Out)
+	getLabels(env.Out)
+	getLoopVariable(env.Out)
+}
+
+/*
+ * switch picks the first case that matches, and runs only that case:
+ * there is no fall through by default, so no break is needed. */
+func GenerateSwitch(env *lesson.Env) {
+	getSwitchWithTag(env.Out)
+	getSwitchWithoutTag(env.Out)
+	getFallthrough(env.Out)
+	getBreakInSwitch(env.Out)
+}
+
+/*
+ * goto jumps to a label in the same function.
+ * It is rarely needed, since for, break and continue cover most cases. */
+func GenerateGoto(env *lesson.Env) {
+	getGoto(env.Out)
+}
+
+/*
+ * defer schedules a function call to run when the surrounding function returns,
+ * however it returns (return, the end of the function, or a panic). */
+func GenerateDefer(env *lesson.Env) {
+	getDeferOrder(env.Out)
+	getDeferArguments(env.Out)
+	getDeferNamedResults(env.Out)
+	getDeferInLoop(env.Out)
+	getDeferRecover(env.Out)
+}
+
+/*
+ * select waits on several channel operations and runs the first one that can proceed.
+ * go starts a function in a new goroutine, which is what usually sends on those channels. */
+func GenerateSelect(env *lesson.Env) {
+	getSelect(env.Out)
+	getSelectDefault(env.Out)
+	getSelectNilChannel(env.Out)
+}
@@ -0,0 +1,139 @@
+package control_flow
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+func getDeferOrder(w io.Writer) {
+	/*
+	 * The deferred calls of a function run when it returns, in the reverse order of the defer statements:
+	 * the last one deferred runs first (like a stack).
+	 *
+	 * Output:
+	 * start
+	 * end
+	 * deferred 3
+	 * deferred 2
+	 * deferred 1 */
+	countDown(w)
+}
+
+func countDown(w io.Writer) {
+	fmt.Fprintln(w, "start")
+	for i := 1; i <= 3; i++ {
+		defer fmt.Fprintln(w, "deferred", i)
+	}
+	fmt.Fprintln(w, "end")
+}
+
+func getDeferArguments(w io.Writer) {
+	/*
+	 * The arguments of a deferred call are evaluated when the defer statement runs, not when the call runs.
+	 * A deferred closure, on the other hand, reads its variables when it runs.
+	 *
+	 * Output:
+	 * closure: 2
+	 * argument: 1 */
+	deferArguments(w)
+}
+
+func deferArguments(w io.Writer) {
+	x := 1
+	defer fmt.Fprintln(w, "argument:", x)
+	defer func() {
+		fmt.Fprintln(w, "closure:", x)
+	}()
+	x = 2
+}
+
+func getDeferNamedResults(w io.Writer) {
+	/*
+	 * A deferred closure runs after the return statement has set the results,
+	 * so it can read and change named results. return 3 sets n to 3, then the deferred call doubles it. */
+	fmt.Fprintln(w, doubledOnReturn()) // Output: 6
+
+	// It is the usual way to add context to any error a function returns.
+	fmt.Fprintln(w, loadConfig("missing.json")) // Output: load missing.json: file does not exist
+}
+
+func doubledOnReturn() (n int) {
+	defer func() {
+		n *= 2
+	}()
+	return 3
+}
+
+var errNotExist = errors.New("file does not exist")
+
+func loadConfig(path string) (err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("load %s: %w", path, err)
+		}
+	}()
+
+	return errNotExist
+}
+
+func getDeferInLoop(w io.Writer) {
+	/*
+	 * defer belongs to the function, not to the loop: in a loop, every call waits for the function to return.
+	 * With files, all of them would stay open until then.
+	 *
+	 * Output:
+	 * open a
+	 * open b
+	 * close b
+	 * close a */
+	processAll(w, []string{"a", "b"})
+
+	/*
+	 * Moving the body of the loop into a function (here a closure called right away)
+	 * runs the deferred call at the end of every iteration.
+	 *
+	 * Output:
+	 * open a
+	 * close a
+	 * open b
+	 * close b */
+	processEach(w, []string{"a", "b"})
+}
+
+func processAll(w io.Writer, names []string) {
+	for _, name := range names {
+		fmt.Fprintln(w, "open", name)
+		defer fmt.Fprintln(w, "close", name)
+	}
+}
+
+func processEach(w io.Writer, names []string) {
+	for _, name := range names {
+		func() {
+			fmt.Fprintln(w, "open", name)
+			defer fmt.Fprintln(w, "close", name)
+		}()
+	}
+}
+
+func getDeferRecover(w io.Writer) {
+	/*
+	 * Deferred calls run during a panic too, and recover, called from a deferred function,
+	 * stops the panic and returns its value. Here it turns the panic into an error. */
+	result, err := safeDivide(10, 2)
+	fmt.Fprintln(w, result, err) // Output: 5 <nil>
+
+	result, err = safeDivide(1, 0)
+	fmt.Fprintln(w, result, err) // Output: 0 recovered: runtime error: integer divide by zero
+}
+
+func safeDivide(a, b int) (result int, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("recovered: %v", r)
+		}
+	}()
+
+	return a / b, nil
+}
@@ -0,0 +1,111 @@
+// Code generated by "go run . examples"; DO NOT EDIT.
+
+package control_flow_test
+
+import (
+	"os"
+
+	"github.com/fajarstrtn/golang-tutorial/control_flow"
+	"github.com/fajarstrtn/golang-tutorial/lesson"
+)
+
+// ExampleGenerateDefer runs the control_flow/defer lesson.
+func ExampleGenerateDefer() {
+	control_flow.GenerateDefer(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// start
+	// end
+	// deferred 3
+	// deferred 2
+	// deferred 1
+	// closure: 2
+	// argument: 1
+	// 6
+	// load missing.json: file does not exist
+	// open a
+	// open b
+	// close b
+	// close a
+	// open a
+	// close a
+	// open b
+	// close b
+	// 5 <nil>
+	// 0 recovered: runtime error: integer divide by zero
+}
+
+// ExampleGenerateFor runs the control_flow/for lesson.
+func ExampleGenerateFor() {
+	control_flow.GenerateFor(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// [1 4 9 16]
+	// 111
+	// 3
+	// [0 1 2]
+	// [0:Jakarta 1:Bandung]
+	// [0:h 1:é 3:l 4:l 5:o]
+	// [Bandung=2 Jakarta=10]
+	// [1 2]
+	// [RANGE OVER AN ITERATOR]
+	// [1 3 5 7 9]
+	// found 12 at row 1, column 2
+	// [0 2]
+	// 0 1 2
+	// 3 3 3
+	// 0 1 2 true
+}
+
+// ExampleGenerateGoto runs the control_flow/goto lesson.
+func ExampleGenerateGoto() {
+	control_flow.GenerateGoto(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// attempt 1 failed
+	// connected after 2 attempts
+	// parsed 2 values, then failed at "x"
+}
+
+// ExampleGenerateIf runs the control_flow/if lesson.
+func ExampleGenerateIf() {
+	control_flow.GenerateIf(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// -5 is negative
+	// 0 is zero
+	// 7 is odd
+	// 12 is even
+	// 84
+	// strconv.Atoi: parsing "forty-two": invalid syntax
+	// 30
+	// Hello, Ana!
+	// name is empty
+	// name is too long
+}
+
+// ExampleGenerateSelect runs the control_flow/select lesson.
+func ExampleGenerateSelect() {
+	control_flow.GenerateSelect(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// [cube 27 square 9]
+	// true false
+	// first true
+	// "" false
+	// [1 a b]
+}
+
+// ExampleGenerateSwitch runs the control_flow/switch lesson.
+func ExampleGenerateSwitch() {
+	control_flow.GenerateSwitch(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// Saturday: weekend
+	// Monday: weekday
+	// Someday: not a day
+	// 6 letters
+	// 95 A
+	// 80 B
+	// 42 F
+	// level 3: admin, write, read
+	// level 2: write, read
+	// level 1: read
+	// [positive more than 100?]
+	// 3
+	// 2
+}
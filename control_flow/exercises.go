@@ -0,0 +1,173 @@
+package control_flow
+
+import "github.com/fajarstrtn/golang-tutorial/exercise"
+
+func init() {
+	exercise.Register(exercise.Exercise{
+		ID:     "control_flow/if/early-return",
+		Lesson: "control_flow/if",
+		Title:  "Check the special cases first",
+		Prompt: "Complete ticketPrice with if statements that return early: children under 5 pay 0, people 65 and older pay 5, and everyone else pays 10. The output must be:\n0\n10\n5",
+		Starter: `package main
+
+import "fmt"
+
+func ticketPrice(age int) int {
+	return 10
+}
+
+func main() {
+	fmt.Println(ticketPrice(3))
+	fmt.Println(ticketPrice(30))
+	fmt.Println(ticketPrice(70))
+}
+`,
+		Checks: []exercise.Check{
+			exercise.UsesKeyword("if", "return"),
+			exercise.OutputIs("0\n10\n5"),
+		},
+	})
+
+	exercise.Register(exercise.Exercise{
+		ID:     "control_flow/for/labelled-break",
+		Lesson: "control_flow/for",
+		Title:  "Leave two loops at once",
+		Prompt: "The inner break only leaves the inner loop, so the search goes on and prints every pair. Label the outer loop and break out of it, so only the first pair that adds up to 10 is printed: 1 9",
+		Starter: `package main
+
+import "fmt"
+
+func main() {
+	numbers := []int{1, 3, 5, 7, 9}
+
+	for _, a := range numbers {
+		for _, b := range numbers {
+			if a < b && a+b == 10 {
+				fmt.Println(a, b)
+				break
+			}
+		}
+	}
+}
+`,
+		Checks: []exercise.Check{
+			exercise.UsesKeyword("break"),
+			exercise.OutputIs("1 9"),
+		},
+	})
+
+	exercise.Register(exercise.Exercise{
+		ID:     "control_flow/switch/seasons",
+		Lesson: "control_flow/switch",
+		Title:  "Match several values in one case",
+		Prompt: "Write season with a switch on the month, listing several months in each case: December to February is winter, March to May spring, June to August summer, September to November autumn, anything else unknown. The output must be:\nwinter\nsummer\nautumn\nunknown",
+		Starter: `package main
+
+import "fmt"
+
+func season(month int) string {
+	return "unknown"
+}
+
+func main() {
+	for _, month := range []int{1, 7, 10, 13} {
+		fmt.Println(season(month))
+	}
+}
+`,
+		Checks: []exercise.Check{
+			exercise.UsesKeyword("switch", "case", "default"),
+			exercise.OutputIs("winter\nsummer\nautumn\nunknown"),
+		},
+	})
+
+	exercise.Register(exercise.Exercise{
+		ID:     "control_flow/goto/to-a-loop",
+		Lesson: "control_flow/goto",
+		Title:  "Replace goto with a for loop",
+		Prompt: "Rewrite the countdown with a for loop instead of the label and goto, keeping the same output:\n3\n2\n1\nliftoff",
+		Starter: `package main
+
+import "fmt"
+
+func main() {
+	n := 3
+
+again:
+	fmt.Println(n)
+	n--
+	if n > 0 {
+		goto again
+	}
+	fmt.Println("liftoff")
+}
+`,
+		Checks: []exercise.Check{
+			exercise.UsesKeyword("for"),
+			exercise.OutputIs("3\n2\n1\nliftoff"),
+		},
+	})
+
+	exercise.Register(exercise.Exercise{
+		ID:     "control_flow/defer/close-on-return",
+		Lesson: "control_flow/defer",
+		Title:  "Close with defer",
+		Prompt: "process returns early when the work fails, so close is never printed for it. Defer the close right after the open, so it runs on every return. The output must be:\nopen a\nwork a\nclose a\nopen b\nclose b\nb failed",
+		Starter: `package main
+
+import (
+	"errors"
+	"fmt"
+)
+
+func process(name string) error {
+	fmt.Println("open", name)
+	if name == "b" {
+		return errors.New(name + " failed")
+	}
+	fmt.Println("work", name)
+	fmt.Println("close", name)
+	return nil
+}
+
+func main() {
+	for _, name := range []string{"a", "b"} {
+		if err := process(name); err != nil {
+			fmt.Println(err)
+		}
+	}
+}
+`,
+		Checks: []exercise.Check{
+			exercise.UsesKeyword("defer"),
+			exercise.OutputIs("open a\nwork a\nclose a\nopen b\nclose b\nb failed"),
+		},
+	})
+
+	exercise.Register(exercise.Exercise{
+		ID:     "control_flow/select/try-receive",
+		Lesson: "control_flow/select",
+		Title:  "Receive without blocking",
+		Prompt: "The second receive blocks forever because the channel is empty. Write tryReceive with a select and a default case, so it returns false instead of waiting. The output must be:\n42 true\n0 false",
+		Starter: `package main
+
+import "fmt"
+
+func tryReceive(numbers chan int) (int, bool) {
+	return <-numbers, true
+}
+
+func main() {
+	numbers := make(chan int, 1)
+	numbers <- 42
+
+	fmt.Println(tryReceive(numbers))
+	fmt.Println(tryReceive(numbers))
+}
+`,
+		Checks: []exercise.Check{
+			exercise.UsesKeyword("select", "default"),
+			exercise.OutputIs("42 true\n0 false"),
+		},
+	})
+}
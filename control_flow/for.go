@@ -0,0 +1,186 @@
+package control_flow
+
+import (
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+)
+
+func getForForms(w io.Writer) {
+	/*
+	 * for has three forms:
+	 * 1. for init; condition; post { }: The classic loop
+	 * 2. for condition { }             : A while loop
+	 * 3. for { }                       : An endless loop, left with break or return */
+	var squares []int
+	for i := 1; i <= 4; i++ {
+		squares = append(squares, i*i)
+	}
+	fmt.Fprintln(w, squares) // Output: [1 4 9 16]
+
+	n := 27
+	steps := 0
+	for n != 1 {
+		if n%2 == 0 {
+			n /= 2
+		} else {
+			n = 3*n + 1
+		}
+		steps++
+	}
+	fmt.Fprintln(w, steps) // Output: 111
+
+	attempts := 0
+	for {
+		attempts++
+		if attempts == 3 {
+			break
+		}
+	}
+	fmt.Fprintln(w, attempts) // Output: 3
+}
+
+func getForRange(w io.Writer) {
+	/*
+	 * for range walks over the elements of a value and gives two values per element:
+	 * 1. Integer (Go 1.22): 0 to n - 1, one value
+	 * 2. Array, slice     : The index and a copy of the element
+	 * 3. String           : The byte index and the rune (see the data_types/utf8 lesson)
+	 * 4. Map              : The key and the value, in a random order
+	 * 5. Channel          : The values received, until the channel is closed
+	 * 6. Function iterator (Go 1.23): The values it yields
+	 *
+	 * _ ignores a value, and for i := range s takes only the index.
+	 * The loops below collect what they get, and print it at the end. */
+	var got []string
+	for i := range 3 {
+		got = append(got, fmt.Sprint(i))
+	}
+	fmt.Fprintln(w, got) // Output: [0 1 2]
+
+	got = nil
+	for i, city := range []string{"Jakarta", "Bandung"} {
+		got = append(got, fmt.Sprint(i, ":", city))
+	}
+	fmt.Fprintln(w, got) // Output: [0:Jakarta 1:Bandung]
+
+	got = nil
+	for i, r := range "héllo" {
+		got = append(got, fmt.Sprintf("%d:%c", i, r))
+	}
+	fmt.Fprintln(w, got) // Output: [0:h 1:é 3:l 4:l 5:o]
+
+	// The order of a map is random, so the keys are sorted first (see the composite_types/maps lesson).
+	got = nil
+	population := map[string]int{"Jakarta": 10, "Bandung": 2}
+	for _, city := range slices.Sorted(maps.Keys(population)) {
+		got = append(got, fmt.Sprint(city, "=", population[city]))
+	}
+	fmt.Fprintln(w, got) // Output: [Bandung=2 Jakarta=10]
+
+	got = nil
+	numbers := make(chan int, 3)
+	numbers <- 1
+	numbers <- 2
+	close(numbers)
+	for n := range numbers {
+		got = append(got, fmt.Sprint(n))
+	}
+	fmt.Fprintln(w, got) // Output: [1 2]
+
+	got = nil
+	for word := range strings.FieldsSeq("range over an iterator") {
+		got = append(got, strings.ToUpper(word))
+	}
+	fmt.Fprintln(w, got) // Output: [RANGE OVER AN ITERATOR]
+}
+
+func getBreakAndContinue(w io.Writer) {
+	/*
+	 * break leaves the loop; continue skips the rest of the body and starts the next iteration.
+	 * Both apply to the innermost for, switch or select. */
+	var odds []int
+	for i := range 100 {
+		if i > 9 {
+			break
+		}
+		if i%2 == 0 {
+			continue
+		}
+		odds = append(odds, i)
+	}
+	fmt.Fprintln(w, odds) // Output: [1 3 5 7 9]
+}
+
+func getLabels(w io.Writer) {
+	grid := [][]int{
+		{1, 2, 3},
+		{10, 11, 12},
+		{20, 21, 22},
+	}
+
+	/*
+	 * A label names a statement. break Label and continue Label apply to the labelled loop,
+	 * not to the innermost one: they are how to leave (or skip an iteration of) an outer loop.
+	 *
+	 * Output:
+	 * found 12 at row 1, column 2 */
+search:
+	for row, cells := range grid {
+		for column, cell := range cells {
+			if cell == 12 {
+				fmt.Fprintf(w, "found %d at row %d, column %d\n", cell, row, column)
+				break search
+			}
+		}
+	}
+
+	/*
+	 * continue rows stops checking the current row at its first negative number
+	 * and goes on with the next row. */
+	matrix := [][]int{{1, 2, 3}, {4, -1, 6}, {7, 8, 9}}
+	var valid []int
+
+rows:
+	for i, cells := range matrix {
+		for _, cell := range cells {
+			if cell < 0 {
+				continue rows
+			}
+		}
+		valid = append(valid, i)
+	}
+	fmt.Fprintln(w, valid) // Output: [0 2]
+}
+
+func getLoopVariable(w io.Writer) {
+	/*
+	 * Since Go 1.22 (go 1.22 or later in go.mod), every iteration has its own loop variable.
+	 * A closure or a goroutine that captures i sees the value of its own iteration. */
+	var prints []func() int
+	for i := range 3 {
+		prints = append(prints, func() int { return i })
+	}
+	fmt.Fprintln(w, prints[0](), prints[1](), prints[2]()) // Output: 0 1 2
+
+	/*
+	 * Before Go 1.22, there was one i for the whole loop, so every closure returned its last value: 3 3 3.
+	 * The same happens today with a variable declared outside the loop. */
+	var shared []func() int
+	j := 0
+	for ; j < 3; j++ {
+		shared = append(shared, func() int { return j })
+	}
+	fmt.Fprintln(w, shared[0](), shared[1](), shared[2]()) // Output: 3 3 3
+
+	/*
+	 * Taking the address of the loop variable gives a different pointer in every iteration too,
+	 * so the old i := i copy inside the loop is no longer needed. */
+	var pointers []*int
+	for i := range 3 {
+		pointers = append(pointers, &i)
+	}
+	fmt.Fprintln(w, *pointers[0], *pointers[1], *pointers[2], pointers[0] != pointers[1]) // Output: 0 1 2 true
+}
@@ -0,0 +1,55 @@
+package control_flow
+
+import (
+	"fmt"
+	"io"
+)
+
+func getGoto(w io.Writer) {
+	/*
+	 * goto Label jumps to the labelled statement, forward or backward, in the same function.
+	 * The compiler refuses a goto that:
+	 * 1. Jumps over a variable declaration (the variable would exist without being set)
+	 * 2. Jumps into a block (e.g., into the body of an if or a for)
+	 *
+	 * It can make a retry loop, although a for loop says the same more clearly. */
+	attempts := 0
+
+retry:
+	attempts++
+	if err := connect(attempts); err != nil {
+		fmt.Fprintln(w, err) // Output: attempt 1 failed
+		goto retry
+	}
+	fmt.Fprintln(w, "connected after", attempts, "attempts") // Output: connected after 2 attempts
+
+	/*
+	 * goto also jumps forward, e.g., to shared clean-up code at the end of a function.
+	 * Go code usually does this with defer instead (see the defer lesson). */
+	fmt.Fprintln(w, parseAll([]string{"1", "2", "x", "4"})) // Output: parsed 2 values, then failed at "x"
+}
+
+// connect fails on the first attempt.
+func connect(attempt int) error {
+	if attempt < 2 {
+		return fmt.Errorf("attempt %d failed", attempt)
+	}
+	return nil
+}
+
+// parseAll jumps to fail at the first value that is not a digit.
+func parseAll(values []string) string {
+	parsed := 0
+	var bad string
+	for _, v := range values {
+		if len(v) != 1 || v[0] < '0' || v[0] > '9' {
+			bad = v
+			goto fail
+		}
+		parsed++
+	}
+	return fmt.Sprintf("parsed %d values", parsed)
+
+fail:
+	return fmt.Sprintf("parsed %d values, then failed at %q", parsed, bad)
+}
@@ -0,0 +1,92 @@
+package control_flow
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+func getIfElse(w io.Writer) {
+	/*
+	 * The condition must be a bool: if n { ... } does not compile for an int n.
+	 * else if chains more conditions; the first true one wins.
+	 *
+	 * Output:
+	 * -5 is negative
+	 * 0 is zero
+	 * 7 is odd
+	 * 12 is even */
+	for _, n := range []int{-5, 0, 7, 12} {
+		fmt.Fprintln(w, n, "is", classify(n))
+	}
+}
+
+func classify(n int) string {
+	if n < 0 {
+		return "negative"
+	} else if n == 0 {
+		return "zero"
+	} else if n%2 == 1 {
+		return "odd"
+	} else {
+		return "even"
+	}
+}
+
+func getIfWithStatement(w io.Writer) {
+	/*
+	 * A short statement can come before the condition, separated by a semicolon.
+	 * The variables it declares exist only in the if and its else branches,
+	 * which keeps them close to where they are checked. */
+	if n, err := strconv.Atoi("42"); err == nil {
+		fmt.Fprintln(w, n*2) // Output: 84
+	}
+
+	if _, err := strconv.Atoi("forty-two"); err != nil {
+		fmt.Fprintln(w, err) // Output: strconv.Atoi: parsing "forty-two": invalid syntax
+	}
+
+	/*
+	 * The comma ok forms (map lookup, type assertion, channel receive) are often written this way.
+	 * Here, age and ok are not visible after the if statement. */
+	ages := map[string]int{"Ana": 30}
+	if age, ok := ages["Ana"]; ok {
+		fmt.Fprintln(w, age) // Output: 30
+	} else {
+		fmt.Fprintln(w, "unknown")
+	}
+}
+
+var errEmptyName = errors.New("name is empty")
+
+func getEarlyReturn(w io.Writer) {
+	/*
+	 * Go code keeps the normal path on the left: handle the special cases first with if and return,
+	 * instead of nesting the normal path inside else branches.
+	 *
+	 * Output:
+	 * Hello, Ana!
+	 * name is empty
+	 * name is too long */
+	for _, name := range []string{"Ana", "", "Muhammad Fajar Setiawan"} {
+		greeting, err := greet(name)
+		if err != nil {
+			fmt.Fprintln(w, err)
+			continue
+		}
+		fmt.Fprintln(w, greeting)
+	}
+}
+
+// greet returns as soon as a check fails, so the last line is the normal path.
+func greet(name string) (string, error) {
+	if name == "" {
+		return "", errEmptyName
+	}
+	if len(name) > 20 {
+		return "", errors.New("name is too long")
+	}
+
+	return "Hello, " + name + "!", nil
+}
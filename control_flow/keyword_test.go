@@ -0,0 +1,51 @@
+package control_flow
+
+import (
+	"go/scanner"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/fajarstrtn/golang-tutorial/identifier"
+)
+
+// Every control flow and function call keyword of the identifier/keywords lesson must have an example here.
+func TestEveryControlFlowKeywordIsShown(t *testing.T) {
+	files, err := filepath.Glob("*.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	used := map[string]bool{}
+	for _, name := range files {
+		if strings.HasSuffix(name, "_test.go") || name == "exercises.go" {
+			continue
+		}
+
+		src, err := os.ReadFile(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var sc scanner.Scanner
+		sc.Init(token.NewFileSet().AddFile(name, -1, len(src)), src, nil, 0)
+		for {
+			_, tok, _ := sc.Scan()
+			if tok == token.EOF {
+				break
+			}
+			if tok.IsKeyword() {
+				used[tok.String()] = true
+			}
+		}
+	}
+
+	keywords := append(identifier.KeywordsIn(identifier.CONTROL_FLOW), identifier.KeywordsIn(identifier.FUNCTION_CALL)...)
+	for _, k := range keywords {
+		if !used[k.Name] {
+			t.Errorf("no lesson of control_flow uses %s", k.Name)
+		}
+	}
+}
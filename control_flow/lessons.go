@@ -0,0 +1,12 @@
+package control_flow
+
+import "github.com/fajarstrtn/golang-tutorial/lesson"
+
+func init() {
+	lesson.Register(lesson.Lesson{ID: "control_flow/if", Title: "if, else and return", Topic: "control_flow", Order: 1000, Run: GenerateIf})
+	lesson.Register(lesson.Lesson{ID: "control_flow/for", Title: "for, range, break, continue and labels", Topic: "control_flow", Order: 1010, Run: GenerateFor})
+	lesson.Register(lesson.Lesson{ID: "control_flow/switch", Title: "switch, case, default and fallthrough", Topic: "control_flow", Order: 1020, Run: GenerateSwitch})
+	lesson.Register(lesson.Lesson{ID: "control_flow/goto", Title: "goto", Topic: "control_flow", Order: 1030, Run: GenerateGoto})
+	lesson.Register(lesson.Lesson{ID: "control_flow/defer", Title: "defer", Topic: "control_flow", Order: 1040, Run: GenerateDefer})
+	lesson.Register(lesson.Lesson{ID: "control_flow/select", Title: "select and go", Topic: "control_flow", Order: 1050, Run: GenerateSelect})
+}
@@ -0,0 +1,102 @@
+package control_flow
+
+import (
+	"fmt"
+	"io"
+	"slices"
+)
+
+func getSelect(w io.Writer) {
+	/*
+	 * go f() runs f in a new goroutine, at the same time as the caller (see the concurrency lessons).
+	 * select blocks until one of its cases can proceed, then runs that case.
+	 * When several are ready at once, it picks one at random: the order of the cases means nothing.
+	 *
+	 * Here two goroutines send on their own channel; the loop receives from whichever is ready
+	 * until it has both results. The order they arrive in varies, so they are sorted before printing. */
+	squares := make(chan int)
+	cubes := make(chan int)
+
+	go func() { squares <- 3 * 3 }()
+	go func() { cubes <- 3 * 3 * 3 }()
+
+	var got []string
+	for range 2 {
+		select {
+		case n := <-squares:
+			got = append(got, fmt.Sprint("square ", n))
+		case n := <-cubes:
+			got = append(got, fmt.Sprint("cube ", n))
+		}
+	}
+	slices.Sort(got)
+	fmt.Fprintln(w, got) // Output: [cube 27 square 9]
+}
+
+func getSelectDefault(w io.Writer) {
+	/*
+	 * With a default case, select never blocks: default runs when no other case is ready.
+	 * It makes a send or receive that gives up instead of waiting. */
+	messages := make(chan string, 1)
+	fmt.Fprintln(w, trySend(messages, "first"), trySend(messages, "second")) // Output: true false
+
+	message, ok := tryReceive(messages)
+	fmt.Fprintln(w, message, ok) // Output: first true
+
+	message, ok = tryReceive(messages)
+	fmt.Fprintf(w, "%q %v\n", message, ok) // Output: "" false
+}
+
+// trySend sends unless the channel is full.
+func trySend(messages chan string, message string) bool {
+	select {
+	case messages <- message:
+		return true
+	default:
+		return false
+	}
+}
+
+// tryReceive receives unless the channel is empty.
+func tryReceive(messages chan string) (string, bool) {
+	select {
+	case message := <-messages:
+		return message, true
+	default:
+		return "", false
+	}
+}
+
+func getSelectNilChannel(w io.Writer) {
+	/*
+	 * A send or receive on a nil channel blocks forever, so its case is never chosen.
+	 * Setting a channel variable to nil once it is closed turns its case off,
+	 * and the loop ends when both channels are done. */
+	letters := make(chan string, 2)
+	digits := make(chan string, 2)
+	letters <- "a"
+	letters <- "b"
+	digits <- "1"
+	close(letters)
+	close(digits)
+
+	var got []string
+	for letters != nil || digits != nil {
+		select {
+		case s, ok := <-letters:
+			if !ok {
+				letters = nil
+				continue
+			}
+			got = append(got, s)
+		case s, ok := <-digits:
+			if !ok {
+				digits = nil
+				continue
+			}
+			got = append(got, s)
+		}
+	}
+	slices.Sort(got)
+	fmt.Fprintln(w, got) // Output: [1 a b]
+}
@@ -0,0 +1,143 @@
+package control_flow
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+func getSwitchWithTag(w io.Writer) {
+	/*
+	 * A switch with a tag compares it with the values of each case, from top to bottom.
+	 * 1. A case can list several values, separated by commas
+	 * 2. default runs when no case matches, wherever it is written
+	 *
+	 * Output:
+	 * Saturday: weekend
+	 * Monday: weekday
+	 * Someday: not a day */
+	for _, day := range []string{"Saturday", "Monday", "Someday"} {
+		fmt.Fprintf(w, "%s: %s\n", day, dayKind(day))
+	}
+
+	// Like if, a switch can start with a short statement, whose variables exist only inside the switch.
+	switch length := len("gopher"); length {
+	case 0:
+		fmt.Fprintln(w, "empty")
+	default:
+		fmt.Fprintln(w, length, "letters") // Output: 6 letters
+	}
+}
+
+func dayKind(day string) string {
+	switch day {
+	case "Saturday", "Sunday":
+		return "weekend"
+	default:
+		return "not a day"
+	case "Monday", "Tuesday", "Wednesday", "Thursday", "Friday":
+		return "weekday"
+	}
+}
+
+func getSwitchWithoutTag(w io.Writer) {
+	/*
+	 * A switch without a tag is switch true: every case is a bool condition,
+	 * and the first true one runs. It is a cleaner way to write a long if else if chain.
+	 *
+	 * Output:
+	 * 95 A
+	 * 80 B
+	 * 42 F */
+	for _, score := range []int{95, 80, 42} {
+		fmt.Fprintln(w, score, grade(score))
+	}
+}
+
+func grade(score int) string {
+	switch {
+	case score >= 90:
+		return "A"
+	case score >= 75:
+		return "B"
+	case score >= 60:
+		return "C"
+	default:
+		return "F"
+	}
+}
+
+func getFallthrough(w io.Writer) {
+	/*
+	 * fallthrough, as the last statement of a case, continues into the body of the next case.
+	 * The next case's values or condition are not checked: its body runs unconditionally.
+	 * It cannot be used in the last case or in a type switch.
+	 *
+	 * Output:
+	 * level 3: admin, write, read
+	 * level 2: write, read
+	 * level 1: read */
+	for _, level := range []int{3, 2, 1} {
+		fmt.Fprintf(w, "level %d: %s\n", level, strings.Join(permissions(level), ", "))
+	}
+
+	// n > 100 is false, yet its case runs, because the case before it ends with fallthrough.
+	n := 5
+	var got []string
+	switch {
+	case n > 0:
+		got = append(got, "positive")
+		fallthrough
+	case n > 100:
+		got = append(got, "more than 100?")
+	case n > 1:
+		got = append(got, "never reached")
+	}
+	fmt.Fprintln(w, got) // Output: [positive more than 100?]
+}
+
+// permissions grants a level its own permission and, by falling through, those of the levels below it.
+func permissions(level int) []string {
+	var granted []string
+	switch level {
+	case 3:
+		granted = append(granted, "admin")
+		fallthrough
+	case 2:
+		granted = append(granted, "write")
+		fallthrough
+	case 1:
+		granted = append(granted, "read")
+	}
+	return granted
+}
+
+func getBreakInSwitch(w io.Writer) {
+	/*
+	 * break inside a switch leaves the switch, not the loop around it.
+	 * To leave the loop from a case, break with the label of the loop. */
+	commands := []string{"add", "skip", "add", "stop", "add"}
+
+	count := 0
+	for _, command := range commands {
+		switch command {
+		case "stop":
+			break
+		case "add":
+			count++
+		}
+	}
+	fmt.Fprintln(w, count) // Output: 3
+
+	count = 0
+loop:
+	for _, command := range commands {
+		switch command {
+		case "stop":
+			break loop
+		case "add":
+			count++
+		}
+	}
+	fmt.Fprintln(w, count) // Output: 2
+}
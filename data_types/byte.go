@@ -2,10 +2,11 @@ package data_types
 
 import (
 	"fmt"
+	"io"
 	"unsafe"
 )
 
-func getBytes() {
+func getBytes(w io.Writer) {
 	/*
 	 * The byte data type is exactly the same as uint8,
 	 * where its size is 1 byte (8 bits).
@@ -19,16 +20,16 @@ func getBytes() {
 	 * The reason of using byte instead of uint8 is
 	 * because byte makes your code more meaningful. */
 	var b byte = 255
-	fmt.Printf("%d\n", b)                // Output: 255
-	fmt.Printf("%c\n", b)                // Output: ÿ
-	fmt.Printf("%T\n", b)                // Output: uint8
-	fmt.Printf("%d\n", unsafe.Sizeof(b)) // Output: 1
+	fmt.Fprintf(w, "%d\n", b)                // Output: 255
+	fmt.Fprintf(w, "%c\n", b)                // Output: ÿ
+	fmt.Fprintf(w, "%T\n", b)                // Output: uint8
+	fmt.Fprintf(w, "%d\n", unsafe.Sizeof(b)) // Output: 1
 
 	// In Go, string is slice of bytes.
 	text := "Hello World"
-	fmt.Printf("%v\n", text[0]) // Output: 72
-	fmt.Printf("%c\n", text[0]) // Output: H
+	fmt.Fprintf(w, "%v\n", text[0]) // Output: 72
+	fmt.Fprintf(w, "%c\n", text[0]) // Output: H
 
 	binary := []byte(text)
-	fmt.Printf("%v\n", binary) // Output: [72 101 108 108 111 32 87 111 114 108 100]
+	fmt.Fprintf(w, "%v\n", binary) // Output: [72 101 108 108 111 32 87 111 114 108 100]
 }
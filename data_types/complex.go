@@ -2,20 +2,23 @@ package data_types
 
 import (
 	"fmt"
+	"io"
+	"math"
+	"math/cmplx"
 	"unsafe"
 )
 
-func getComplexNumbers() {
+func getComplexNumbers(w io.Writer) {
 	var a complex64 = complex(5, 2)
 	var b complex128 = complex(9, 7)
 
-	fmt.Printf("%v\n", a)                // Output: (5+2i)
-	fmt.Printf("%T\n", a)                // Output: complex64
-	fmt.Printf("%d\n", unsafe.Sizeof(a)) // Output: 8
+	fmt.Fprintf(w, "%v\n", a)                // Output: (5+2i)
+	fmt.Fprintf(w, "%T\n", a)                // Output: complex64
+	fmt.Fprintf(w, "%d\n", unsafe.Sizeof(a)) // Output: 8
 
-	fmt.Printf("%v\n", b)                // Output: (9+7i)
-	fmt.Printf("%T\n", b)                // Output: complex128
-	fmt.Printf("%d\n", unsafe.Sizeof(b)) // Output: 16
+	fmt.Fprintf(w, "%v\n", b)                // Output: (9+7i)
+	fmt.Fprintf(w, "%T\n", b)                // Output: complex128
+	fmt.Fprintf(w, "%d\n", unsafe.Sizeof(b)) // Output: 16
 
 	/*
 	 * There are few built-in functions in complex numbers:
@@ -26,10 +29,97 @@ func getComplexNumbers() {
 	realNumber := real(val)
 	imagNumber := imag(val)
 
-	fmt.Printf("%v\n", val)        // Output: (10+5i)
-	fmt.Printf("%T\n", val)        // Output: complex128
-	fmt.Printf("%v\n", realNumber) // Output: 10
-	fmt.Printf("%T\n", realNumber) // Output: float64
-	fmt.Printf("%v\n", imagNumber) // Output: 5
-	fmt.Printf("%T\n", imagNumber) // Output: float64
+	fmt.Fprintf(w, "%v\n", val)        // Output: (10+5i)
+	fmt.Fprintf(w, "%T\n", val)        // Output: complex128
+	fmt.Fprintf(w, "%v\n", realNumber) // Output: 10
+	fmt.Fprintf(w, "%T\n", realNumber) // Output: float64
+	fmt.Fprintf(w, "%v\n", imagNumber) // Output: 5
+
+	// The complex numbers lesson (data_types/complex) shows arithmetic, the polar form and math/cmplx.
+	fmt.Fprintf(w, "%T\n", imagNumber) // Output: float64
+}
+
+func getComplexArithmetic(w io.Writer) {
+	/*
+	 * A complex number a+bi has a real part a and an imaginary part b, where i*i is -1.
+	 * + - * / work on complex numbers like on the other numbers:
+	 * 1. (a+bi) + (c+di) = (a+c) + (b+d)i
+	 * 2. (a+bi) * (c+di) = (ac-bd) + (ad+bc)i, because bi*di is -bd
+	 * 3. (a+bi) / (c+di) multiplies both by the conjugate c-di, so that the divisor becomes real
+	 *
+	 * An imaginary literal is a number followed by i (e.g., 2i, 0.5i, 1e3i). */
+	x := 3 + 4i
+	y := 1 - 2i
+	fmt.Fprintln(w, x+y, x-y)  // Output: (4+2i) (2+6i)
+	fmt.Fprintln(w, x*y, x/y)  // Output: (11-2i) (-1+2i)
+	fmt.Fprintln(w, 1i*1i)     // Output: (-1+0i)
+	fmt.Fprintln(w, x == 3+4i) // Output: true
+
+	/*
+	 * The math package only works with real numbers, math/cmplx has the complex versions:
+	 * 1. cmplx.Abs  : The modulus |z|, the distance from 0 (e.g., |3+4i| is 5)
+	 * 2. cmplx.Conj : The conjugate, with the sign of the imaginary part flipped
+	 * 3. cmplx.Sqrt, cmplx.Exp, cmplx.Log, cmplx.Pow, cmplx.Sin, ...: The usual functions
+	 *
+	 * The square root of -1 does not exist with real numbers, but it does with complex numbers. */
+	fmt.Fprintln(w, cmplx.Abs(x), cmplx.Conj(x))   // Output: 5 (3-4i)
+	fmt.Fprintln(w, math.Sqrt(-1), cmplx.Sqrt(-1)) // Output: NaN (0+1i)
+
+	// cmplx.Pow goes through the polar form (angles and math.Pi), so even i^2 is a little off.
+	fmt.Fprintln(w, cmplx.Pow(1i, 2)) // Output: (-1+1.2246467991473515e-16i)
+
+	// Dividing by zero does not panic; it gives infinities and NaNs, like with floats.
+	zero := complex(0, 0)
+	fmt.Fprintln(w, x/zero, cmplx.IsInf(x/zero)) // Output: (+Inf+Infi) true
+}
+
+func getComplexPolar(w io.Writer) {
+	/*
+	 * A complex number is also a point of the plane: a+bi is (a, b). It can be written in two forms:
+	 * 1. Rectangular: a+bi, its coordinates
+	 * 2. Polar      : r∠θ, its distance r from 0 (cmplx.Abs) and its angle θ from the real axis (cmplx.Phase)
+	 *
+	 * cmplx.Polar converts to the polar form and cmplx.Rect back: a is r cos θ and b is r sin θ.
+	 * Multiplying two complex numbers multiplies their r and adds their θ. */
+	r, theta := cmplx.Polar(1 + 1i)
+	fmt.Fprintln(w, r, theta)                      // Output: 1.4142135623730951 0.7853981633974483
+	fmt.Fprintln(w, theta*180/math.Pi)             // Output: 45
+	fmt.Fprintln(w, cmplx.Phase(-1))               // Output: 3.141592653589793
+	fmt.Fprintln(w, PolarForm(1i))                 // Output: 1∠1.5707963267948966 rad (90°)
+	fmt.Fprintf(w, "%.4f\n", cmplx.Rect(r, theta)) // Output: (1.0000+1.0000i)
+
+	/*
+	 * Euler's formula: e^(iθ) is cos θ + i sin θ, the point at angle θ on the circle of radius 1.
+	 * So e^(iπ) is -1. The tiny imaginary part is there because math.Pi is not exactly π,
+	 * and sin(math.Pi) is not exactly 0. */
+	fmt.Fprintln(w, cmplx.Exp(1i*math.Pi))          // Output: (-1+1.2246467991473515e-16i)
+	fmt.Fprintf(w, "%.2f\n", cmplx.Exp(1i*math.Pi)) // Output: (-1.00+0.00i)
+}
+
+func getComplexPrecision(w io.Writer) {
+	/*
+	 * complex64 is made of two float32 and complex128 of two float64,
+	 * so complex64 keeps about 7 significant digits and complex128 about 16.
+	 * Every operation rounds both parts, and the errors add up like with floats.
+	 *
+	 * math/cmplx only has complex128 functions, so complex64 values must be converted,
+	 * and the result rounded back: complex64(cmplx.Sqrt(complex128(z))). */
+	var a64, b64 complex64 = 0.1 + 0.2i, 3
+	var a128, b128 complex128 = 0.1 + 0.2i, 3
+	fmt.Fprintln(w, a64*b64)   // Output: (0.3+0.6i)
+	fmt.Fprintln(w, a128*b128) // Output: (0.30000000000000004+0.6000000000000001i)
+
+	/*
+	 * math.Pi rounded to float32 is further from π, so e^(iπ) is further from -1
+	 * (the imaginary part should be 0). */
+	var pi64 complex64 = math.Pi
+	fmt.Fprintln(w, complex64(cmplx.Exp(complex128(1i*pi64)))) // Output: (-1-8.742278e-08i)
+	fmt.Fprintln(w, cmplx.Exp(1i*math.Pi))                     // Output: (-1+1.2246467991473515e-16i)
+
+	/*
+	 * ParseComplexExpression computes an expression both ways.
+	 *
+	 * Try your own expressions with go run . complex (e.g., go run . complex '(3+4i)*(1-2i)' 'sqrt(-1)' 'exp(i*pi)'). */
+	expr, _ := ParseComplexExpression("1/3 + 2i/3")
+	fmt.Fprintln(w, expr.Complex64, expr.Complex128) // Output: (0.33333334+0.6666667i) (0.3333333333333333+0.6666666666666666i)
 }
@@ -0,0 +1,216 @@
+package data_types
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"math"
+	"math/cmplx"
+	"sort"
+	"strings"
+)
+
+/*
+ * A ComplexExpression is an expression computed twice:
+ * once with complex64 values (two float32) and once with complex128 values (two float64). */
+type ComplexExpression struct {
+	Source     string
+	Complex64  complex64
+	Complex128 complex128
+}
+
+// The names ParseComplexExpression knows besides numbers, and their values.
+var complexConstants = map[string]complex128{
+	"i":   1i,
+	"pi":  math.Pi,
+	"e":   math.E,
+	"Inf": cmplx.Inf(),
+	"NaN": cmplx.NaN(),
+}
+
+/*
+ * The functions ParseComplexExpression knows, from the math/cmplx package.
+ * abs, phase, real and imag return a real number, as a complex number whose imaginary part is 0. */
+var complexFunctions = map[string]func(complex128) complex128{
+	"abs":   func(z complex128) complex128 { return complex(cmplx.Abs(z), 0) },
+	"phase": func(z complex128) complex128 { return complex(cmplx.Phase(z), 0) },
+	"real":  func(z complex128) complex128 { return complex(real(z), 0) },
+	"imag":  func(z complex128) complex128 { return complex(imag(z), 0) },
+	"conj":  cmplx.Conj,
+	"sqrt":  cmplx.Sqrt,
+	"exp":   cmplx.Exp,
+	"log":   cmplx.Log,
+	"log10": cmplx.Log10,
+	"sin":   cmplx.Sin,
+	"cos":   cmplx.Cos,
+	"tan":   cmplx.Tan,
+	"asin":  cmplx.Asin,
+	"acos":  cmplx.Acos,
+	"atan":  cmplx.Atan,
+	"sinh":  cmplx.Sinh,
+	"cosh":  cmplx.Cosh,
+	"tanh":  cmplx.Tanh,
+}
+
+// The functions of two arguments: pow(x, y) is x to the power y, rect(r, θ) is the number of modulus r and angle θ.
+var complexFunctions2 = map[string]func(complex128, complex128) complex128{
+	"pow":  cmplx.Pow,
+	"rect": func(r, theta complex128) complex128 { return cmplx.Rect(real(r), real(theta)) },
+}
+
+/*
+ * ParseComplexExpression computes an expression such as (3+4i)*(1-2i), sqrt(-1) or exp(i*pi)
+ * with complex64 and with complex128 values, the way a Go program does with variables.
+ *
+ * It understands:
+ * 1. Numbers in any Go notation, including imaginary literals (e.g., 2i, 1.5e3i)
+ * 2. + - * /, parentheses, and the constants i, pi, e, Inf and NaN
+ * 3. The math/cmplx functions abs, phase, conj, sqrt, exp, log, log10, pow(x, y), rect(r, θ),
+ *    the trigonometric and hyperbolic functions, and real and imag
+ *
+ * math/cmplx only works with complex128, so for complex64 every function result
+ * is rounded back to complex64, like complex64(cmplx.Sqrt(complex128(z))) in a program. */
+func ParseComplexExpression(src string) (ComplexExpression, error) {
+	expr, err := parser.ParseExpr(src)
+	if err != nil {
+		return ComplexExpression{}, fmt.Errorf("%s is not a Go expression: %w", src, err)
+	}
+
+	c64, err := evalComplex[complex64](expr)
+	if err != nil {
+		return ComplexExpression{}, err
+	}
+	c128, err := evalComplex[complex128](expr)
+	if err != nil {
+		return ComplexExpression{}, err
+	}
+
+	return ComplexExpression{Source: src, Complex64: c64, Complex128: c128}, nil
+}
+
+// evalComplex evaluates expr with values of type T, so + - * / are the real complex64 or complex128 operations.
+func evalComplex[T complex64 | complex128](expr ast.Expr) (T, error) {
+	switch e := expr.(type) {
+	case *ast.BasicLit:
+		return parseComplex[T](e, false)
+
+	case *ast.Ident:
+		if z, ok := complexConstants[e.Name]; ok {
+			return T(z), nil
+		}
+
+	case *ast.ParenExpr:
+		return evalComplex[T](e.X)
+
+	case *ast.UnaryExpr:
+		// A negative literal is one constant (-1 is -1+0i), while negating a value also negates its zero parts (-0i).
+		if lit, ok := e.X.(*ast.BasicLit); ok && e.Op == token.SUB {
+			return parseComplex[T](lit, true)
+		}
+		x, err := evalComplex[T](e.X)
+		if err != nil {
+			return 0, err
+		}
+		switch e.Op {
+		case token.ADD:
+			return x, nil
+		case token.SUB:
+			return -x, nil
+		}
+
+	case *ast.BinaryExpr:
+		x, err := evalComplex[T](e.X)
+		if err != nil {
+			return 0, err
+		}
+		y, err := evalComplex[T](e.Y)
+		if err != nil {
+			return 0, err
+		}
+		switch e.Op {
+		case token.ADD:
+			return x + y, nil
+		case token.SUB:
+			return x - y, nil
+		case token.MUL:
+			return x * y, nil
+		case token.QUO:
+			return x / y, nil
+		}
+
+	case *ast.CallExpr:
+		name := types.ExprString(e.Fun)
+		args := make([]complex128, len(e.Args))
+		for i, arg := range e.Args {
+			x, err := evalComplex[T](arg)
+			if err != nil {
+				return 0, err
+			}
+			args[i] = complex128(x)
+		}
+
+		if f, ok := complexFunctions[name]; ok && len(args) == 1 {
+			return T(f(args[0])), nil
+		}
+		if f, ok := complexFunctions2[name]; ok && len(args) == 2 {
+			return T(f(args[0], args[1])), nil
+		}
+		if _, ok := complexFunctions2[name]; ok {
+			return 0, fmt.Errorf("%s takes 2 arguments", name)
+		}
+		if _, ok := complexFunctions[name]; ok {
+			return 0, fmt.Errorf("%s takes 1 argument", name)
+		}
+		return 0, fmt.Errorf("unknown function %s (known: %s)", name, strings.Join(ComplexFunctionNames(), ", "))
+	}
+
+	return 0, fmt.Errorf("unsupported expression %s: use numbers, i, + - * /, parentheses, pi, e and math/cmplx functions", types.ExprString(expr))
+}
+
+/*
+ * parseComplex rounds a literal straight to the precision of T, like a conversion at run time does.
+ * go/constant reads every Go notation (e.g., 0x10, 1_000, 2.5e3i); a number too large becomes an infinity. */
+func parseComplex[T complex64 | complex128](lit *ast.BasicLit, negative bool) (T, error) {
+	value := constant.MakeFromLiteral(lit.Value, lit.Kind, 0)
+	if value.Kind() == constant.Unknown || lit.Kind == token.CHAR || lit.Kind == token.STRING {
+		return 0, fmt.Errorf("%s is not a number", lit.Value)
+	}
+	if negative {
+		value = constant.UnaryOp(token.SUB, value, 0)
+	}
+
+	var zero T
+	if _, ok := any(zero).(complex64); ok {
+		re, _ := constant.Float32Val(constant.ToFloat(constant.Real(value)))
+		im, _ := constant.Float32Val(constant.ToFloat(constant.Imag(value)))
+		return T(complex(re, im)), nil
+	}
+	re, _ := constant.Float64Val(constant.ToFloat(constant.Real(value)))
+	im, _ := constant.Float64Val(constant.ToFloat(constant.Imag(value)))
+	return T(complex(re, im)), nil
+}
+
+// ComplexFunctionNames returns the names of the functions ParseComplexExpression knows, sorted.
+func ComplexFunctionNames() []string {
+	var names []string
+	for name := range complexFunctions {
+		names = append(names, name)
+	}
+	for name := range complexFunctions2 {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+/*
+ * PolarForm writes z as r∠θ: its modulus r (cmplx.Abs, the distance from 0)
+ * and its phase θ (cmplx.Phase, the angle from the positive real axis, from -π to π),
+ * with θ in radians and in degrees. z is r(cos θ + i sin θ), or r·e^(iθ). */
+func PolarForm(z complex128) string {
+	r, theta := cmplx.Polar(z)
+	return fmt.Sprintf("%v∠%v rad (%v°)", r, theta, theta*180/math.Pi)
+}
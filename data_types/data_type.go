@@ -9,9 +9,18 @@
  * The basic data types are further categorized into three subcategories which are:
  * 1. Numbers
  * 2. Strings
- * 3. Booleans */
+ * 3. Booleans
+ *
+ * Arrays, slices and maps have their own lessons in the composite_types package,
+ * structs in the struct_types package, pointers in the pointer_types package,
+ * interface types in the interface_types package, and channels in the concurrency package.
+ *
+ * The number lessons repeat the same arithmetic for every numeric type;
+ * the generics package writes it once, with type parameters. */
 package data_types
 
+import "github.com/fajarstrtn/golang-tutorial/lesson"
+
 /*
  * Numbers are divided into three sub-categories that are:
  * 1. Integers              : Both signed (can store both positive and negative values)
@@ -21,7 +30,7 @@ package data_types
  * 3. Complex Numbers       : The in-built function creates a complex number
  * from its imaginary and real part and in-built imaginary and real function extract those parts.
  * float32 and float64 are also part of these complex numbers. */
-func GenerateNumbers() {
+func GenerateNumbers(env *lesson.Env) {
 	/*
 	 * These are five types of signed integers:
 	 * 1. int  : Depends on platform (32 bits in 32-bit system and 64 bits in 64-bit system),
@@ -31,7 +40,7 @@ func GenerateNumbers() {
 	 * 3. int16: 16-bit signed integer, Range -32768 to 32767.
 	 * 4. int32: 32-bit signed integer, Range -2147483648 to 2147483647.
 	 * 5. int64: 64-bit signed integer, Range -9223372036854775808 to 9223372036854775807. */
-	getSignedIntegers()
+	getSignedIntegers(env.Out)
 
 	/*
 	 * These are five types of unsigned integers:
@@ -42,7 +51,7 @@ func GenerateNumbers() {
 	 * 3. uint16: 16-bit unsigned integer, Range 0 to 65535.
 	 * 4. uint32: 32-bit unsigned integer, Range 0 to 4294967295.
 	 * 5. uint64: 64-bit unsigned integer, Range 0 to 18446744073709551615. */
-	getUnsignedIntegers()
+	getUnsignedIntegers(env.Out)
 
 	/*
 	 * There are also other special built-in integer data types:
@@ -59,15 +68,15 @@ func GenerateNumbers() {
 	 * Understanding them is very important,
 	 * especially when dealing with text,
 	 * binary data, and low-level programming. */
-	getBytes()
-	getRune()
-	getUintptr()
+	getBytes(env.Out)
+	getRune(env.Out)
+	getUintptr(env)
 
 	/*
 	 * There are two types of complex numbers:
 	 * 1. complex64 : Contains float32 as a real and imaginary component.
 	 * 2. complex128: Contains float64 as a real and imaginary component. */
-	getComplexNumbers()
+	getComplexNumbers(env.Out)
 
 	/*
 	 * There are two types of floating-point data types:
@@ -78,7 +87,75 @@ func GenerateNumbers() {
 	 * 1. decimal    : 3.15
 	 * 2. exponential: 12e18 or 3E10
 	 * 3. mixed      : 13.16e12 */
-	getFloatNumbers()
+	getFloatNumbers(env.Out)
+}
+
+/*
+ * Integers have a fixed number of bits, so they can only hold a fixed range of values.
+ *
+ * What happens at the edges of that range:
+ * 1. Overflow  : A result above the maximum wraps around to the minimum (int8 127 + 1 is -128)
+ * 2. Underflow : A result below the minimum wraps around to the maximum (uint8 0 - 1 is 255)
+ * 3. Narrowing : Converting to a smaller type keeps only the lowest bits (int8(int16(300)) is 44)
+ * 4. Division by zero: Panics at run time, because there is no result at all
+ *
+ * None of them is reported by the compiler when variables are involved,
+ * so knowing the limits of each type is the only protection.
+ *
+ * Try your own operands with go run . overflow (e.g., go run . overflow int16 16000 '*' 120). */
+func GenerateIntegerOverflow(env *lesson.Env) {
+	getIntegerLimits(env.Out)
+	getIntegerOverflow(env.Out)
+	getIntegerConversions(env.Out)
+	getIntegerDivisionByZero(env.Out)
+}
+
+/*
+ * Floating-point numbers follow the IEEE 754 standard: a sign, an exponent and a mantissa,
+ * all in binary. Most decimal fractions (e.g., 0.1) cannot be stored exactly,
+ * so every float is the nearest representable number, and every operation rounds again.
+ *
+ * This lesson looks at:
+ * 1. The bits     : How a number is split into sign, exponent and mantissa
+ * 2. The gaps     : The neighbours of a number and the ULP between them
+ * 3. Special values: ±0, ±Inf, NaN and subnormals
+ * 4. Rounding     : Why float32 and float64 give different results for the same expression */
+func GenerateFloatingPoint(env *lesson.Env) {
+	getFloatBits(env.Out)
+	getFloatSpecialValues(env.Out)
+	getFloatRounding(env.Out)
+}
+
+/*
+ * Complex numbers have a real and an imaginary part, and are built into the language:
+ * complex64 and complex128, imaginary literals (e.g., 2i), and the functions complex, real and imag.
+ * The math/cmplx package has the rest (e.g., cmplx.Abs, cmplx.Sqrt, cmplx.Exp).
+ *
+ * This lesson looks at:
+ * 1. Arithmetic: + - * / and the math/cmplx functions
+ * 2. Polar form: The modulus and the angle of a complex number, and Euler's formula
+ * 3. Precision : complex64 against complex128 */
+func GenerateComplexNumbers(env *lesson.Env) {
+	getComplexArithmetic(env.Out)
+	getComplexPolar(env.Out)
+	getComplexPrecision(env.Out)
+}
+
+/*
+ * Every value takes a fixed number of bytes in memory, and starts at an address
+ * that is a multiple of its alignment. In a struct, the compiler adds padding between fields
+ * to keep each of them aligned, so the order of the fields changes the size of the struct.
+ *
+ * This lesson looks at:
+ * 1. unsafe.Sizeof, unsafe.Alignof and unsafe.Offsetof
+ * 2. Padding, drawn byte by byte
+ * 3. Reordering fields to remove the padding
+ * 4. The only valid ways to do arithmetic on addresses with uintptr */
+func GenerateMemoryLayout(env *lesson.Env) {
+	getSizeAndAlignment(env.Out)
+	getPadding(env.Out)
+	getFieldReordering(env.Out)
+	getUintptrPatterns(env)
 }
 
 /*
@@ -95,9 +172,24 @@ func GenerateNumbers() {
  * without any confusion and limitation of the page.
  *
  * Generally, strings are enclosed in double-quotes (""). */
-func GenerateStrings() {
-	getStrings()
-	manipulateStrings()
+func GenerateStrings(env *lesson.Env) {
+	getStrings(env.Out)
+	manipulateStrings(env.Out)
+}
+
+/*
+ * A string is a sequence of bytes, usually UTF-8, and a rune is one code point.
+ * What a reader sees as one character can be several runes (a grapheme cluster),
+ * and a string can also hold bytes that are not valid UTF-8 at all.
+ *
+ * This lesson looks at:
+ * 1. Bytes    : How UTF-8 encodes a code point in 1 to 4 bytes
+ * 2. Graphemes: Combining marks, emoji ZWJ sequences, skin tones and flags
+ * 3. Invalid  : What decoding does with bytes that are not UTF-8 */
+func GenerateUTF8(env *lesson.Env) {
+	getUTF8Bytes(env.Out)
+	getGraphemeClusters(env.Out)
+	getInvalidUTF8(env.Out)
 }
 
 /*
@@ -109,6 +201,6 @@ func GenerateStrings() {
  * The default value of a boolean data type is false.
  *
  * Boolean values are mostly used for conditional testing. */
-func GenerateBooleans() {
-	getBooleans()
+func GenerateBooleans(env *lesson.Env) {
+	getBooleans(env.Out)
 }
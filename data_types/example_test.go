@@ -0,0 +1,408 @@
+// Code generated by "go run . examples"; DO NOT EDIT.
+
+package data_types_test
+
+import (
+	"os"
+
+	"github.com/fajarstrtn/golang-tutorial/data_types"
+	"github.com/fajarstrtn/golang-tutorial/lesson"
+)
+
+// ExampleGenerateBooleans runs the data_types/booleans lesson.
+func ExampleGenerateBooleans() {
+	data_types.GenerateBooleans(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// bool1? true
+	// bool2? true
+	// bool3? false
+	// bool4? false
+	// bool1 == bool2? true
+	// bool2 == bool3? false
+	// bool3 == bool4? true
+	// bool4 == bool1? false
+	// 1
+	// 1
+	// 1
+	// 1
+}
+
+// ExampleGenerateComplexNumbers runs the data_types/complex lesson.
+func ExampleGenerateComplexNumbers() {
+	data_types.GenerateComplexNumbers(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// (4+2i) (2+6i)
+	// (11-2i) (-1+2i)
+	// (-1+0i)
+	// true
+	// 5 (3-4i)
+	// NaN (0+1i)
+	// (-1+1.2246467991473515e-16i)
+	// (+Inf+Infi) true
+	// 1.4142135623730951 0.7853981633974483
+	// 45
+	// 3.141592653589793
+	// 1∠1.5707963267948966 rad (90°)
+	// (1.0000+1.0000i)
+	// (-1+1.2246467991473515e-16i)
+	// (-1.00+0.00i)
+	// (0.3+0.6i)
+	// (0.30000000000000004+0.6000000000000001i)
+	// (-1-8.742278e-08i)
+	// (-1+1.2246467991473515e-16i)
+	// (0.33333334+0.6666667i) (0.3333333333333333+0.6666666666666666i)
+}
+
+// ExampleGenerateFloatingPoint runs the data_types/ieee754 lesson.
+func ExampleGenerateFloatingPoint() {
+	data_types.GenerateFloatingPoint(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// 11000000001000000000000000000000
+	// 1 10000000 01000000000000000000000
+	// (-1)^1 x 2^(128 - 127) x 1.01 (binary)
+	// 0.1 0.1
+	// 0.100000001490116119384765625
+	// 0.1000000000000000055511151231257827021181583404541015625
+	// 0 01111011 10011001100110011001101
+	// 0.10000000149011611938
+	// 0.10000000149011612
+	// false
+	// 1.0000000000000002
+	// 1.0000001
+	// 1.1920928955078125e-07
+	// 2.220446049250313e-16
+	// 2
+	// true
+	// -0 true
+	// -Inf true
+	// 1 00000000000
+	// +Inf -Inf true
+	// +Inf
+	// NaN false true
+	// NaN
+	// NaN
+	// 5e-324
+	// subnormal
+	// normal
+	// 0
+	// 19.602001 19.602
+	// 7.489999771118164 12.112000465393066
+	// 90.718880 90.7188796997
+	// 0.30000000000000004 false
+	// 0.3 true
+	// 1.0000001 0.9999999999999999
+	// true
+	// 19.602001 19.602
+}
+
+// ExampleGenerateIntegerOverflow runs the data_types/overflow lesson.
+func ExampleGenerateIntegerOverflow() {
+	data_types.GenerateIntegerOverflow(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// int8    8 bits  -128 to 127
+	// int16   16 bits -32768 to 32767
+	// int32   32 bits -2147483648 to 2147483647
+	// int64   64 bits -9223372036854775808 to 9223372036854775807
+	// uint8   8 bits  0 to 255
+	// uint16  16 bits 0 to 65535
+	// uint32  32 bits 0 to 4294967295
+	// uint64  64 bits 0 to 18446744073709551615
+	// byte    8 bits  0 to 255
+	// rune    32 bits -2147483648 to 2147483647
+	// int     64 bits -9223372036854775808 to 9223372036854775807
+	// uint    64 bits 0 to 18446744073709551615
+	// uintptr 64 bits 0 to 18446744073709551615
+	// -128 127
+	// 65535
+	// -9223372036854775808
+	// 18446744073709551615
+	//    0 = 00000000
+	//    1 = 00000001
+	//  127 = 01111111
+	//   -1 = 11111111
+	//   -2 = 11111110
+	// -128 = 10000000
+	// -128
+	// 10000000
+	// 127
+	// 0
+	// 255
+	// 18446744073709551016
+	// 19456
+	// 1920000
+	// 111010100110000000000
+	// 0100110000000000
+	// 19456
+	// -128
+	// -128
+	// true
+	// 0 1
+	// 65536 0
+	// 44
+	// 255
+	// 4294967295
+	// 5
+	// 233
+	// -56
+	// -56
+	// 1111111111001000
+	// runtime error: integer divide by zero
+	// 3 <nil>
+	// +Inf -Inf NaN
+}
+
+// ExampleGenerateMemoryLayout runs the data_types/memory lesson.
+func ExampleGenerateMemoryLayout() {
+	data_types.GenerateMemoryLayout(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// 1 1
+	// 2 2
+	// 8 8
+	// 16 8
+	// 16 24
+	// 6 2
+	// 0 0
+	// 24 8
+	// 0 8 16
+	//  0 |A|.|.|.|.|.|.|.|
+	//  8 |B|B|B|B|B|B|B|B|
+	// 16 |C|.|.|.|.|.|.|.|
+	// 24 14
+	// a bool  offset 0  size 1 align 1 padding 7
+	// b int64 offset 8  size 8 align 8 padding 0
+	// c bool  offset 16 size 1 align 1 padding 7
+	//  0 |A|.|.|.|
+	//  4 |B|B|B|B|
+	//    ...
+	// 12 |C|.|.|.|
+	//  0 |B|B|B|B|B|B|B|B|
+	//  8 |A|C|.|.|.|.|.|.|
+	// 16 16
+	// b a c
+	// 0x3ff8000000000000
+	// true
+	// 0xc00000a0c8
+	// true
+	// 2
+	// 20
+	// 30
+	// [20 30]
+	// hé
+}
+
+// ExampleGenerateNumbers runs the data_types/numbers lesson.
+func ExampleGenerateNumbers() {
+	data_types.GenerateNumbers(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// 8
+	// int8
+	// 16000
+	// int16
+	// 32000000
+	// int32
+	// 64000000000
+	// int64
+	// 120
+	// 16000
+	// 120
+	// f + g = 16120
+	// f - g = 15880
+	// f * g = 19456
+	// f / g = 133
+	// f % g = 40
+	// Size of h: 8
+	// Size of i: 8
+	// 450000
+	// int
+	// 750000
+	// int
+	// h + i = 1200000
+	// h - i = -300000
+	// h * i = 337500000000
+	// h / i = 0
+	// h % i = 450000
+	// 7000000
+	// 12000000
+	// 17000000
+	// Size of j: 4
+	// Size of k: 8
+	// Size of l: 8
+	// 8
+	// uint8
+	// 16000
+	// uint16
+	// 32000000
+	// uint32
+	// 64000000000
+	// uint64
+	// 215
+	// uint16
+	// Size of g: 8
+	// Size of h: 8
+	// g + h = 3000
+	// g - h = 18446744073709551016
+	// g * h = 2160000
+	// g / h = 0
+	// g % h = 1200
+	// 255
+	// ÿ
+	// uint8
+	// 1
+	// 72
+	// H
+	// [72 101 108 108 111 32 87 111 114 108 100]
+	// 65
+	// A
+	// int32
+	// 4
+	// 1
+	// 3
+	// Rune 0 is 'F' (Unicode: U+0046)
+	// Rune 1 is 'l' (Unicode: U+006C)
+	// Rune 2 is 'a' (Unicode: U+0061)
+	// Rune 3 is 'b' (Unicode: U+0062)
+	// Rune 4 is 'b' (Unicode: U+0062)
+	// Rune 5 is 'e' (Unicode: U+0065)
+	// Rune 6 is 'r' (Unicode: U+0072)
+	// Rune 7 is 'g' (Unicode: U+0067)
+	// Rune 8 is 'a' (Unicode: U+0061)
+	// Rune 9 is 's' (Unicode: U+0073)
+	// Rune 10 is 't' (Unicode: U+0074)
+	// Rune 11 is 'e' (Unicode: U+0065)
+	// Rune 12 is 'd' (Unicode: U+0064)
+	// 12354
+	// あ
+	// 4
+	// A
+	// 65
+	// int32
+	// あ
+	// 12354
+	// int32
+	// 128512
+	// 😀
+	// Length in bytes: 13
+	// Length in runes: 9
+	// Rune 0 is 'H' (Unicode: U+0048)
+	// Rune 1 is 'e' (Unicode: U+0065)
+	// Rune 2 is 'l' (Unicode: U+006C)
+	// Rune 3 is 'l' (Unicode: U+006C)
+	// Rune 4 is 'o' (Unicode: U+006F)
+	// Rune 5 is ',' (Unicode: U+002C)
+	// Rune 6 is ' ' (Unicode: U+0020)
+	// Rune 7 is '世' (Unicode: U+4E16)
+	// Rune 8 is '界' (Unicode: U+754C)
+	// 100
+	// 0xc00000a0c8
+	// 824633761992
+	// 8
+	// (5+2i)
+	// complex64
+	// 8
+	// (9+7i)
+	// complex128
+	// 16
+	// (10+5i)
+	// complex128
+	// 10
+	// float64
+	// 5
+	// float64
+	// 10.520000
+	// float32
+	// 4
+	// 0.001252
+	// float64
+	// 8
+	// 3345.710000
+	// float64
+	// 8
+	// d + e = 19.602001
+	// d - e = -4.622001
+	// d * e = 90.718880
+	// d / e = 0.618395
+	// f + g = 20.02
+	// f - g = -9.52
+	// f * g = 77.52
+	// f / g = 0.36
+}
+
+// ExampleGenerateStrings runs the data_types/strings lesson.
+func ExampleGenerateStrings() {
+	data_types.GenerateStrings(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// a b c d A B C D
+	// Fajar
+	//  (0)
+	// Hello World (11)
+	// Have a nice day! (16)
+	// Oops! Something went wrong
+	// Lorem ipsum
+	// dolor sit amet
+	// Lorem ipsum
+	// 	dolor sit amet
+	// Jakarta
+	// 7
+	// 2
+	// 74
+	// J
+	// 0 J
+	// 1 a
+	// 2 k
+	// 3 a
+	// 4 r
+	// 5 t
+	// 6 a
+	// true
+	// [84 111 107 121 111]
+	// [T o k y o]
+	// 84
+	// T
+	// One Two One Two Three Two One Three
+	// true
+	// ONE TWO ONE TWO THREE TWO ONE THREE
+	// one two one two three two one three
+	// 1 Two 1 Two Three Two One Three
+	// 1 Two 1 Two Three Two 1 Three
+	// [One Two One Two Three Two One Three]
+	// One
+	// One-Two-One-Two-Three-Two-One-Three
+	// Hello World
+	// true
+	// true
+}
+
+// ExampleGenerateUTF8 runs the data_types/utf8 lesson.
+func ExampleGenerateUTF8() {
+	data_types.GenerateUTF8(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// 10 4
+	// 41 C3 A9 E4 B8 96 F0 9F 98 80
+	// 11000011 10101001
+	// 0 U+0041 1 byte(s) LATIN CAPITAL LETTER A
+	// 1 U+00E9 2 byte(s) LATIN SMALL LETTER E WITH ACUTE
+	// 3 U+4E16 3 byte(s) CJK UNIFIED IDEOGRAPH-4E16
+	// 6 U+1F600 4 byte(s) GRINNING FACE
+	// 1 2 2 0
+	// é é "\u00e9" "e\u0301" false
+	// 2 3
+	// 1 2
+	// 1 1
+	// é 2 rune(s) base character + combining mark(s)
+	// 👩‍💻 3 rune(s) emoji ZWJ sequence
+	// 👍🏽 2 rune(s) emoji with skin tone modifier
+	// 🇮🇩 2 rune(s) flag (two regional indicators)
+	// 🇯🇵 2 rune(s) flag (two regional indicators)
+	// 11 3
+	// 👩
+	// false true
+	// 0 U+0061 a
+	// 1 U+FFFD �
+	// 2 U+0062 b
+	// 3 U+FFFD �
+	// 4 U+FFFD �
+	// 0xFF never appears in UTF-8
+	// 0xE4 starts a 3-byte sequence, but the string ends after 2 byte(s)
+	// 0xB8 is a continuation byte (10xxxxxx) without a leading byte before it
+	// a?b?
+}
@@ -0,0 +1,187 @@
+package data_types
+
+import "github.com/fajarstrtn/golang-tutorial/exercise"
+
+func init() {
+	exercise.Register(exercise.Exercise{
+		ID:     "data_types/numbers/mixed-sizes",
+		Lesson: "data_types/numbers",
+		Title:  "Add integers of different sizes",
+		Prompt: "The starter does not compile because it adds an int8 to an int16. Convert one operand so the program prints the sum: 16120",
+		Starter: `package main
+
+import "fmt"
+
+func main() {
+	var a int8 = 120
+	var b int16 = 16_000
+
+	fmt.Println(a + b)
+}
+`,
+		Checks: []exercise.Check{
+			exercise.Calls("int16", 1),
+			exercise.OutputIs("16120"),
+		},
+	})
+
+	exercise.Register(exercise.Exercise{
+		ID:     "data_types/overflow/checked-add",
+		Lesson: "data_types/overflow",
+		Title:  "Detect int8 overflow",
+		Prompt: "addInt8 must return the int8 sum and false when it wrapped around. Detect it before adding, by comparing with math.MaxInt8 and math.MinInt8 (import the math package). The output must be:\n127 true\n-128 false\n127 false",
+		Starter: `package main
+
+import "fmt"
+
+func addInt8(a, b int8) (int8, bool) {
+	return a + b, true
+}
+
+func main() {
+	fmt.Println(addInt8(100, 27))
+	fmt.Println(addInt8(100, 28))
+	fmt.Println(addInt8(-100, -29))
+}
+`,
+		Checks: []exercise.Check{
+			exercise.OutputIs("127 true\n-128 false\n127 false"),
+		},
+	})
+
+	exercise.Register(exercise.Exercise{
+		ID:     "data_types/ieee754/tolerance",
+		Lesson: "data_types/ieee754",
+		Title:  "Compare floats with a tolerance",
+		Prompt: "a + b == 0.3 is false because of rounding. Compare the difference with math.Abs against a tolerance of 1e-9 instead, so the program prints: true",
+		Starter: `package main
+
+import "fmt"
+
+func main() {
+	a, b := 0.1, 0.2
+	fmt.Println(a+b == 0.3)
+}
+`,
+		Checks: []exercise.Check{
+			exercise.Calls("math.Abs", 1),
+			exercise.OutputIs("true"),
+		},
+	})
+
+	exercise.Register(exercise.Exercise{
+		ID:     "data_types/memory/reorder-fields",
+		Lesson: "data_types/memory",
+		Title:  "Remove the padding from a struct",
+		Prompt: "Order takes 24 bytes because of padding. Reorder its fields (keep their names and types) so that unsafe.Sizeof(Order{}) is as small as possible. The output must be: 16",
+		Starter: `package main
+
+import (
+	"fmt"
+	"unsafe"
+)
+
+type Order struct {
+	Paid     bool
+	ID       int64
+	Shipped  bool
+	Quantity int32
+}
+
+func main() {
+	fmt.Println(unsafe.Sizeof(Order{}))
+}
+`,
+		Checks: []exercise.Check{
+			exercise.Calls("unsafe.Sizeof", 1),
+			exercise.OutputIs("16"),
+		},
+	})
+
+	exercise.Register(exercise.Exercise{
+		ID:     "data_types/complex/modulus",
+		Lesson: "data_types/complex",
+		Title:  "Compute the modulus and the square root",
+		Prompt: "For z := 3 + 4i, print the modulus of z with cmplx.Abs, then the square root of -4 with cmplx.Sqrt, on one line. The output must be: 5 (0+2i)",
+		Starter: `package main
+
+import (
+	"fmt"
+	"math"
+)
+
+func main() {
+	z := 3 + 4i
+	fmt.Println(z, math.Sqrt(-4))
+}
+`,
+		Checks: []exercise.Check{
+			exercise.Calls("cmplx.Abs", 1),
+			exercise.Calls("cmplx.Sqrt", 1),
+			exercise.OutputIs("5 (0+2i)"),
+		},
+	})
+
+	exercise.Register(exercise.Exercise{
+		ID:     "data_types/strings/bytes-and-runes",
+		Lesson: "data_types/strings",
+		Title:  "Count bytes and characters",
+		Prompt: "Print the number of bytes and the number of runes (characters) of the string \"héllo\" on one line. The output must be: 6 5",
+		Starter: `package main
+
+import "fmt"
+
+func main() {
+	word := "héllo"
+	fmt.Println(len(word))
+}
+`,
+		Checks: []exercise.Check{
+			exercise.Calls("len", 1),
+			exercise.OutputIs("6 5"),
+		},
+	})
+
+	exercise.Register(exercise.Exercise{
+		ID:     "data_types/utf8/runes-and-validity",
+		Lesson: "data_types/utf8",
+		Title:  "Count runes and check for invalid UTF-8",
+		Prompt: "The string word holds e followed by a combining acute accent, and data holds the byte 0xFF. Print the number of bytes and the number of runes of word with utf8.RuneCountInString, then whether data is valid UTF-8 with utf8.ValidString, on one line. The output must be: 3 2 false",
+		Starter: `package main
+
+import "fmt"
+
+func main() {
+	word := "e\u0301"
+	data := "\xff"
+	fmt.Println(len(word), len([]rune(word)), data)
+}
+`,
+		Checks: []exercise.Check{
+			exercise.Calls("utf8.RuneCountInString", 1),
+			exercise.Calls("utf8.ValidString", 1),
+			exercise.OutputIs("3 2 false"),
+		},
+	})
+
+	exercise.Register(exercise.Exercise{
+		ID:     "data_types/booleans/compare",
+		Lesson: "data_types/booleans",
+		Title:  "Store comparisons in booleans",
+		Prompt: "Declare isAdult := age >= 18 and isTeen := age < 20 for age := 19, and print them with fmt.Printf and the %t verb. The output must be: true true",
+		Starter: `package main
+
+import "fmt"
+
+func main() {
+	age := 19
+	fmt.Println(age)
+}
+`,
+		Checks: []exercise.Check{
+			exercise.UsesVerb("%t"),
+			exercise.ShortVarDecls(3),
+			exercise.OutputIs("true true"),
+		},
+	})
+}
@@ -0,0 +1,314 @@
+package data_types
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+/*
+ * A FloatFormat is one IEEE 754 binary format:
+ * 1. Name        : The Go type using it
+ * 2. Size        : Total number of bits
+ * 3. ExponentBits: Number of bits of the biased exponent
+ * 4. MantissaBits: Number of stored bits of the mantissa (the leading 1 of normal numbers is implicit)
+ * 5. Bias        : What is subtracted from the stored exponent */
+type FloatFormat struct {
+	Name         string
+	Size         uint
+	ExponentBits uint
+	MantissaBits uint
+	Bias         int
+}
+
+var (
+	FLOAT32 = FloatFormat{Name: "float32", Size: 32, ExponentBits: 8, MantissaBits: 23, Bias: 127}
+	FLOAT64 = FloatFormat{Name: "float64", Size: 64, ExponentBits: 11, MantissaBits: 52, Bias: 1023}
+)
+
+// The classes of IEEE 754 values.
+const (
+	FLOAT_ZERO      = "zero"
+	FLOAT_SUBNORMAL = "subnormal"
+	FLOAT_NORMAL    = "normal"
+	FLOAT_INFINITY  = "infinity"
+	FLOAT_NAN       = "NaN"
+)
+
+/*
+ * A FloatInspection is a float broken into its IEEE 754 parts:
+ * 1. Value   : The number (a float32 is stored as the float64 with the same value, which is always exact)
+ * 2. Bits    : The raw bits (math.Float32bits or math.Float64bits)
+ * 3. Sign    : 1 for negative numbers, including -0
+ * 4. Exponent: The stored (biased) exponent
+ * 5. Mantissa: The stored fraction bits
+ * 6. Class   : zero, subnormal, normal, infinity or NaN
+ * 7. Previous, Next: The nearest representable numbers below and above
+ * 8. ULP     : Unit in the last place, the gap between the number and the next one away from zero */
+type FloatInspection struct {
+	Format   FloatFormat
+	Value    float64
+	Bits     uint64
+	Sign     uint64
+	Exponent uint64
+	Mantissa uint64
+	Class    string
+	Previous float64
+	Next     float64
+	ULP      float64
+}
+
+// InspectFloat64 breaks a float64 into its IEEE 754 parts.
+func InspectFloat64(x float64) FloatInspection {
+	i := newInspection(FLOAT64, x, math.Float64bits(x))
+	i.Previous = math.Nextafter(x, math.Inf(-1))
+	i.Next = math.Nextafter(x, math.Inf(1))
+	i.ULP = ulp(x, i.Previous, i.Next)
+
+	return i
+}
+
+// InspectFloat32 breaks a float32 into its IEEE 754 parts.
+func InspectFloat32(x float32) FloatInspection {
+	i := newInspection(FLOAT32, float64(x), uint64(math.Float32bits(x)))
+	i.Previous = float64(math.Nextafter32(x, float32(math.Inf(-1))))
+	i.Next = float64(math.Nextafter32(x, float32(math.Inf(1))))
+	i.ULP = ulp(float64(x), i.Previous, i.Next)
+
+	return i
+}
+
+func newInspection(format FloatFormat, value float64, bits uint64) FloatInspection {
+	i := FloatInspection{
+		Format:   format,
+		Value:    value,
+		Bits:     bits,
+		Sign:     bits >> (format.Size - 1),
+		Exponent: bits >> format.MantissaBits & (1<<format.ExponentBits - 1),
+		Mantissa: bits & (1<<format.MantissaBits - 1),
+	}
+
+	switch {
+	case i.Exponent == 1<<format.ExponentBits-1 && i.Mantissa == 0:
+		i.Class = FLOAT_INFINITY
+	case i.Exponent == 1<<format.ExponentBits-1:
+		i.Class = FLOAT_NAN
+	case i.Exponent == 0 && i.Mantissa == 0:
+		i.Class = FLOAT_ZERO
+	case i.Exponent == 0:
+		i.Class = FLOAT_SUBNORMAL
+	default:
+		i.Class = FLOAT_NORMAL
+	}
+
+	return i
+}
+
+// ulp is the distance to the next number away from zero (towards zero for the largest finite number).
+func ulp(x, previous, next float64) float64 {
+	switch {
+	case math.IsNaN(x) || math.IsInf(x, 0):
+		return math.NaN()
+	case math.IsInf(next, 1):
+		return x - previous
+	case math.IsInf(previous, -1):
+		return next - x
+	case math.Signbit(x):
+		return x - previous
+	}
+
+	return next - x
+}
+
+// BitString returns the bits as sign, exponent and mantissa separated by spaces.
+func (i FloatInspection) BitString() string {
+	digits := fmt.Sprintf("%0*b", i.Format.Size, i.Bits)
+
+	return digits[:1] + " " + digits[1:1+i.Format.ExponentBits] + " " + digits[1+i.Format.ExponentBits:]
+}
+
+/*
+ * Formula returns how the parts make the value:
+ * 1. Normal   : (-1)^sign x 2^(exponent - bias) x 1.mantissa
+ * 2. Subnormal: (-1)^sign x 2^(1 - bias) x 0.mantissa (there is no implicit 1, so tiny numbers lose precision gradually)
+ * The mantissa is written in binary. */
+func (i FloatInspection) Formula() string {
+	fraction := fmt.Sprintf("%0*b", i.Format.MantissaBits, i.Mantissa)
+	fraction = strings.TrimRight(fraction, "0")
+	if fraction == "" {
+		fraction = "0"
+	}
+
+	switch i.Class {
+	case FLOAT_NORMAL:
+		return fmt.Sprintf("(-1)^%d x 2^(%d - %d) x 1.%s (binary)", i.Sign, i.Exponent, i.Format.Bias, fraction)
+	case FLOAT_SUBNORMAL:
+		return fmt.Sprintf("(-1)^%d x 2^(1 - %d) x 0.%s (binary)", i.Sign, i.Format.Bias, fraction)
+	case FLOAT_ZERO:
+		return fmt.Sprintf("(-1)^%d x 0", i.Sign)
+	case FLOAT_INFINITY:
+		return "every exponent bit set and mantissa 0: infinity"
+	}
+
+	return "every exponent bit set and mantissa not 0: not a number"
+}
+
+/*
+ * Exact returns the exact decimal value of the stored number.
+ * Every finite float is a fraction with a power of two below, so its decimal expansion always ends
+ * (0.1 is really 0.1000000000000000055511151231257827021181583404541015625 in float64). */
+func (i FloatInspection) Exact() string {
+	if i.Class == FLOAT_NAN || i.Class == FLOAT_INFINITY {
+		return strconv.FormatFloat(i.Value, 'g', -1, 64)
+	}
+
+	exact := new(big.Rat).SetFloat64(i.Value)
+	digits := exact.Denom().BitLen() - 1 // The denominator is 2^digits, which needs digits decimals.
+
+	s := exact.FloatString(digits)
+	if strings.Contains(s, ".") {
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+	if i.Sign == 1 && !strings.HasPrefix(s, "-") {
+		s = "-" + s
+	}
+
+	return s
+}
+
+// Short returns the shortest decimal that reads back as the same number, which is what fmt prints with %v.
+func (i FloatInspection) Short() string {
+	return strconv.FormatFloat(i.Value, 'g', -1, int(i.Format.Size))
+}
+
+/*
+ * A FloatExpression is an expression computed twice,
+ * once with float32 variables and once with float64 variables. */
+type FloatExpression struct {
+	Source  string
+	Float32 float32
+	Float64 float64
+}
+
+/*
+ * ParseFloatExpression computes an expression such as 0.1 + 0.2 or 7.49 * 12.112
+ * the way a Go program does with variables (every literal and every intermediate result is rounded to the type).
+ * Constant expressions are different: the compiler computes them exactly, then rounds once.
+ *
+ * It understands numbers in any Go notation, + - * / and parentheses,
+ * Inf and NaN, and the constants math.Pi, math.E, math.MaxFloat32, math.SmallestNonzeroFloat32,
+ * math.MaxFloat64 and math.SmallestNonzeroFloat64. */
+func ParseFloatExpression(src string) (FloatExpression, error) {
+	expr, err := parser.ParseExpr(src)
+	if err != nil {
+		return FloatExpression{}, fmt.Errorf("%s is not a Go expression: %w", src, err)
+	}
+
+	f32, err := evalFloat(expr, 32)
+	if err != nil {
+		return FloatExpression{}, err
+	}
+	f64, err := evalFloat(expr, 64)
+	if err != nil {
+		return FloatExpression{}, err
+	}
+
+	return FloatExpression{Source: src, Float32: float32(f32), Float64: f64}, nil
+}
+
+var floatConstants = map[string]string{
+	"math.Pi":                     "3.14159265358979323846264338327950288419716939937510582097494459",
+	"math.E":                      "2.71828182845904523536028747135266249775724709369995957496696763",
+	"math.MaxFloat32":             "0x1.fffffep127",
+	"math.SmallestNonzeroFloat32": "0x1p-149",
+	"math.MaxFloat64":             "0x1.fffffffffffffp1023",
+	"math.SmallestNonzeroFloat64": "0x1p-1074",
+	"Inf":                         "Inf",
+	"NaN":                         "NaN",
+}
+
+// evalFloat evaluates expr rounding every step to bitSize bits (32 or 64).
+func evalFloat(expr ast.Expr, bitSize int) (float64, error) {
+	round := func(x float64) float64 {
+		if bitSize == 32 {
+			return float64(float32(x))
+		}
+		return x
+	}
+
+	switch e := expr.(type) {
+	case *ast.BasicLit:
+		if e.Kind != token.INT && e.Kind != token.FLOAT {
+			return 0, fmt.Errorf("%s is not a number", e.Value)
+		}
+		return parseFloat(e.Value, bitSize)
+
+	case *ast.Ident:
+		if s, ok := floatConstants[e.Name]; ok {
+			return parseFloat(s, bitSize)
+		}
+
+	case *ast.SelectorExpr:
+		if s, ok := floatConstants[types.ExprString(e)]; ok {
+			return parseFloat(s, bitSize)
+		}
+
+	case *ast.ParenExpr:
+		return evalFloat(e.X, bitSize)
+
+	case *ast.UnaryExpr:
+		x, err := evalFloat(e.X, bitSize)
+		if err != nil {
+			return 0, err
+		}
+		switch e.Op {
+		case token.ADD:
+			return x, nil
+		case token.SUB:
+			return -x, nil
+		}
+
+	case *ast.BinaryExpr:
+		x, err := evalFloat(e.X, bitSize)
+		if err != nil {
+			return 0, err
+		}
+		y, err := evalFloat(e.Y, bitSize)
+		if err != nil {
+			return 0, err
+		}
+		/*
+		 * float64 has more than twice the mantissa bits of float32, so computing a float32 operation
+		 * in float64 and rounding once gives exactly the float32 result. */
+		switch e.Op {
+		case token.ADD:
+			return round(x + y), nil
+		case token.SUB:
+			return round(x - y), nil
+		case token.MUL:
+			return round(x * y), nil
+		case token.QUO:
+			return round(x / y), nil
+		}
+	}
+
+	return 0, fmt.Errorf("unsupported expression %s: use numbers, + - * /, parentheses, Inf, NaN and math constants", types.ExprString(expr))
+}
+
+/*
+ * parseFloat rounds a literal straight to bitSize bits, like a conversion at run time does.
+ * A number too large for the type becomes an infinity (the compiler would reject the constant). */
+func parseFloat(s string, bitSize int) (float64, error) {
+	x, err := strconv.ParseFloat(s, bitSize)
+	if err != nil && !errors.Is(err, strconv.ErrRange) {
+		return 0, fmt.Errorf("%s is not a valid number: %w", s, err)
+	}
+	return x, nil
+}
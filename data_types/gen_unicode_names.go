@@ -0,0 +1,131 @@
+//go:build ignore
+
+/*
+ * gen_unicode_names writes unicode_names.txt, the code point names used by the UTF-8 inspector.
+ *
+ * The Go standard library has the Unicode categories and scripts, but not the names.
+ * This program keeps the names of the blocks a learner is likely to paste (Latin, Greek, Cyrillic,
+ * punctuation, symbols, kana, emoji, ...) from UnicodeData.txt of the Unicode Character Database.
+ *
+ * The names must match the unicode package the lessons compare against, so it downloads the UnicodeData.txt
+ * of -version (unicode.Version of the running toolchain by default). The go:generate directive pins it
+ * to the Unicode version of the Go release in go.mod, so every toolchain generates the same table:
+ *
+ *     go generate ./data_types
+ *
+ * Without network access, download the file elsewhere and pass it with its version:
+ *
+ *     curl -O https://www.unicode.org/Public/15.0.0/ucd/UnicodeData.txt
+ *     go run gen_unicode_names.go -version 15.0.0 UnicodeData.txt
+ *
+ * Hangul syllables and CJK ideographs are not listed: their names are computed (see unicode_names.go). */
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Where the UnicodeData.txt of a Unicode version is downloaded from.
+const UNICODE_DATA_URL = "https://www.unicode.org/Public/%s/ucd/UnicodeData.txt"
+
+// The blocks whose names are kept, as first and last code point.
+var BLOCKS = [][2]rune{
+	{0x0020, 0x007E},   // Basic Latin (without the controls)
+	{0x00A0, 0x024F},   // Latin-1 Supplement, Latin Extended-A and B
+	{0x02B0, 0x036F},   // Spacing Modifier Letters, Combining Diacritical Marks
+	{0x0370, 0x04FF},   // Greek and Coptic, Cyrillic
+	{0x1100, 0x11FF},   // Hangul Jamo
+	{0x1E00, 0x1EFF},   // Latin Extended Additional
+	{0x2000, 0x23FF},   // General Punctuation to Miscellaneous Technical
+	{0x25A0, 0x27BF},   // Geometric Shapes, Miscellaneous Symbols, Dingbats
+	{0x2B00, 0x2BFF},   // Miscellaneous Symbols and Arrows
+	{0x3000, 0x30FF},   // CJK Symbols and Punctuation, Hiragana, Katakana
+	{0xFE00, 0xFE0F},   // Variation Selectors
+	{0xFEFF, 0xFEFF},   // Zero width no-break space (byte order mark)
+	{0xFF00, 0xFFEF},   // Halfwidth and Fullwidth Forms
+	{0xFFFD, 0xFFFD},   // Replacement character
+	{0x1F000, 0x1FAFF}, // Emoji and pictographs
+	{0xE0000, 0xE007F}, // Tags
+}
+
+func main() {
+	version := flag.String("version", unicode.Version, "Unicode version of the input, written in the header")
+	output := flag.String("o", "unicode_names.txt", "output file")
+	flag.Parse()
+
+	if flag.NArg() > 1 || *version == "" {
+		log.Fatal("usage: go run gen_unicode_names.go [-version <unicode version>] [UnicodeData.txt]")
+	}
+
+	var in io.ReadCloser
+	var err error
+	if flag.NArg() == 1 {
+		in, err = os.Open(flag.Arg(0))
+	} else {
+		in, err = download(fmt.Sprintf(UNICODE_DATA_URL, *version))
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer in.Close()
+
+	var lines []string
+	scanner := bufio.NewScanner(in)
+	for scanner.Scan() {
+		// 0041;LATIN CAPITAL LETTER A;Lu;0;L;;;;;N;;;;0061;
+		fields := strings.Split(scanner.Text(), ";")
+		if len(fields) < 2 || strings.HasPrefix(fields[1], "<") {
+			continue
+		}
+
+		code, err := strconv.ParseUint(fields[0], 16, 32)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if inBlocks(rune(code)) {
+			lines = append(lines, fmt.Sprintf("%04X %s", code, fields[1]))
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		log.Fatal(err)
+	}
+
+	out := fmt.Sprintf("# Code generated by \"go run gen_unicode_names.go\"; DO NOT EDIT.\n# Unicode %s, %d names.\n%s\n", *version, len(lines), strings.Join(lines, "\n"))
+	if err := os.WriteFile(*output, []byte(out), 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// download opens the body of url, with a hint to use a local copy when it fails.
+func download(url string) (io.ReadCloser, error) {
+	const hint = "download it yourself and run go run gen_unicode_names.go -version <unicode version> UnicodeData.txt"
+
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("cannot download %s: %v\n%s", url, err, hint)
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("cannot download %s: %s\n%s", url, resp.Status, hint)
+	}
+
+	return resp.Body, nil
+}
+
+func inBlocks(r rune) bool {
+	for _, block := range BLOCKS {
+		if block[0] <= r && r <= block[1] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,206 @@
+package data_types
+
+import "unicode"
+
+/*
+ * A GraphemeClass is the Grapheme_Cluster_Break property of a code point (Unicode Standard Annex #29),
+ * plus Extended_Pictographic, which rule GB11 needs for emoji ZWJ sequences. */
+type GraphemeClass int
+
+const (
+	GRAPHEME_OTHER GraphemeClass = iota
+	GRAPHEME_CR
+	GRAPHEME_LF
+	GRAPHEME_CONTROL
+	GRAPHEME_EXTEND
+	GRAPHEME_ZWJ
+	GRAPHEME_REGIONAL_INDICATOR
+	GRAPHEME_PREPEND
+	GRAPHEME_SPACING_MARK
+	GRAPHEME_L
+	GRAPHEME_V
+	GRAPHEME_T
+	GRAPHEME_LV
+	GRAPHEME_LVT
+	GRAPHEME_PICTOGRAPHIC
+)
+
+// Special code points of the segmentation rules.
+const (
+	ZERO_WIDTH_JOINER     = '\u200D'
+	ZERO_WIDTH_NON_JOINER = '\u200C'
+	VARIATION_SELECTOR_16 = '\uFE0F'
+)
+
+/*
+ * Extended_Pictographic is not in the unicode package, so these ranges approximate it:
+ * the emoji and pictograph blocks, and the older symbols that became emoji (e.g., ©, ™, ☀, ✂). */
+var pictographic = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x00A9, Hi: 0x00A9, Stride: 1},
+		{Lo: 0x00AE, Hi: 0x00AE, Stride: 1},
+		{Lo: 0x203C, Hi: 0x203C, Stride: 1},
+		{Lo: 0x2049, Hi: 0x2049, Stride: 1},
+		{Lo: 0x2122, Hi: 0x2122, Stride: 1},
+		{Lo: 0x2139, Hi: 0x2139, Stride: 1},
+		{Lo: 0x2194, Hi: 0x2199, Stride: 1},
+		{Lo: 0x21A9, Hi: 0x21AA, Stride: 1},
+		{Lo: 0x231A, Hi: 0x231B, Stride: 1},
+		{Lo: 0x2328, Hi: 0x2328, Stride: 1},
+		{Lo: 0x23CF, Hi: 0x23CF, Stride: 1},
+		{Lo: 0x23E9, Hi: 0x23FA, Stride: 1},
+		{Lo: 0x24C2, Hi: 0x24C2, Stride: 1},
+		{Lo: 0x25AA, Hi: 0x25AB, Stride: 1},
+		{Lo: 0x25B6, Hi: 0x25B6, Stride: 1},
+		{Lo: 0x25C0, Hi: 0x25C0, Stride: 1},
+		{Lo: 0x25FB, Hi: 0x25FE, Stride: 1},
+		{Lo: 0x2600, Hi: 0x27BF, Stride: 1},
+		{Lo: 0x2934, Hi: 0x2935, Stride: 1},
+		{Lo: 0x2B05, Hi: 0x2B07, Stride: 1},
+		{Lo: 0x2B1B, Hi: 0x2B1C, Stride: 1},
+		{Lo: 0x2B50, Hi: 0x2B50, Stride: 1},
+		{Lo: 0x2B55, Hi: 0x2B55, Stride: 1},
+		{Lo: 0x3030, Hi: 0x3030, Stride: 1},
+		{Lo: 0x303D, Hi: 0x303D, Stride: 1},
+		{Lo: 0x3297, Hi: 0x3297, Stride: 1},
+		{Lo: 0x3299, Hi: 0x3299, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x1F000, Hi: 0x1F1E5, Stride: 1},
+		{Lo: 0x1F200, Hi: 0x1F3FA, Stride: 1},
+		{Lo: 0x1F400, Hi: 0x1FAFF, Stride: 1},
+		{Lo: 0x1FC00, Hi: 0x1FFFD, Stride: 1},
+	},
+}
+
+/*
+ * classifyGrapheme finds the class of a code point from the tables of the unicode package.
+ * The official property tables are not in the standard library, so this is an approximation
+ * that is exact for the common cases (letters, combining marks, Hangul, emoji, flags). */
+func classifyGrapheme(r rune) GraphemeClass {
+	switch {
+	case r == '\r':
+		return GRAPHEME_CR
+	case r == '\n':
+		return GRAPHEME_LF
+	case r == ZERO_WIDTH_JOINER:
+		return GRAPHEME_ZWJ
+	case r == ZERO_WIDTH_NON_JOINER,
+		0x1F3FB <= r && r <= 0x1F3FF, // Emoji skin tone modifiers
+		0xE0020 <= r && r <= 0xE007F, // Tags (used by subdivision flags)
+		unicode.In(r, unicode.Mn, unicode.Me, unicode.Other_Grapheme_Extend):
+		return GRAPHEME_EXTEND
+	case 0x1F1E6 <= r && r <= 0x1F1FF:
+		return GRAPHEME_REGIONAL_INDICATOR
+	case unicode.Is(unicode.Prepended_Concatenation_Mark, r):
+		return GRAPHEME_PREPEND
+	case unicode.In(r, unicode.Cc, unicode.Zl, unicode.Zp, unicode.Cf), r == utf8RuneErrorMarker:
+		return GRAPHEME_CONTROL
+	case unicode.Is(unicode.Mc, r), r == 0x0E33, r == 0x0EB3:
+		return GRAPHEME_SPACING_MARK
+	case 0x1100 <= r && r <= 0x115F, 0xA960 <= r && r <= 0xA97C:
+		return GRAPHEME_L
+	case 0x1160 <= r && r <= 0x11A7, 0xD7B0 <= r && r <= 0xD7C6:
+		return GRAPHEME_V
+	case 0x11A8 <= r && r <= 0x11FF, 0xD7CB <= r && r <= 0xD7FB:
+		return GRAPHEME_T
+	case 0xAC00 <= r && r <= 0xD7A3:
+		if (r-0xAC00)%28 == 0 {
+			return GRAPHEME_LV
+		}
+		return GRAPHEME_LVT
+	case unicode.Is(pictographic, r):
+		return GRAPHEME_PICTOGRAPHIC
+	}
+
+	return GRAPHEME_OTHER
+}
+
+/*
+ * utf8RuneErrorMarker stands for a byte that is not valid UTF-8 while segmenting.
+ * It is outside the Unicode range, so it cannot be confused with a real code point,
+ * and it is treated like a control character: it always stands alone. */
+const utf8RuneErrorMarker = -1
+
+/*
+ * graphemeBreaks reports, for each rune, whether a grapheme cluster starts before it.
+ * The first rune always starts one. The rules are those of Unicode Standard Annex #29:
+ * 1. GB3 to GB5 : CR LF stays together; other controls stand alone
+ * 2. GB6 to GB8 : Hangul jamo join into syllables
+ * 3. GB9 to GB9b: Combining marks, ZWJ and spacing marks join the previous character; prepend marks join the next
+ * 4. GB11       : Pictograph ZWJ pictograph stays together (e.g., 👩‍💻)
+ * 5. GB12, GB13 : Regional indicators pair up into flags (e.g., 🇮🇩)
+ * 6. GB999      : Break everywhere else */
+func graphemeBreaks(runes []rune) []bool {
+	breaks := make([]bool, len(runes))
+	if len(runes) == 0 {
+		return breaks
+	}
+	breaks[0] = true
+
+	var (
+		previous        = classifyGrapheme(runes[0])
+		regionalCount   = 0     // Regional indicators in a row before the current rune
+		pictographicRun = false // The current cluster is a pictograph followed by Extend* (and maybe a ZWJ)
+	)
+	if previous == GRAPHEME_REGIONAL_INDICATOR {
+		regionalCount = 1
+	}
+	if previous == GRAPHEME_PICTOGRAPHIC {
+		pictographicRun = true
+	}
+
+	for i := 1; i < len(runes); i++ {
+		current := classifyGrapheme(runes[i])
+		breaks[i] = graphemeBreak(previous, current, regionalCount, pictographicRun)
+
+		switch {
+		case current == GRAPHEME_REGIONAL_INDICATOR:
+			regionalCount++
+		default:
+			regionalCount = 0
+		}
+
+		switch {
+		case current == GRAPHEME_PICTOGRAPHIC:
+			pictographicRun = true
+		case current == GRAPHEME_EXTEND && pictographicRun && previous != GRAPHEME_ZWJ:
+		case current == GRAPHEME_ZWJ && pictographicRun && previous != GRAPHEME_ZWJ:
+		default:
+			pictographicRun = false
+		}
+
+		previous = current
+	}
+
+	return breaks
+}
+
+func graphemeBreak(previous, current GraphemeClass, regionalCount int, pictographicRun bool) bool {
+	switch {
+	case previous == GRAPHEME_CR && current == GRAPHEME_LF: // GB3
+		return false
+	case previous == GRAPHEME_CR, previous == GRAPHEME_LF, previous == GRAPHEME_CONTROL: // GB4
+		return true
+	case current == GRAPHEME_CR, current == GRAPHEME_LF, current == GRAPHEME_CONTROL: // GB5
+		return true
+	case previous == GRAPHEME_L && (current == GRAPHEME_L || current == GRAPHEME_V || current == GRAPHEME_LV || current == GRAPHEME_LVT): // GB6
+		return false
+	case (previous == GRAPHEME_LV || previous == GRAPHEME_V) && (current == GRAPHEME_V || current == GRAPHEME_T): // GB7
+		return false
+	case (previous == GRAPHEME_LVT || previous == GRAPHEME_T) && current == GRAPHEME_T: // GB8
+		return false
+	case current == GRAPHEME_EXTEND || current == GRAPHEME_ZWJ: // GB9
+		return false
+	case current == GRAPHEME_SPACING_MARK: // GB9a
+		return false
+	case previous == GRAPHEME_PREPEND: // GB9b
+		return false
+	case previous == GRAPHEME_ZWJ && current == GRAPHEME_PICTOGRAPHIC && pictographicRun: // GB11
+		return false
+	case previous == GRAPHEME_REGIONAL_INDICATOR && current == GRAPHEME_REGIONAL_INDICATOR: // GB12, GB13
+		return regionalCount%2 == 0
+	}
+
+	return true // GB999
+}
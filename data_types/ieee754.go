@@ -0,0 +1,137 @@
+package data_types
+
+import (
+	"fmt"
+	"io"
+	"math"
+)
+
+func getFloatBits(w io.Writer) {
+	/*
+	 * float32 and float64 store numbers in the IEEE 754 binary format, as three groups of bits:
+	 * 1. Sign    : 1 bit, 0 for positive and 1 for negative
+	 * 2. Exponent: 8 bits (float32) or 11 bits (float64), stored with a bias of 127 or 1023
+	 * 3. Mantissa: 23 bits (float32) or 52 bits (float64), the digits after the binary point
+	 *
+	 * A normal number is (-1)^sign x 2^(exponent - bias) x 1.mantissa (in binary).
+	 * The leading 1 is not stored, so float32 really has 24 bits of precision (about 7 decimal digits)
+	 * and float64 has 53 bits (about 16 decimal digits).
+	 *
+	 * math.Float32bits and math.Float64bits return the raw bits.
+	 *
+	 * -2.5 is -1.01 (binary) x 2^1, so the sign is 1, the exponent is 1 + 127 = 128 and the mantissa is 01000...: */
+	fmt.Fprintf(w, "%032b\n", math.Float32bits(-2.5)) // Output: 11000000001000000000000000000000
+
+	inspection := InspectFloat32(-2.5)
+	fmt.Fprintln(w, inspection.BitString()) // Output: 1 10000000 01000000000000000000000
+	fmt.Fprintln(w, inspection.Formula())   // Output: (-1)^1 x 2^(128 - 127) x 1.01 (binary)
+
+	/*
+	 * 0.1 has no exact binary form (like 1/3 has no exact decimal form): 0.0001100110011... forever.
+	 * The mantissa is cut after 23 or 52 bits and rounded, so the stored number is only close to 0.1.
+	 * fmt prints the shortest decimal that reads back as the same number, which hides the difference. */
+	var tenth32 float32 = 0.1
+	var tenth64 float64 = 0.1
+	fmt.Fprintln(w, tenth32, tenth64)                    // Output: 0.1 0.1
+	fmt.Fprintln(w, InspectFloat32(tenth32).Exact())     // Output: 0.100000001490116119384765625
+	fmt.Fprintln(w, InspectFloat64(tenth64).Exact())     // Output: 0.1000000000000000055511151231257827021181583404541015625
+	fmt.Fprintln(w, InspectFloat32(tenth32).BitString()) // Output: 0 01111011 10011001100110011001101
+	fmt.Fprintf(w, "%.20f\n", tenth32)                   // Output: 0.10000000149011611938
+	fmt.Fprintln(w, float64(tenth32))                    // Output: 0.10000000149011612
+	fmt.Fprintln(w, float64(tenth32) == tenth64)         // Output: false
+
+	/*
+	 * Between two neighbouring floats there is nothing: the gap between them is called
+	 * the ULP (unit in the last place). math.Nextafter returns the neighbours.
+	 *
+	 * The gap grows with the number. Above 2^24 (16777216) float32 cannot even store every integer,
+	 * and float64 stops at 2^53. */
+	fmt.Fprintln(w, math.Nextafter(1, 2))         // Output: 1.0000000000000002
+	fmt.Fprintln(w, math.Nextafter32(1, 2))       // Output: 1.0000001
+	fmt.Fprintln(w, InspectFloat32(1).ULP)        // Output: 1.1920928955078125e-07
+	fmt.Fprintln(w, InspectFloat64(1).ULP)        // Output: 2.220446049250313e-16
+	fmt.Fprintln(w, InspectFloat32(16777216).ULP) // Output: 2
+
+	var large float32 = 16_777_216
+	fmt.Fprintln(w, large+1 == large) // Output: true
+}
+
+func getFloatSpecialValues(w io.Writer) {
+	/*
+	 * Some bit patterns are reserved for special values:
+	 * 1. ±0       : Exponent and mantissa all 0. -0 is equal to 0 but keeps its sign (1 / -0 is -Inf)
+	 * 2. ±Inf     : Exponent all 1, mantissa 0. The result of overflow or of dividing by zero
+	 * 3. NaN      : Exponent all 1, mantissa not 0. "Not a Number", the result of 0 / 0 or Inf - Inf
+	 * 4. Subnormal: Exponent all 0, mantissa not 0. Tiny numbers without the implicit leading 1,
+	 *    which fill the gap between 0 and the smallest normal number
+	 *
+	 * Floats never panic: every operation returns one of these values instead. */
+	zero := 0.0
+	negativeZero := -zero
+	fmt.Fprintln(w, negativeZero, negativeZero == zero)            // Output: -0 true
+	fmt.Fprintln(w, 1/negativeZero, math.Signbit(negativeZero))    // Output: -Inf true
+	fmt.Fprintln(w, InspectFloat64(negativeZero).BitString()[:13]) // Output: 1 00000000000
+
+	inf := math.Inf(1)
+	fmt.Fprintln(w, inf, -inf, inf+1 == inf) // Output: +Inf -Inf true
+
+	largest := math.MaxFloat64
+	fmt.Fprintln(w, largest*2) // Output: +Inf
+
+	// NaN is not equal to anything, not even itself. Use math.IsNaN to check for it.
+	nan := zero / zero
+	fmt.Fprintln(w, nan, nan == nan, math.IsNaN(nan)) // Output: NaN false true
+	fmt.Fprintln(w, inf-inf)                          // Output: NaN
+	fmt.Fprintln(w, InspectFloat64(nan).Class)        // Output: NaN
+
+	/*
+	 * The smallest normal float64 is 2^-1022. Below it, subnormals keep going down to 2^-1074
+	 * (math.SmallestNonzeroFloat64), losing one bit of precision at each step. */
+	fmt.Fprintln(w, math.SmallestNonzeroFloat64)                       // Output: 5e-324
+	fmt.Fprintln(w, InspectFloat64(math.SmallestNonzeroFloat64).Class) // Output: subnormal
+	fmt.Fprintln(w, InspectFloat64(0x1p-1022).Class)                   // Output: normal
+
+	tiny := math.SmallestNonzeroFloat64
+	fmt.Fprintln(w, tiny/2) // Output: 0
+}
+
+func getFloatRounding(w io.Writer) {
+	/*
+	 * Every operation rounds its result to the nearest float of its type.
+	 * float32 rounds much more, so the same expression gives different results:
+	 * d + e in the floating-point lesson prints 19.602001, because 7.49 and 12.112
+	 * are already rounded when they are stored (7.4899997... and 12.1120004...)
+	 * and the sum is rounded again. */
+	var d32, e32 float32 = 7.49, 12.112
+	var d64, e64 float64 = 7.49, 12.112
+	fmt.Fprintln(w, d32+e32, d64+e64)              // Output: 19.602001 19.602
+	fmt.Fprintln(w, float64(d32), float64(e32))    // Output: 7.489999771118164 12.112000465393066
+	fmt.Fprintf(w, "%f %.10f\n", d32*e32, d32*e32) // Output: 90.718880 90.7188796997
+
+	// The classic: 0.1 + 0.2 is not 0.3 in float64, but it happens to be in float32.
+	var a64, b64 float64 = 0.1, 0.2
+	var a32, b32 float32 = 0.1, 0.2
+	fmt.Fprintln(w, a64+b64, a64+b64 == 0.3) // Output: 0.30000000000000004 false
+	fmt.Fprintln(w, a32+b32, a32+b32 == 0.3) // Output: 0.3 true
+
+	// The rounding errors add up.
+	var sum32 float32
+	var sum64 float64
+	for i := 0; i < 10; i++ {
+		sum32 += 0.1
+		sum64 += 0.1
+	}
+	fmt.Fprintln(w, sum32, sum64) // Output: 1.0000001 0.9999999999999999
+
+	/*
+	 * Rules of thumb:
+	 * 1. Prefer float64; use float32 only to save memory (e.g., large arrays, graphics)
+	 * 2. Never compare floats with ==; compare their difference with a tolerance
+	 * 3. Never use floats for money; use integers of cents (or a decimal package)
+	 *
+	 * Try your own expressions with go run . float (e.g., go run . float '0.1 + 0.2'). */
+	fmt.Fprintln(w, math.Abs((a64+b64)-0.3) < 1e-9) // Output: true
+
+	expr, _ := ParseFloatExpression("7.49 + 12.112")
+	fmt.Fprintln(w, expr.Float32, expr.Float64) // Output: 19.602001 19.602
+}
@@ -0,0 +1,178 @@
+package data_types
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"unsafe"
+)
+
+/*
+ * An IntType describes one integer type of Go:
+ * 1. Name  : The name used in code (e.g., byte)
+ * 2. Size  : Number of bits
+ * 3. Signed: It can hold negative values (stored in two's complement)
+ * 4. Alias : The type it is another name for, if any (byte is uint8 and rune is int32) */
+type IntType struct {
+	Name   string
+	Size   uint
+	Signed bool
+	Alias  string
+}
+
+// Every integer type, the sized ones first. int, uint and uintptr take the size of this platform.
+var INT_TYPES = []IntType{
+	{Name: "int8", Size: 8, Signed: true},
+	{Name: "int16", Size: 16, Signed: true},
+	{Name: "int32", Size: 32, Signed: true},
+	{Name: "int64", Size: 64, Signed: true},
+	{Name: "uint8", Size: 8},
+	{Name: "uint16", Size: 16},
+	{Name: "uint32", Size: 32},
+	{Name: "uint64", Size: 64},
+	{Name: "byte", Size: 8, Alias: "uint8"},
+	{Name: "rune", Size: 32, Signed: true, Alias: "int32"},
+	{Name: "int", Size: strconv.IntSize, Signed: true},
+	{Name: "uint", Size: strconv.IntSize},
+	{Name: "uintptr", Size: uint(unsafe.Sizeof(uintptr(0))) * 8},
+}
+
+// LookupIntType finds an integer type by name.
+func LookupIntType(name string) (IntType, bool) {
+	for _, t := range INT_TYPES {
+		if t.Name == name {
+			return t, true
+		}
+	}
+
+	return IntType{}, false
+}
+
+// Min returns the smallest value of the type: -2^(size-1) when signed, 0 otherwise.
+func (t IntType) Min() *big.Int {
+	if !t.Signed {
+		return new(big.Int)
+	}
+
+	return new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), t.Size-1))
+}
+
+// Max returns the largest value of the type: 2^(size-1)-1 when signed, 2^size-1 otherwise.
+func (t IntType) Max() *big.Int {
+	size := t.Size
+	if t.Signed {
+		size--
+	}
+
+	return new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), size), big.NewInt(1))
+}
+
+// Fits reports whether x is between Min and Max.
+func (t IntType) Fits(x *big.Int) bool {
+	return x.Cmp(t.Min()) >= 0 && x.Cmp(t.Max()) <= 0
+}
+
+/*
+ * Wrap returns what is left of x once it is stored in the type,
+ * the same way Go does on overflow and in conversions:
+ * only the lowest Size bits are kept, and for signed types those bits are read in two's complement.
+ * In other words, 2^Size is added or subtracted until the value fits. */
+func (t IntType) Wrap(x *big.Int) *big.Int {
+	modulus := new(big.Int).Lsh(big.NewInt(1), t.Size)
+
+	wrapped := new(big.Int).Mod(x, modulus) // Mod is always >= 0.
+	if t.Signed && wrapped.Cmp(t.Max()) > 0 {
+		wrapped.Sub(wrapped, modulus)
+	}
+
+	return wrapped
+}
+
+/*
+ * Bits returns the bit pattern the type stores for x (after Wrap), grouped by byte.
+ * For a negative number this is its two's complement: invert every bit of |x| and add 1. */
+func (t IntType) Bits(x *big.Int) string {
+	pattern := new(big.Int).Mod(x, new(big.Int).Lsh(big.NewInt(1), t.Size))
+	digits := fmt.Sprintf("%0*b", t.Size, pattern)
+
+	var groups []string
+	for i := 0; i < len(digits); i += 8 {
+		groups = append(groups, digits[i:i+8])
+	}
+
+	return strings.Join(groups, " ")
+}
+
+// Operators understood by Calculate.
+const INT_OPERATORS = "+ - * / % << >>"
+
+// Largest shift count Calculate accepts; anything from the size of the type up shifts every bit out anyway.
+const MAX_SHIFT = 1024
+
+/*
+ * An IntResult is the result of Calculate:
+ * 1. Exact   : The mathematical result, with no limit on its size
+ * 2. Result  : What a Go program computes with two variables of the type
+ * 3. Overflow: Exact does not fit in the type, so Result wrapped around
+ * 4. Panic   : The message of the run-time panic (a division by zero or a negative shift count); Result and Exact are nil then */
+type IntResult struct {
+	Exact    *big.Int
+	Result   *big.Int
+	Overflow bool
+	Panic    string
+}
+
+/*
+ * Calculate applies op to two variables a and b of the type.
+ *
+ * a and b must fit in the type. Like Go:
+ * 1. / and % truncate towards zero (-7 / 2 is -3)
+ * 2. Dividing by zero panics at run time (a constant zero would not compile)
+ * 3. The only overflowing division is the minimum of a signed type divided by -1
+ * 4. The shift count b must not be negative; >> on a signed type keeps the sign */
+func (t IntType) Calculate(a *big.Int, op string, b *big.Int) (IntResult, error) {
+	for _, x := range []*big.Int{a, b} {
+		if !t.Fits(x) {
+			return IntResult{}, fmt.Errorf("%s does not fit in %s (%s to %s)", x, t.Name, t.Min(), t.Max())
+		}
+	}
+
+	exact := new(big.Int)
+	switch op {
+	case "+":
+		exact.Add(a, b)
+	case "-":
+		exact.Sub(a, b)
+	case "*":
+		exact.Mul(a, b)
+	case "/", "%":
+		if b.Sign() == 0 {
+			return IntResult{Panic: "runtime error: integer divide by zero"}, nil
+		}
+		if op == "/" {
+			exact.Quo(a, b)
+		} else {
+			exact.Rem(a, b)
+		}
+	case "<<", ">>":
+		if b.Sign() < 0 {
+			return IntResult{Panic: "runtime error: negative shift amount"}, nil
+		}
+		// The exact result of a << 1_000_000 would be a million bits long.
+		if !b.IsUint64() || b.Uint64() > MAX_SHIFT {
+			return IntResult{}, fmt.Errorf("shift count %s is too large to show (at most %d)", b, MAX_SHIFT)
+		}
+		if op == "<<" {
+			exact.Lsh(a, uint(b.Uint64()))
+		} else {
+			exact.Rsh(a, uint(b.Uint64()))
+		}
+	default:
+		return IntResult{}, fmt.Errorf("unknown operator %q (use one of %s)", op, INT_OPERATORS)
+	}
+
+	result := t.Wrap(exact)
+
+	return IntResult{Exact: exact, Result: result, Overflow: result.Cmp(exact) != 0}, nil
+}
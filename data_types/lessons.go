@@ -0,0 +1,14 @@
+package data_types
+
+import "github.com/fajarstrtn/golang-tutorial/lesson"
+
+func init() {
+	lesson.Register(lesson.Lesson{ID: "data_types/numbers", Title: "Integers, floats, complex numbers, bytes, runes and uintptr", Topic: "data_types", Order: 500, Run: GenerateNumbers})
+	lesson.Register(lesson.Lesson{ID: "data_types/overflow", Title: "Integer limits, overflow and wraparound", Topic: "data_types", Order: 505, Run: GenerateIntegerOverflow})
+	lesson.Register(lesson.Lesson{ID: "data_types/ieee754", Title: "Floating-point numbers bit by bit (IEEE 754)", Topic: "data_types", Order: 506, Run: GenerateFloatingPoint})
+	lesson.Register(lesson.Lesson{ID: "data_types/memory", Title: "Memory layout: size, alignment, padding and uintptr", Topic: "data_types", Order: 507, Run: GenerateMemoryLayout})
+	lesson.Register(lesson.Lesson{ID: "data_types/complex", Title: "Complex numbers and math/cmplx", Topic: "data_types", Order: 508, Run: GenerateComplexNumbers})
+	lesson.Register(lesson.Lesson{ID: "data_types/strings", Title: "Strings", Topic: "data_types", Order: 510, Run: GenerateStrings})
+	lesson.Register(lesson.Lesson{ID: "data_types/utf8", Title: "UTF-8 bytes, runes and grapheme clusters", Topic: "data_types", Order: 515, Run: GenerateUTF8})
+	lesson.Register(lesson.Lesson{ID: "data_types/booleans", Title: "Booleans", Topic: "data_types", Order: 520, Run: GenerateBooleans})
+}
@@ -0,0 +1,159 @@
+package data_types
+
+import (
+	"fmt"
+	"io"
+	"unsafe"
+
+	"github.com/fajarstrtn/golang-tutorial/lesson"
+)
+
+// The struct of the padding examples, as Go source for ParseStructLayouts.
+const PADDED_STRUCT = "struct{ a bool; b int64; c bool }"
+
+func getSizeAndAlignment(w io.Writer) {
+	/*
+	 * The unsafe package reports how values are laid out in memory (on a 64-bit system here):
+	 * 1. unsafe.Sizeof  : Bytes a value takes, without what it points to (a string is a pointer and a length)
+	 * 2. unsafe.Alignof : Its address is always a multiple of this
+	 * 3. unsafe.Offsetof: Bytes from the start of a struct to one of its fields
+	 *
+	 * They are computed by the compiler, so they are constants and cost nothing at run time. */
+	fmt.Fprintln(w, unsafe.Sizeof(true), unsafe.Alignof(true))                  // Output: 1 1
+	fmt.Fprintln(w, unsafe.Sizeof(int16(0)), unsafe.Alignof(int16(0)))          // Output: 2 2
+	fmt.Fprintln(w, unsafe.Sizeof(int64(0)), unsafe.Alignof(int64(0)))          // Output: 8 8
+	fmt.Fprintln(w, unsafe.Sizeof(complex128(0)), unsafe.Alignof(0i))           // Output: 16 8
+	fmt.Fprintln(w, unsafe.Sizeof(""), unsafe.Sizeof([]int{}))                  // Output: 16 24
+	fmt.Fprintln(w, unsafe.Sizeof([3]int16{}), unsafe.Alignof([3]int16{}))      // Output: 6 2
+	fmt.Fprintln(w, unsafe.Sizeof(struct{}{}), unsafe.Sizeof([1000]struct{}{})) // Output: 0 0
+
+	/*
+	 * Each field of a struct starts at a multiple of its alignment, so the compiler
+	 * puts unused bytes (padding) in front of it when needed. The struct is aligned like
+	 * its most aligned field, and its size is rounded up to that alignment too,
+	 * so that the elements of an array of it stay aligned. */
+	var padded struct {
+		a bool
+		b int64
+		c bool
+	}
+	fmt.Fprintln(w, unsafe.Sizeof(padded), unsafe.Alignof(padded))                                   // Output: 24 8
+	fmt.Fprintln(w, unsafe.Offsetof(padded.a), unsafe.Offsetof(padded.b), unsafe.Offsetof(padded.c)) // Output: 0 8 16
+}
+
+func getPadding(w io.Writer) {
+	layout := mustParseStructLayout(PADDED_STRUCT, "amd64")
+
+	/*
+	 * ParseStructLayouts computes the same numbers from Go source, for any architecture,
+	 * and Diagram draws them: one row per 8 bytes, a letter per field byte and a dot per padding byte.
+	 * Only 10 of the 24 bytes are used: 7 bytes of padding after a, and 7 more at the end.
+	 *
+	 * Output:
+	 *  0 |A|.|.|.|.|.|.|.|
+	 *  8 |B|B|B|B|B|B|B|B|
+	 * 16 |C|.|.|.|.|.|.|.| */
+	fmt.Fprint(w, layout.Diagram())
+
+	fmt.Fprintln(w, layout.Size, layout.Padding()) // Output: 24 14
+
+	/*
+	 * Output:
+	 * a bool  offset 0  size 1 align 1 padding 7
+	 * b int64 offset 8  size 8 align 8 padding 0
+	 * c bool  offset 16 size 1 align 1 padding 7 */
+	for _, f := range layout.Fields {
+		fmt.Fprintf(w, "%s %-5s offset %-2d size %d align %d padding %d\n", f.Name, f.Type, f.Offset, f.Size, f.Align, f.Padding)
+	}
+
+	/*
+	 * The layout depends on the architecture: on 386 (32-bit), int64 is only aligned to 4 bytes.
+	 *
+	 * Output:
+	 *  0 |A|.|.|.|
+	 *  4 |B|B|B|B|
+	 *    ...
+	 * 12 |C|.|.|.| */
+	fmt.Fprint(w, mustParseStructLayout(PADDED_STRUCT, "386").Diagram())
+}
+
+func getFieldReordering(w io.Writer) {
+	reordered := mustParseStructLayout(PADDED_STRUCT, "amd64").Reordered()
+
+	/*
+	 * The compiler never reorders fields: they stay in the order they are written.
+	 * Writing them from the most aligned to the least aligned removes the padding between them,
+	 * which gives the smallest size. It matters for structs stored by the million (e.g., in a slice).
+	 *
+	 * Reordered suggests that order; the letters keep naming the same fields.
+	 *
+	 * Output:
+	 *  0 |B|B|B|B|B|B|B|B|
+	 *  8 |A|C|.|.|.|.|.|.| */
+	fmt.Fprint(w, reordered.Diagram())
+
+	var compact struct {
+		b int64
+		a bool
+		c bool
+	}
+	fmt.Fprintln(w, reordered.Size, unsafe.Sizeof(compact)) // Output: 16 16
+
+	/*
+	 * Try your own structs with go run . layout
+	 * (e.g., go run . layout 'struct{ a bool; b int64; c bool }', or a .go file with type declarations). */
+	fmt.Fprintln(w, reordered.Fields[0].Name, reordered.Fields[1].Name, reordered.Fields[2].Name) // Output: b a c
+}
+
+func getUintptrPatterns(env *lesson.Env) {
+	w := env.Out
+
+	/*
+	 * unsafe.Pointer can point to anything, and uintptr holds an address as a number.
+	 * The garbage collector can move or free memory that only a uintptr refers to,
+	 * so the unsafe package only allows these patterns:
+	 * 1. Convert *T1 to unsafe.Pointer to *T2, when T2 is no larger than T1 and has the same layout
+	 * 2. Convert unsafe.Pointer to uintptr, only to print or compute with the number (never back)
+	 * 3. Convert unsafe.Pointer to uintptr, do arithmetic, and convert it back, all in one expression,
+	 *    and only to point inside the same variable
+	 * 4. Convert to uintptr in the argument list of a call to syscall.Syscall
+	 * 5. Convert the result of reflect.Value.Pointer or reflect.Value.UnsafeAddr, in the same expression
+	 *
+	 * Since Go 1.17, unsafe.Add and unsafe.Slice do the arithmetic of pattern 3 without uintptr at all.
+	 *
+	 * These look similar but are wrong, and go vet reports some of them ("possible misuse of unsafe.Pointer"):
+	 * 1. Keeping the uintptr in a variable and converting it back later: the variable may have moved
+	 * 2. Pointing outside the variable (e.g., &n + 8 for an int n, or past the end of an array)
+	 * 3. Converting to a larger type (e.g., *int32 to *int64): it reads memory that is not part of the variable */
+	f := 1.5
+	bits := *(*uint64)(unsafe.Pointer(&f)) // Pattern 1, what math.Float64bits does
+	fmt.Fprintf(w, "%#x\n", bits)          // Output: 0x3ff8000000000000
+
+	point := struct{ X, Y int64 }{X: 1, Y: 2}
+	addr := uintptr(unsafe.Pointer(&point))                                             // Pattern 2
+	fmt.Fprintln(w, addr%unsafe.Alignof(point) == 0)                                    // Output: true
+	fmt.Fprintf(w, "%#x\n", env.Addr(addr))                                             // Output: 0xc00000a0c8
+	fmt.Fprintln(w, uintptr(unsafe.Pointer(&point.Y))-addr == unsafe.Offsetof(point.Y)) // Output: true
+
+	y := (*int64)(unsafe.Pointer(uintptr(unsafe.Pointer(&point)) + unsafe.Offsetof(point.Y))) // Pattern 3
+	fmt.Fprintln(w, *y)                                                                       // Output: 2
+
+	y = (*int64)(unsafe.Add(unsafe.Pointer(&point), unsafe.Offsetof(point.Y))) // The same with unsafe.Add
+	*y = 20
+	fmt.Fprintln(w, point.Y) // Output: 20
+
+	numbers := [4]int32{10, 20, 30, 40}
+	third := (*int32)(unsafe.Add(unsafe.Pointer(&numbers[0]), 2*unsafe.Sizeof(numbers[0])))
+	fmt.Fprintln(w, *third)                                       // Output: 30
+	fmt.Fprintln(w, unsafe.Slice(&numbers[1], 2))                 // Output: [20 30]
+	fmt.Fprintln(w, unsafe.String(unsafe.StringData("héllo"), 3)) // Output: hé
+}
+
+// mustParseStructLayout returns the layout of a struct type written by the lessons themselves.
+func mustParseStructLayout(src, arch string) StructLayout {
+	layouts, err := ParseStructLayouts(src, arch)
+	if err != nil {
+		panic(err)
+	}
+	return layouts[0]
+}
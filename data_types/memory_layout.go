@@ -0,0 +1,283 @@
+package data_types
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/scanner"
+	"go/token"
+	"go/types"
+	"runtime"
+	"sort"
+	"strings"
+)
+
+// FIELD_SYMBOLS are the characters that stand for the fields in a layout diagram, in order.
+const FIELD_SYMBOLS = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789"
+
+// PADDING_SYMBOL stands for a padding byte in a layout diagram.
+const PADDING_SYMBOL = '.'
+
+/*
+ * A FieldLayout is where a struct field lives in memory, what unsafe.Offsetof, unsafe.Sizeof
+ * and unsafe.Alignof report for it:
+ * 1. Offset : Bytes from the start of the struct
+ * 2. Size   : Bytes the field takes
+ * 3. Align  : Its offset is always a multiple of this
+ * 4. Padding: Unused bytes between this field and the next one (or the end of the struct)
+ * 5. Symbol : The character that stands for it in Diagram; it stays the same in Reordered
+ * Name is the type name for an embedded field (e.g., Time for an embedded time.Time). */
+type FieldLayout struct {
+	Name     string
+	Type     string
+	Embedded bool
+	Offset   int64
+	Size     int64
+	Align    int64
+	Padding  int64
+	Symbol   byte
+}
+
+/*
+ * A StructLayout is the memory layout of a struct type for one architecture.
+ * Size is always a multiple of Align, so that every element of an array of the struct is aligned. */
+type StructLayout struct {
+	Name     string
+	Arch     string
+	WordSize int64
+	Size     int64
+	Align    int64
+	Fields   []FieldLayout
+
+	vars    []*types.Var
+	symbols []byte
+	sizes   types.Sizes
+}
+
+/*
+ * ParseStructLayouts type checks Go source and returns the layout of every struct type in it,
+ * in the order they are declared. The source can be:
+ * 1. A struct type (e.g., struct{ a bool; b int64 })
+ * 2. Type declarations (e.g., type Point struct{ X, Y float64 }), with or without a package clause
+ *
+ * Fields may use other declared types and the standard library (e.g., time.Time) when it is imported.
+ * arch is a GOARCH value (e.g., amd64, 386, arm); empty means the architecture this program runs on. */
+func ParseStructLayouts(src, arch string) ([]StructLayout, error) {
+	if arch == "" {
+		arch = runtime.GOARCH
+	}
+	sizes := types.SizesFor("gc", arch)
+	if sizes == nil {
+		return nil, fmt.Errorf("unknown architecture %q", arch)
+	}
+
+	trimmed := strings.TrimSpace(src)
+	wrapped := true
+	switch {
+	case strings.HasPrefix(trimmed, "struct"):
+		src = "package layout\n\ntype struct_ " + trimmed + "\n"
+	case !strings.HasPrefix(trimmed, "package"):
+		src = "package layout\n\n" + trimmed + "\n"
+	default:
+		wrapped = false
+	}
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "layout.go", src, parser.SkipObjectResolution)
+	if err != nil {
+		return nil, withoutPosition(err, wrapped)
+	}
+
+	config := types.Config{Importer: importer.Default(), Sizes: sizes}
+	pkg, err := config.Check("layout", fset, []*ast.File{file}, nil)
+	if err != nil {
+		return nil, withoutPosition(err, wrapped)
+	}
+
+	var layouts []StructLayout
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			name := spec.(*ast.TypeSpec).Name.Name
+			s, ok := pkg.Scope().Lookup(name).Type().Underlying().(*types.Struct)
+			if !ok {
+				continue
+			}
+			if name == "struct_" {
+				name = "struct"
+			}
+
+			vars := make([]*types.Var, s.NumFields())
+			symbols := make([]byte, s.NumFields())
+			for i := range vars {
+				vars[i] = s.Field(i)
+				symbols[i] = FieldSymbol(i)
+			}
+			layouts = append(layouts, newStructLayout(name, arch, vars, symbols, sizes))
+		}
+	}
+	if len(layouts) == 0 {
+		return nil, fmt.Errorf("no struct type found")
+	}
+
+	return layouts, nil
+}
+
+// withoutPosition drops the position of an error in source that was wrapped in a package, since it would not match the input.
+func withoutPosition(err error, wrapped bool) error {
+	if !wrapped {
+		return err
+	}
+
+	var list scanner.ErrorList
+	var typeErr types.Error
+	switch {
+	case errors.As(err, &list) && len(list) > 0:
+		return errors.New(list[0].Msg)
+	case errors.As(err, &typeErr):
+		return errors.New(typeErr.Msg)
+	}
+	return err
+}
+
+// The types of the parsed source are printed without a package name; the imported ones keep theirs (e.g., time.Time).
+func qualifier(pkg *types.Package) string {
+	if pkg.Path() == "layout" {
+		return ""
+	}
+	return pkg.Name()
+}
+
+func newStructLayout(name, arch string, vars []*types.Var, symbols []byte, sizes types.Sizes) StructLayout {
+	s := types.NewStruct(vars, nil)
+	layout := StructLayout{
+		Name:     name,
+		Arch:     arch,
+		WordSize: sizes.Sizeof(types.Typ[types.Uintptr]),
+		Size:     sizes.Sizeof(s),
+		Align:    sizes.Alignof(s),
+		vars:     vars,
+		symbols:  symbols,
+		sizes:    sizes,
+	}
+
+	offsets := sizes.Offsetsof(vars)
+	for i, v := range vars {
+		field := FieldLayout{
+			Name:     v.Name(),
+			Type:     types.TypeString(v.Type(), qualifier),
+			Offset:   offsets[i],
+			Size:     sizes.Sizeof(v.Type()),
+			Align:    sizes.Alignof(v.Type()),
+			Symbol:   symbols[i],
+			Embedded: v.Embedded(),
+		}
+		if i > 0 {
+			previous := &layout.Fields[i-1]
+			previous.Padding = field.Offset - previous.Offset - previous.Size
+		}
+		layout.Fields = append(layout.Fields, field)
+	}
+	if n := len(layout.Fields); n > 0 {
+		last := &layout.Fields[n-1]
+		last.Padding = layout.Size - last.Offset - last.Size
+	}
+
+	return layout
+}
+
+// Padding returns the number of unused bytes in the struct.
+func (l StructLayout) Padding() int64 {
+	var padding int64
+	for _, f := range l.Fields {
+		padding += f.Padding
+	}
+	return padding
+}
+
+/*
+ * Reordered returns the layout with the fields sorted for the smallest possible size:
+ * 1. Zero-size fields first (e.g., [0]int or struct{}): as the last field, one would get padding,
+ *    so that its address does not point past the end of the struct
+ * 2. Then by alignment, largest first: every field starts right where the previous one ends,
+ *    so only the padding at the end remains
+ * Fields that compare equal keep their order. */
+func (l StructLayout) Reordered() StructLayout {
+	order := make([]int, len(l.vars))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		a, b := l.Fields[order[i]], l.Fields[order[j]]
+		if (a.Size == 0) != (b.Size == 0) {
+			return a.Size == 0
+		}
+		return a.Align > b.Align
+	})
+
+	vars := make([]*types.Var, len(order))
+	symbols := make([]byte, len(order))
+	for i, field := range order {
+		vars[i] = l.vars[field]
+		symbols[i] = l.symbols[field]
+	}
+
+	return newStructLayout(l.Name, l.Arch, vars, symbols, l.sizes)
+}
+
+/*
+ * Diagram draws the memory of the struct, one row per word (8 bytes on 64-bit systems).
+ * Each byte is the Symbol of its field (A for the first field, B for the second, ...),
+ * or PADDING_SYMBOL for padding. Rows that repeat the one before are left out.
+ *
+ * For struct{ a bool; b int64; c bool } on amd64:
+ *     0 |A|.|.|.|.|.|.|.|
+ *     8 |B|B|B|B|B|B|B|B|
+ *    16 |C|.|.|.|.|.|.|.| */
+func (l StructLayout) Diagram() string {
+	memory := make([]byte, l.Size)
+	for i := range memory {
+		memory[i] = PADDING_SYMBOL
+	}
+	for _, f := range l.Fields {
+		for b := f.Offset; b < f.Offset+f.Size; b++ {
+			memory[b] = f.Symbol
+		}
+	}
+
+	var sb strings.Builder
+	width := len(fmt.Sprint(l.Size))
+	skipped := false
+	for start := int64(0); start < l.Size; start += l.WordSize {
+		row := memory[start:min(start+l.WordSize, l.Size)]
+		if start > 0 && start+l.WordSize < l.Size && string(row) == string(memory[start-l.WordSize:start]) {
+			if !skipped {
+				fmt.Fprintf(&sb, "%*s ...\n", width, "")
+				skipped = true
+			}
+			continue
+		}
+		skipped = false
+
+		fmt.Fprintf(&sb, "%*d |", width, start)
+		for _, b := range row {
+			fmt.Fprintf(&sb, "%c|", b)
+		}
+		sb.WriteByte('\n')
+	}
+
+	return sb.String()
+}
+
+// FieldSymbol returns the symbol of the i-th field in a diagram.
+func FieldSymbol(i int) byte {
+	if i < len(FIELD_SYMBOLS) {
+		return FIELD_SYMBOLS[i]
+	}
+	return '#'
+}
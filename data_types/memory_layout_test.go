@@ -0,0 +1,78 @@
+package data_types
+
+import (
+	"reflect"
+	"testing"
+	"time"
+	"unsafe"
+)
+
+// The layouts computed from source must match what the compiler really does on this machine.
+func TestStructLayoutsMatchReflect(t *testing.T) {
+	type order struct {
+		Paid     bool
+		ID       int64
+		Created  time.Time
+		Shipped  bool
+		Quantity int32
+		Tags     []string
+		Items    [3]uint16
+	}
+
+	src := `import "time"
+
+type order struct {
+	Paid     bool
+	ID       int64
+	Created  time.Time
+	Shipped  bool
+	Quantity int32
+	Tags     []string
+	Items    [3]uint16
+}`
+	layouts, err := ParseStructLayouts(src, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	layout, typ := layouts[0], reflect.TypeOf(order{})
+	if layout.Size != int64(typ.Size()) || layout.Align != int64(typ.Align()) {
+		t.Errorf("size %d align %d, want %d and %d", layout.Size, layout.Align, typ.Size(), typ.Align())
+	}
+	for i, f := range layout.Fields {
+		field := typ.Field(i)
+		if f.Name != field.Name || f.Offset != int64(field.Offset) || f.Size != int64(field.Type.Size()) || f.Align != int64(field.Type.Align()) {
+			t.Errorf("field %d is %+v, want %s at offset %d, size %d, align %d", i, f, field.Name, field.Offset, field.Type.Size(), field.Type.Align())
+		}
+	}
+
+	reordered := layout.Reordered()
+	if reordered.Size > layout.Size || reordered.Padding() >= reordered.Fields[0].Align {
+		t.Errorf("reordered size %d with %d bytes of padding, from %d", reordered.Size, reordered.Padding(), layout.Size)
+	}
+}
+
+// A zero-size field at the end gets padding, so the smallest order puts zero-size fields first.
+func TestReorderedPutsZeroSizeFieldsFirst(t *testing.T) {
+	type trailing struct {
+		a int
+		b [0]int
+	}
+	type leading struct {
+		b [0]int
+		a int
+	}
+
+	layouts, err := ParseStructLayouts("struct{ a int; b [0]int }", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	layout, reordered := layouts[0], layouts[0].Reordered()
+	if layout.Size != int64(unsafe.Sizeof(trailing{})) {
+		t.Errorf("size %d, want %d", layout.Size, unsafe.Sizeof(trailing{}))
+	}
+	if reordered.Size != int64(unsafe.Sizeof(leading{})) || reordered.Fields[0].Name != "b" {
+		t.Errorf("reordered to %+v (%d bytes), want b first and %d bytes", reordered.Fields, reordered.Size, unsafe.Sizeof(leading{}))
+	}
+}
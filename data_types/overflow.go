@@ -0,0 +1,181 @@
+package data_types
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"math/bits"
+)
+
+func getIntegerLimits(w io.Writer) {
+	/*
+	 * Every integer type has a fixed number of bits, so it has a minimum and a maximum:
+	 * 1. Unsigned, n bits: 0 to 2^n - 1
+	 * 2. Signed, n bits  : -2^(n-1) to 2^(n-1) - 1
+	 *
+	 * The math package has them as constants (e.g., math.MinInt8, math.MaxUint16).
+	 *
+	 * Output:
+	 * int8    8 bits  -128 to 127
+	 * int16   16 bits -32768 to 32767
+	 * int32   32 bits -2147483648 to 2147483647
+	 * int64   64 bits -9223372036854775808 to 9223372036854775807
+	 * uint8   8 bits  0 to 255
+	 * uint16  16 bits 0 to 65535
+	 * uint32  32 bits 0 to 4294967295
+	 * uint64  64 bits 0 to 18446744073709551615
+	 * byte    8 bits  0 to 255
+	 * rune    32 bits -2147483648 to 2147483647
+	 * int     64 bits -9223372036854775808 to 9223372036854775807
+	 * uint    64 bits 0 to 18446744073709551615
+	 * uintptr 64 bits 0 to 18446744073709551615 */
+	for _, t := range INT_TYPES {
+		fmt.Fprintf(w, "%-7s %-7s %s to %s\n", t.Name, fmt.Sprintf("%d bits", t.Size), t.Min(), t.Max())
+	}
+
+	fmt.Fprintln(w, math.MinInt8, math.MaxInt8) // Output: -128 127
+	fmt.Fprintln(w, uint16(math.MaxUint16))     // Output: 65535
+	fmt.Fprintln(w, int64(math.MinInt64))       // Output: -9223372036854775808
+	fmt.Fprintln(w, uint64(math.MaxUint64))     // Output: 18446744073709551615
+
+	/*
+	 * Signed integers are stored in two's complement:
+	 * the highest bit counts as -2^(n-1) instead of +2^(n-1).
+	 *
+	 * To negate a number, invert every bit and add 1 (e.g., 1 is 00000001, so -1 is 11111110 + 1 = 11111111).
+	 *
+	 * That is why there is one more negative number than positive numbers:
+	 * -128 (10000000) exists in int8, but +128 does not.
+	 *
+	 * %08b of uint8(x) shows the 8 bits of an int8 x, because the conversion keeps the bits as they are.
+	 *
+	 * Output:
+	 *    0 = 00000000
+	 *    1 = 00000001
+	 *  127 = 01111111
+	 *   -1 = 11111111
+	 *   -2 = 11111110
+	 * -128 = 10000000 */
+	for _, x := range []int8{0, 1, 127, -1, -2, -128} {
+		fmt.Fprintf(w, "%4d = %08b\n", x, uint8(x))
+	}
+}
+
+func getIntegerOverflow(w io.Writer) {
+	/*
+	 * When the result of an operation does not fit in its type, Go does not stop the program
+	 * and does not report an error: it keeps the lowest bits and drops the others.
+	 * The value wraps around, like the odometer of a car going from 999999 to 000000.
+	 *
+	 * Only constant expressions are checked by the compiler:
+	 * var x int8 = 127 + 1 does not compile (constant 128 overflows int8). */
+	var a int8 = 127
+	a++
+	fmt.Fprintln(w, a)                 // Output: -128
+	fmt.Fprintf(w, "%08b\n", uint8(a)) // Output: 10000000
+	fmt.Fprintln(w, a-1)               // Output: 127
+	fmt.Fprintln(w, a*2)               // Output: 0
+
+	// Going below the minimum is called underflow. For unsigned types it happens as soon as you go below 0.
+	var b uint8 = 0
+	b--
+	fmt.Fprintln(w, b) // Output: 255
+
+	var c, d uint = 1200, 1800
+	fmt.Fprintln(w, c-d) // Output: 18446744073709551016
+
+	/*
+	 * The f * g of the signed integers lesson: 16000 * 120 = 1920000 needs 21 bits.
+	 * int16 keeps the lowest 16 bits (0100110000000000), which is 19456.
+	 * In other words, 1920000 - 29 * 65536 = 19456. */
+	var f, g int16 = 16_000, 120
+	fmt.Fprintln(w, f*g)                          // Output: 19456
+	fmt.Fprintln(w, int32(f)*int32(g))            // Output: 1920000
+	fmt.Fprintf(w, "%b\n", int32(f)*int32(g))     // Output: 111010100110000000000
+	fmt.Fprintf(w, "%016b\n", uint16(f*g))        // Output: 0100110000000000
+	fmt.Fprintln(w, int32(f)*int32(g)-29*(1<<16)) // Output: 19456
+
+	/*
+	 * Negating the minimum overflows too, because its positive twin does not exist.
+	 * math.MinInt8 / -1 is the only division that overflows; it does not panic. */
+	var minimum, minusOne int8 = math.MinInt8, -1
+	fmt.Fprintln(w, -minimum)         // Output: -128
+	fmt.Fprintln(w, minimum/minusOne) // Output: -128
+
+	/*
+	 * Overflow can be detected before it happens:
+	 * 1. Compare with the limits first (a > math.MaxInt8 - b means a + b overflows when b > 0)
+	 * 2. Use the math/bits package, which returns the carry of unsigned operations
+	 * 3. Do the math in a wider type and check the result (e.g., int32 for int16 operands) */
+	var x, y int8 = 100, 50
+	fmt.Fprintln(w, x > math.MaxInt8-y) // Output: true
+
+	sum, carry := bits.Add64(math.MaxUint64, 1, 0)
+	fmt.Fprintln(w, sum, carry) // Output: 0 1
+
+	hi, lo := bits.Mul64(1<<40, 1<<40)
+	fmt.Fprintln(w, hi, lo) // Output: 65536 0
+}
+
+func getIntegerConversions(w io.Writer) {
+	/*
+	 * Converting to a narrower type (narrowing) follows the same rule:
+	 * the lowest bits are kept, the others are dropped.
+	 * 300 is 00000001 00101100, and int8 keeps 00101100, which is 44.
+	 *
+	 * Converting between signed and unsigned types of the same size keeps every bit,
+	 * so -1 (11111111) becomes 255 in uint8.
+	 *
+	 * Like overflow, it only fails to compile for constants: int8(300) is rejected. */
+	var n int16 = 300
+	fmt.Fprintln(w, int8(n)) // Output: 44
+
+	var m int = -1
+	fmt.Fprintln(w, uint8(m))  // Output: 255
+	fmt.Fprintln(w, uint32(m)) // Output: 4294967295
+
+	var wide int64 = 1<<32 + 5
+	fmt.Fprintln(w, int32(wide)) // Output: 5
+
+	var r rune = 'é'
+	fmt.Fprintln(w, byte(r)) // Output: 233
+
+	var u uint8 = 200
+	fmt.Fprintln(w, int8(u)) // Output: -56
+
+	// Widening never loses anything: a signed value is extended with copies of its sign bit.
+	var s int8 = -56
+	fmt.Fprintln(w, int64(s))                   // Output: -56
+	fmt.Fprintf(w, "%016b\n", uint16(int16(s))) // Output: 1111111111001000
+}
+
+func getIntegerDivisionByZero(w io.Writer) {
+	/*
+	 * Dividing an integer by zero is not an overflow:
+	 * there is no answer at all, so the program panics at run time
+	 * with "runtime error: integer divide by zero".
+	 * x / 0 with a constant 0 does not even compile (invalid operation: division by zero).
+	 *
+	 * The panic can be recovered, which is how the function below turns it into an error. */
+	divide := func(a, b int) (result int, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = fmt.Errorf("%v", r)
+			}
+		}()
+
+		return a / b, nil
+	}
+
+	zero := 0
+
+	_, err := divide(10, zero)
+	fmt.Fprintln(w, err) // Output: runtime error: integer divide by zero
+
+	result, err := divide(10, 3)
+	fmt.Fprintln(w, result, err) // Output: 3 <nil>
+
+	// Floating-point numbers do not panic: dividing by zero gives an infinity (or NaN for 0 / 0).
+	var fzero float64
+	fmt.Fprintln(w, 1/fzero, -1/fzero, fzero/fzero) // Output: +Inf -Inf NaN
+}
@@ -2,12 +2,13 @@ package data_types
 
 import (
 	"fmt"
+	"io"
 	"unsafe"
 )
 
 const MESSAGE_TEMPLATE = "Rune %d is '%c' (Unicode: U+%04X)\n"
 
-func getRune() {
+func getRune(w io.Writer) {
 	/*
 	 * Go has rune data type which is comparable to "char" type in java.
 	 * It represents a single Unicode code point, meaning it represents a character
@@ -25,17 +26,19 @@ func getRune() {
 	 *
 	 * Use byte for raw data and ASCII, rune for character and unicode. */
 	var char rune = 'A'
-	fmt.Printf("%d\n", char)                // Output: 65
-	fmt.Printf("%c\n", char)                // Output: A
-	fmt.Printf("%T\n", char)                // Output: int32
-	fmt.Printf("%d\n", unsafe.Sizeof(char)) // Output: 4
+	fmt.Fprintf(w, "%d\n", char)                // Output: 65
+	fmt.Fprintf(w, "%c\n", char)                // Output: A
+	fmt.Fprintf(w, "%T\n", char)                // Output: int32
+	fmt.Fprintf(w, "%d\n", unsafe.Sizeof(char)) // Output: 4
 
 	en := "A"
-	fmt.Printf("%v\n", len(en)) // Output: 1
+	fmt.Fprintf(w, "%v\n", len(en)) // Output: 1
 
 	// rune exists because UTF-8 characters can be multiple bytes, あ stores 3 bytes.
 	jp := "あ"
-	fmt.Printf("%v\n", len(jp)) // Output: 3
+	fmt.Fprintf(w, "%v\n", len(jp)) // Output: 3
+
+	txt := "Flabbergasted"
 
 	/*
 	 * Finally, Go provides a nifty way of iterating through string
@@ -59,20 +62,19 @@ func getRune() {
 	 * Rune 10 is 't' (Unicode: U+0074)
 	 * Rune 11 is 'e' (Unicode: U+0065)
 	 * Rune 12 is 'd' (Unicode: U+0064) */
-	txt := "Flabbergasted"
-	iterateString(txt)
+	iterateString(w, txt)
 
 	/*
 	 * But rune counts correctly.
 	 * In Go, string indexing gives byte, not character. */
 	for _, r := range jp {
-		fmt.Printf("%d\n", r) // Output: 12354
-		fmt.Printf("%c\n", r) // Output: あ
+		fmt.Fprintf(w, "%d\n", r) // Output: 12354
+		fmt.Fprintf(w, "%c\n", r) // Output: あ
 	}
 
 	// A for 1 byte and あ for 3 bytes.
 	str := "Aあ"
-	fmt.Println(len(str)) // Output: 4
+	fmt.Fprintln(w, len(str)) // Output: 4
 
 	/*
 	 * Output:
@@ -83,47 +85,51 @@ func getRune() {
 	 * 12354
 	 * int32 */
 	for _, r := range str {
-		fmt.Printf("%v\n", string(r))
-		fmt.Printf("%v\n", r)
-		fmt.Printf("%T\n", r)
+		fmt.Fprintf(w, "%v\n", string(r))
+		fmt.Fprintf(w, "%v\n", r)
+		fmt.Fprintf(w, "%T\n", r)
+	}
 
-		var emoji rune = '😀'
-		fmt.Println(emoji)        // Output: 128512
-		fmt.Printf("%c\n", emoji) // Output: 😀
+	var emoji rune = '😀'
+	fmt.Fprintln(w, emoji)        // Output: 128512
+	fmt.Fprintf(w, "%c\n", emoji) // Output: 😀
 
-		txt = "Hello, 世界"
-		runes := []rune(txt)
-		fmt.Printf("Length in bytes: %d\n", len(txt))   // Output: Length in bytes: 13
-		fmt.Printf("Length in runes: %d\n", len(runes)) // Output: Length in runes: 9
+	/*
+	 * A rune is a code point, not always a whole character: é can also be e + a combining accent,
+	 * and emoji like 👩‍💻 are several runes. The UTF-8 lesson (data_types/utf8) goes further,
+	 * and go run . utf8 <string> prints the bytes, runes, names and grapheme clusters of any string. */
+	txt = "Hello, 世界"
+	runes := []rune(txt)
+	fmt.Fprintf(w, "Length in bytes: %d\n", len(txt))   // Output: Length in bytes: 13
+	fmt.Fprintf(w, "Length in runes: %d\n", len(runes)) // Output: Length in runes: 9
 
-		/*
-		 * Output:
-		 * Rune 0 is 'H' (Unicode: U+0048)
-		 * Rune 1 is 'e' (Unicode: U+0065)
-		 * Rune 2 is 'l' (Unicode: U+006C)
-		 * Rune 3 is 'l' (Unicode: U+006C)
-		 * Rune 4 is 'o' (Unicode: U+006F)
-		 * Rune 5 is ',' (Unicode: U+002C)
-		 * Rune 6 is ' ' (Unicode: U+0020)
-		 * Rune 7 is '世' (Unicode: U+4E16)
-		 * Rune 8 is '界' (Unicode: U+754C) */
-		iterateRunes(runes)
-	}
+	/*
+	 * Output:
+	 * Rune 0 is 'H' (Unicode: U+0048)
+	 * Rune 1 is 'e' (Unicode: U+0065)
+	 * Rune 2 is 'l' (Unicode: U+006C)
+	 * Rune 3 is 'l' (Unicode: U+006C)
+	 * Rune 4 is 'o' (Unicode: U+006F)
+	 * Rune 5 is ',' (Unicode: U+002C)
+	 * Rune 6 is ' ' (Unicode: U+0020)
+	 * Rune 7 is '世' (Unicode: U+4E16)
+	 * Rune 8 is '界' (Unicode: U+754C) */
+	iterateRunes(w, runes)
 }
 
-func iterateString(txt string) {
+func iterateString(w io.Writer, txt string) {
 	/*
 	 * For simple iteration without in-place modification,
 	 * using for...range on the string directly is generally preferred
 	 * as it avoids memory allocation for a new []rune slice.
 	 * This produces the exact same rune values as ranging over a []rune slice. */
 	for i, r := range txt {
-		fmt.Printf(MESSAGE_TEMPLATE, i, r, r)
+		fmt.Fprintf(w, MESSAGE_TEMPLATE, i, r, r)
 	}
 }
 
-func iterateRunes(runes []rune) {
+func iterateRunes(w io.Writer, runes []rune) {
 	for i, r := range runes {
-		fmt.Printf(MESSAGE_TEMPLATE, i, r, r)
+		fmt.Fprintf(w, MESSAGE_TEMPLATE, i, r, r)
 	}
 }
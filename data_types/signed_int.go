@@ -2,25 +2,26 @@ package data_types
 
 import (
 	"fmt"
+	"io"
 	"unsafe"
 )
 
-func getSignedIntegers() {
+func getSignedIntegers(w io.Writer) {
 	var a int8 = 8
-	fmt.Printf("%d\n", a) // Output: 8
-	fmt.Printf("%T\n", a) // Output: int8
+	fmt.Fprintf(w, "%d\n", a) // Output: 8
+	fmt.Fprintf(w, "%T\n", a) // Output: int8
 
 	var b int16 = 16_000
-	fmt.Printf("%d\n", b) // Output: 16000
-	fmt.Printf("%T\n", b) // Output: int16
+	fmt.Fprintf(w, "%d\n", b) // Output: 16000
+	fmt.Fprintf(w, "%T\n", b) // Output: int16
 
 	var c int32 = 32_000_000
-	fmt.Printf("%d\n", c) // Output: 32000000
-	fmt.Printf("%T\n", c) // Output: int32
+	fmt.Fprintf(w, "%d\n", c) // Output: 32000000
+	fmt.Fprintf(w, "%T\n", c) // Output: int32
 
 	var d int64 = 64_000_000_000
-	fmt.Printf("%d\n", d) // Output: 64000000000
-	fmt.Printf("%T\n", d) // Output: int64
+	fmt.Fprintf(w, "%d\n", d) // Output: 64000000000
+	fmt.Fprintf(w, "%T\n", d) // Output: int64
 
 	/*
 	 * An arithmetic operation between an int8 and an int16
@@ -46,33 +47,38 @@ func getSignedIntegers() {
 	var f int16 = 16_000
 	var g int16 = int16(e)
 
-	fmt.Printf("%d\n", e) // Output: 120
-	fmt.Printf("%d\n", f) // Output: 16000
-	fmt.Printf("%d\n", g) // Output: 120
+	fmt.Fprintf(w, "%d\n", e) // Output: 120
+	fmt.Fprintf(w, "%d\n", f) // Output: 16000
+	fmt.Fprintf(w, "%d\n", g) // Output: 120
 
-	// You can use %v to print the default format.
-	fmt.Printf("f + g = %v\n", (f + g))  // Output: f + g = 16120
-	fmt.Printf("f - g = %v\n", (f - g))  // Output: f - g = 15880
-	fmt.Printf("f * g = %v\n", (f * g))  // Output: f * g = 19456
-	fmt.Printf("f / g = %v\n", (f / g))  // Output: f / g = 133
-	fmt.Printf("f %% g = %v\n", (f % g)) // Output: f % g = 40
+	/*
+	 * You can use %v to print the default format.
+	 *
+	 * f * g should be 1920000, but that does not fit in int16 (-32768 to 32767).
+	 * Go silently keeps the lowest 16 bits, so the result wraps around to 19456.
+	 * The data_types/overflow lesson explains why. */
+	fmt.Fprintf(w, "f + g = %v\n", (f + g))  // Output: f + g = 16120
+	fmt.Fprintf(w, "f - g = %v\n", (f - g))  // Output: f - g = 15880
+	fmt.Fprintf(w, "f * g = %v\n", (f * g))  // Output: f * g = 19456
+	fmt.Fprintf(w, "f / g = %v\n", (f / g))  // Output: f / g = 133
+	fmt.Fprintf(w, "f %% g = %v\n", (f % g)) // Output: f % g = 40
 
 	// If you do not specify a type, the type will be int.
 	h, i := 450_000, 750_000
 
-	fmt.Printf("Size of h: %v\n", unsafe.Sizeof(h)) // Output: Size of h: 8
-	fmt.Printf("Size of i: %v\n", unsafe.Sizeof(i)) // Output: Size of i: 8
+	fmt.Fprintf(w, "Size of h: %v\n", unsafe.Sizeof(h)) // Output: Size of h: 8
+	fmt.Fprintf(w, "Size of i: %v\n", unsafe.Sizeof(i)) // Output: Size of i: 8
 
-	fmt.Printf("%d\n", h) // Output: 450000
-	fmt.Printf("%T\n", h) // Output: int
-	fmt.Printf("%d\n", i) // Output: 750000
-	fmt.Printf("%T\n", i) // Output: int
+	fmt.Fprintf(w, "%d\n", h) // Output: 450000
+	fmt.Fprintf(w, "%T\n", h) // Output: int
+	fmt.Fprintf(w, "%d\n", i) // Output: 750000
+	fmt.Fprintf(w, "%T\n", i) // Output: int
 
-	fmt.Printf("h + i = %v\n", (h + i))  // Output: h + i = 1200000
-	fmt.Printf("h - i = %v\n", (h - i))  // Output: h - i = -300000
-	fmt.Printf("h * i = %v\n", (h * i))  // Output: h * i = 337500000000
-	fmt.Printf("h / i = %v\n", (h / i))  // Output: h / i = 0
-	fmt.Printf("h %% i = %v\n", (h % i)) // Output: h % i = 450000
+	fmt.Fprintf(w, "h + i = %v\n", (h + i))  // Output: h + i = 1200000
+	fmt.Fprintf(w, "h - i = %v\n", (h - i))  // Output: h - i = -300000
+	fmt.Fprintf(w, "h * i = %v\n", (h * i))  // Output: h * i = 337500000000
+	fmt.Fprintf(w, "h / i = %v\n", (h / i))  // Output: h / i = 0
+	fmt.Fprintf(w, "h %% i = %v\n", (h % i)) // Output: h % i = 450000
 
 	/*
 	 * When you declare an int64 variable in a Go program compiled for a 32-bit system,
@@ -108,11 +114,11 @@ func getSignedIntegers() {
 	 * the need for 64-bit arithmetic to be emulated in software. */
 	var j int32 = 7_000_000
 	var k, l int64 = 12_000_000, 17_000_000
-	fmt.Printf("%d\n", j) // Output: 7000000
-	fmt.Printf("%d\n", k) // Output: 12000000
-	fmt.Printf("%d\n", l) // Output: 17000000
+	fmt.Fprintf(w, "%d\n", j) // Output: 7000000
+	fmt.Fprintf(w, "%d\n", k) // Output: 12000000
+	fmt.Fprintf(w, "%d\n", l) // Output: 17000000
 
-	fmt.Printf("Size of j: %v\n", unsafe.Sizeof(j)) // Output: Size of j: 4
-	fmt.Printf("Size of k: %v\n", unsafe.Sizeof(k)) // Output: Size of k: 8
-	fmt.Printf("Size of l: %v\n", unsafe.Sizeof(l)) // Output: Size of l: 8
+	fmt.Fprintf(w, "Size of j: %v\n", unsafe.Sizeof(j)) // Output: Size of j: 4
+	fmt.Fprintf(w, "Size of k: %v\n", unsafe.Sizeof(k)) // Output: Size of k: 8
+	fmt.Fprintf(w, "Size of l: %v\n", unsafe.Sizeof(l)) // Output: Size of l: 8
 }
@@ -2,10 +2,11 @@ package data_types
 
 import (
 	"fmt"
+	"io"
 	"strings"
 )
 
-func getStrings() {
+func getStrings(w io.Writer) {
 	/*
 	 * First thing to note is that a UTF-8 encoded string
 	 * can be made of any character in Unicode.
@@ -23,39 +24,40 @@ func getStrings() {
 	 * A string is UTF-8 encoded and immutable (you cannot modify a string directly).
 	 *  */
 	alphabets := "a b c d A B C D"
-	fmt.Printf("%s\n", alphabets)
+	fmt.Fprintf(w, "%s\n", alphabets) // Output: a b c d A B C D
 
 	/*
 	 * This string value "Fajar" is [70 97 106 97 114] (bytes UTF-8).
 	 * Here below, Go automatically knows it's string. */
 	var name = "Fajar"
-	fmt.Printf("%s\n", name) // Output: Fajar
+	fmt.Fprintf(w, "%s\n", name) // Output: Fajar
 
 	// String can be empty, but they are not nil.
 	var txt1 string
-	fmt.Printf("%s (%d)\n", txt1, len(txt1)) // Output:
+	fmt.Fprintf(w, "%s (%d)\n", txt1, len(txt1)) // Output:  (0)
 
 	// Don't assume len() = character count; Wrong for UTF-8.
 	var txt2 string = "Hello World"
-	fmt.Printf("%s (%d)\n", txt2, len(txt2)) // Output:
+	fmt.Fprintf(w, "%s (%d)\n", txt2, len(txt2)) // Output: Hello World (11)
 
 	txt3 := "Have a nice day!"
-	fmt.Printf("%s (%d)\n", txt3, len(txt3)) // Output:
+	fmt.Fprintf(w, "%s (%d)\n", txt3, len(txt3)) // Output: Have a nice day! (16)
 
 	// You can combine strings with + operator.
 	message1, message2 := "Oops!", "Something went wrong"
-	fmt.Printf("%s\n", (message1 + " " + message2)) // Output: Oops! Something went wrong
+	fmt.Fprintf(w, "%s\n", (message1 + " " + message2)) // Output: Oops! Something went wrong
 
 	/*
 	 * Go has 2 types of string literals:
 	 * 1. Double quotes ""
-	 * 2. Backticks (raw string) ``
-	 *
+	 * 2. Backticks (raw string) `` */
+	stc := "Lorem ipsum\ndolor sit amet"
+
+	/*
 	 * Output:
 	 * Lorem ipsum
 	 * dolor sit amet */
-	stc := "Lorem ipsum\ndolor sit amet"
-	fmt.Printf("%s\n", stc)
+	fmt.Fprintf(w, "%s\n", stc)
 
 	/*
 	 * Raw string preserves format and ignores escape characters.
@@ -67,12 +69,16 @@ func getStrings() {
 	 * 2. JSON
 	 * 3. SQL
 	 *
-	 * Output:
-	 * Lorem ipsum
-	 *     dolor sit amet */
+	 * The tab in front of "dolor" is part of the raw string,
+	 * because the second line is indented like the rest of the code. */
 	stc = `Lorem ipsum
 	dolor sit amet`
-	fmt.Printf("%s\n", stc)
+
+	/*
+	 * Output:
+	 * Lorem ipsum
+	 * 	dolor sit amet */
+	fmt.Fprintf(w, "%s\n", stc)
 
 	/*
 	 * A string is immutable, modify string directly causes error.
@@ -83,17 +89,17 @@ func getStrings() {
 	bytes := []byte(city)
 	bytes[0] = 'J'
 	city = string(bytes)
-	fmt.Printf("%s\n", city) // Output: Jakarta
+	fmt.Fprintf(w, "%s\n", city) // Output: Jakarta
 
 	/*
 	 * You can find string length using len() function.
 	 * Be careful, this counts bytes not characters, because of UTF-8. */
-	fmt.Printf("%d\n", len(city)) // Output: 7
-	fmt.Printf("%d\n", len("é"))  // Output: 2
+	fmt.Fprintf(w, "%d\n", len(city)) // Output: 7
+	fmt.Fprintf(w, "%d\n", len("é"))  // Output: 2
 
 	// Accessing character using variable[index], because it's byte.
-	fmt.Printf("%d\n", city[0]) // Output: 74
-	fmt.Printf("%c\n", city[0]) // Output: J
+	fmt.Fprintf(w, "%d\n", city[0]) // Output: 74
+	fmt.Fprintf(w, "%c\n", city[0]) // Output: J
 
 	/*
 	 * Output:
@@ -105,59 +111,59 @@ func getStrings() {
 	 * 5 t
 	 * 6 a */
 	for i, c := range city {
-		fmt.Println(i, string(c))
+		fmt.Fprintln(w, i, string(c))
 	}
 
 	s1 := "Japan"
 	s2 := "Japan"
-	fmt.Printf("%t\n", (s1 == s2)) // Output: true
+	fmt.Fprintf(w, "%t\n", (s1 == s2)) // Output: true
 
 	city = "Tokyo"
 	// String can use rune slice (important for Unicode).
 	runes := []rune(city)
-	fmt.Printf("%v\n", runes)    // Output: [84 111 107 121 111]
-	fmt.Printf("%c\n", runes)    // Output:
-	fmt.Printf("%v\n", runes[0]) // Output: 84
-	fmt.Printf("%c\n", runes[0]) // Output: T
+	fmt.Fprintf(w, "%v\n", runes)    // Output: [84 111 107 121 111]
+	fmt.Fprintf(w, "%c\n", runes)    // Output: [T o k y o]
+	fmt.Fprintf(w, "%v\n", runes[0]) // Output: 84
+	fmt.Fprintf(w, "%c\n", runes[0]) // Output: T
 }
 
-func manipulateStrings() {
+func manipulateStrings(w io.Writer) {
 	// Don't use + repeatedly for many concatenations, use Builder; Better performance.
 	var builder strings.Builder
 	builder.WriteString("One Two One Two")
 	builder.WriteString(" ")
 	builder.WriteString("Three Two One Three")
 	txt := builder.String()
-	fmt.Printf("%s\n", txt)
+	fmt.Fprintf(w, "%s\n", txt) // Output: One Two One Two Three Two One Three
 
 	containsSubstr := strings.Contains(txt, "One")
-	fmt.Printf("%t\n", containsSubstr)
+	fmt.Fprintf(w, "%t\n", containsSubstr) // Output: true
 
 	toUpper := strings.ToUpper(txt)
-	fmt.Printf("%s\n", toUpper)
+	fmt.Fprintf(w, "%s\n", toUpper) // Output: ONE TWO ONE TWO THREE TWO ONE THREE
 
 	toLower := strings.ToLower(txt)
-	fmt.Printf("%s\n", toLower)
+	fmt.Fprintf(w, "%s\n", toLower) // Output: one two one two three two one three
 
 	replace := strings.Replace(txt, "One", "1", 2)
-	fmt.Printf("%s\n", replace)
+	fmt.Fprintf(w, "%s\n", replace) // Output: 1 Two 1 Two Three Two One Three
 
 	replaceAll := strings.ReplaceAll(txt, "One", "1")
-	fmt.Printf("%s\n", replaceAll)
+	fmt.Fprintf(w, "%s\n", replaceAll) // Output: 1 Two 1 Two Three Two 1 Three
 
 	split := strings.Split(txt, " ")
-	fmt.Printf("%v\n", split)
-	fmt.Printf("%v\n", split[0])
+	fmt.Fprintf(w, "%v\n", split)    // Output: [One Two One Two Three Two One Three]
+	fmt.Fprintf(w, "%v\n", split[0]) // Output: One
 
 	join := strings.Join(split, "-")
-	fmt.Printf("%v\n", join)
+	fmt.Fprintf(w, "%v\n", join) // Output: One-Two-One-Two-Three-Two-One-Three
 
 	trimSpace := strings.TrimSpace("   Hello World              ")
-	fmt.Printf("%s\n", trimSpace)
+	fmt.Fprintf(w, "%s\n", trimSpace) // Output: Hello World
 
 	hasPrefix := strings.HasPrefix(txt, "On")
-	fmt.Printf("%t\n", hasPrefix)
+	fmt.Fprintf(w, "%t\n", hasPrefix) // Output: true
 
 	hasSuffix := strings.HasSuffix(txt, "ree")
-	fmt.Printf("%t\n", hasSuffix)
+	fmt.Fprintf(w, "%t\n", hasSuffix) // Output: true
 }
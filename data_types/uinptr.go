@@ -3,9 +3,11 @@ package data_types
 import (
 	"fmt"
 	"unsafe"
+
+	"github.com/fajarstrtn/golang-tutorial/lesson"
 )
 
-func getUintptr() {
+func getUintptr(env *lesson.Env) {
 	/*
 	 * uintptr is an unsigned integer that large enough to store pointer,
 	 * where its size depends of cpu architecture (4 bytes for 32-bit system,
@@ -16,26 +18,23 @@ func getUintptr() {
 	 * which is not allowed on standard Go pointers or unsafe.Pointer.
 	 *
 	 * uintptr treats a memory address as a raw number.
-	 * This allows arithmetic operations (adding or subtracting offsets)
-	 * that are necessary for detailed memory control.
-	 *
-	 * uintptr is an integer type capable of storing the bit pattern of any pointer.
-	 * It is used in unsafe operations, converting unsafe.Pointer to perform arithmetic,
-	 * and back to unsafe.Pointer.
-	 *
-	 * Variables of uintptr type can store and print memory address values
-	 * but doesn't reference objects.
-	 * uintptr aims to store memory address as number, not pointer, only number.
+	 * Pointers ensure type safety and keep the memory they point to alive,
+	 * while a uintptr is only a number: it does not keep anything alive,
+	 * and the garbage collector does not update it when it moves a variable.
 	 *
-	 * Pointers ensure type safety and prevent garbage collection,
-	 * while uintptr allowing low-level memory manipulation
-	 * and are not type-safe.
-	 *
-	 * Use uintptr in advanced, performance-critical, or unsafe operations. */
+	 * So a uintptr can be printed or compared, but converting it back to a pointer
+	 * is only valid in a few patterns (shown in the memory layout lesson, data_types/memory).
+	 * For example, adding 8 to the address of an int and converting it back to unsafe.Pointer
+	 * points past the end of the int, at memory that belongs to nothing (or to something else). */
 	n := 100
-	ptr := unsafe.Pointer(uintptr(unsafe.Pointer(&n)) + 8)
-	addr := uintptr(ptr)
-	fmt.Printf("%v\n", n)    // Output: 100
-	fmt.Printf("%v\n", ptr)  // Output: 0xc00000a0c8
-	fmt.Printf("%v\n", addr) // Output: 824633761992
+	addr := uintptr(unsafe.Pointer(&n))
+
+	/*
+	 * The address changes on every run, so it goes through env.Addr,
+	 * which replaces it with a stable placeholder in deterministic mode.
+	 * %#x prints the address in hex, the same way %v prints an unsafe.Pointer. */
+	fmt.Fprintf(env.Out, "%v\n", n)                   // Output: 100
+	fmt.Fprintf(env.Out, "%#x\n", env.Addr(addr))     // Output: 0xc00000a0c8
+	fmt.Fprintf(env.Out, "%v\n", env.Addr(addr))      // Output: 824633761992
+	fmt.Fprintf(env.Out, "%v\n", unsafe.Sizeof(addr)) // Output: 8
 }
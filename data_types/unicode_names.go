@@ -0,0 +1,126 @@
+package data_types
+
+import (
+	_ "embed"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// Pinned to the Unicode version of the unicode package in the Go version of go.mod (Go 1.25), see gen_unicode_names.go.
+//go:generate go run gen_unicode_names.go -version 15.0.0
+
+//go:embed unicode_names.txt
+var unicodeNamesData string
+
+var (
+	unicodeNamesOnce sync.Once
+	unicodeNames     map[rune]string
+)
+
+// Names of the control characters a learner may meet (UnicodeData.txt only calls them <control>).
+var CONTROL_NAMES = map[rune]string{
+	0x00: "NULL",
+	0x07: "BELL",
+	0x08: "BACKSPACE",
+	0x09: "CHARACTER TABULATION",
+	0x0A: "LINE FEED (LF)",
+	0x0B: "LINE TABULATION",
+	0x0C: "FORM FEED (FF)",
+	0x0D: "CARRIAGE RETURN (CR)",
+	0x1B: "ESCAPE",
+	0x7F: "DELETE",
+	0x85: "NEXT LINE (NEL)",
+}
+
+// Jamo short names, used to build the names of Hangul syllables.
+var (
+	hangulLeads  = strings.Split("G GG N D DD R M B BB S SS  J JJ C K T P H", " ")
+	hangulVowels = strings.Split("A AE YA YAE EO E YEO YE O WA WAE OE YO U WEO WE WI YU EU YI I", " ")
+	hangulTails  = strings.Split(" G GG GS N NJ NH D L LG LM LB LS LT LP LH M B BS S SS NG J C K T P H", " ")
+)
+
+/*
+ * RuneName returns the Unicode name of a code point (e.g., LATIN SMALL LETTER E WITH ACUTE).
+ *
+ * The names come from:
+ * 1. unicode_names.txt for the common blocks (Latin, Greek, Cyrillic, punctuation, symbols, kana, emoji, ...)
+ * 2. The rules of the Unicode standard for Hangul syllables and CJK ideographs
+ * 3. CONTROL_NAMES for the control characters
+ *
+ * Other code points get a description made of their category and script instead, between < and >. */
+func RuneName(r rune) string {
+	unicodeNamesOnce.Do(loadUnicodeNames)
+
+	if name, ok := unicodeNames[r]; ok {
+		return name
+	}
+	if name, ok := CONTROL_NAMES[r]; ok {
+		return name
+	}
+
+	switch {
+	case 0xAC00 <= r && r <= 0xD7A3:
+		s := int(r - 0xAC00)
+		return "HANGUL SYLLABLE " + hangulLeads[s/588] + hangulVowels[s%588/28] + hangulTails[s%28]
+	case unicode.Is(unicode.Unified_Ideograph, r):
+		return fmt.Sprintf("CJK UNIFIED IDEOGRAPH-%04X", r)
+	}
+
+	return "<" + strings.Join(runeDescription(r), ", ") + ">"
+}
+
+func loadUnicodeNames() {
+	unicodeNames = make(map[rune]string)
+
+	for _, line := range strings.Split(unicodeNamesData, "\n") {
+		code, name, ok := strings.Cut(line, " ")
+		if !ok || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		r, err := strconv.ParseUint(code, 16, 32)
+		if err != nil {
+			continue
+		}
+		unicodeNames[rune(r)] = name
+	}
+}
+
+// runeDescription lists the general category and the script of a code point.
+func runeDescription(r rune) []string {
+	description := []string{"unnamed"}
+
+	if category := tableName(unicode.Categories, r, 2); category != "" {
+		description = append(description, category)
+	} else {
+		description = append(description, "unassigned")
+	}
+	if script := tableName(unicode.Scripts, r, 0); script != "" {
+		description = append(description, script)
+	}
+
+	return description
+}
+
+// tableName finds the table containing r. length keeps only names of that length (0 for any).
+func tableName(tables map[string]*unicode.RangeTable, r rune, length int) string {
+	names := make([]string, 0, len(tables))
+	for name := range tables {
+		if length == 0 || len(name) == length {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if unicode.Is(tables[name], r) {
+			return name
+		}
+	}
+
+	return ""
+}
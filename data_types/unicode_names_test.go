@@ -0,0 +1,28 @@
+package data_types
+
+import (
+	"os"
+	"regexp"
+	"testing"
+)
+
+// The embedded table must be the one go generate builds, for the version pinned in the go:generate directive.
+func TestUnicodeNamesVersion(t *testing.T) {
+	src, err := os.ReadFile("unicode_names.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	directive := regexp.MustCompile(`(?m)^//go:generate go run gen_unicode_names.go -version (\S+)$`).FindSubmatch(src)
+	if directive == nil {
+		t.Fatal("no go:generate directive with a -version in unicode_names.go")
+	}
+	header := regexp.MustCompile(`(?m)^# Unicode (\S+),`).FindStringSubmatch(unicodeNamesData)
+	if header == nil {
+		t.Fatal("no Unicode version in the header of unicode_names.txt")
+	}
+
+	if want := string(directive[1]); header[1] != want {
+		t.Errorf("unicode_names.txt is built from Unicode %s, but go generate builds it from Unicode %s", header[1], want)
+	}
+}
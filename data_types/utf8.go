@@ -0,0 +1,113 @@
+package data_types
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+func getUTF8Bytes(w io.Writer) {
+	/*
+	 * UTF-8 stores a code point in 1 to 4 bytes. The first bits of the first byte tell the length:
+	 * 1. 0xxxxxxx: 1 byte (ASCII, U+0000 to U+007F)
+	 * 2. 110xxxxx: 2 bytes (e.g., Latin accents, Greek, Cyrillic)
+	 * 3. 1110xxxx: 3 bytes (e.g., the rest of the BMP: kana, CJK, most symbols)
+	 * 4. 11110xxx: 4 bytes (e.g., emoji)
+	 * The other bytes of a sequence always start with 10 (continuation bytes). */
+	txt := "Aé世😀"
+	fmt.Fprintln(w, len(txt), utf8.RuneCountInString(txt)) // Output: 10 4
+	fmt.Fprintf(w, "% X\n", txt)                           // Output: 41 C3 A9 E4 B8 96 F0 9F 98 80
+	fmt.Fprintf(w, "%08b %08b\n", txt[1], txt[2])          // Output: 11000011 10101001
+
+	/*
+	 * for range decodes one rune at a time, and the index is the byte offset of the rune,
+	 * so it jumps over the continuation bytes.
+	 *
+	 * Output:
+	 * 0 U+0041 1 byte(s) LATIN CAPITAL LETTER A
+	 * 1 U+00E9 2 byte(s) LATIN SMALL LETTER E WITH ACUTE
+	 * 3 U+4E16 3 byte(s) CJK UNIFIED IDEOGRAPH-4E16
+	 * 6 U+1F600 4 byte(s) GRINNING FACE */
+	for i, r := range txt {
+		fmt.Fprintf(w, "%d %U %d byte(s) %s\n", i, r, utf8.RuneLen(r), RuneName(r))
+	}
+
+	/*
+	 * A rune is not always one column on the screen: combining marks take none,
+	 * and East Asian characters and emoji take two. */
+	fmt.Fprintln(w, RuneWidth('A'), RuneWidth('世'), RuneWidth('😀'), RuneWidth('\u0301')) // Output: 1 2 2 0
+}
+
+func getGraphemeClusters(w io.Writer) {
+	/*
+	 * What a reader sees as one character (a grapheme cluster) can be several runes:
+	 * 1. Combining marks  : é can be one rune (U+00E9) or e followed by U+0301 COMBINING ACUTE ACCENT
+	 * 2. Emoji ZWJ        : 👩‍💻 is 👩, ZERO WIDTH JOINER and 💻, drawn as one picture
+	 * 3. Skin tones       : 👍🏽 is 👍 followed by a modifier
+	 * 4. Flags            : 🇮🇩 is two regional indicators, the letters I and D
+	 *
+	 * len counts bytes and utf8.RuneCountInString counts runes; neither counts what a reader sees.
+	 * The rules to split text into grapheme clusters are in Unicode Standard Annex #29. */
+	composed := "é"
+	decomposed := "e\u0301"
+	fmt.Fprintf(w, "%s %s %+q %+q %t\n", composed, decomposed, composed, decomposed, composed == decomposed) // Output: é é "\u00e9" "e\u0301" false
+	fmt.Fprintln(w, len(composed), len(decomposed))                                                          // Output: 2 3
+	fmt.Fprintln(w, utf8.RuneCountInString(composed), utf8.RuneCountInString(decomposed))                    // Output: 1 2
+	fmt.Fprintln(w, len(InspectUTF8(composed).Graphemes), len(InspectUTF8(decomposed).Graphemes))            // Output: 1 1
+
+	/*
+	 * Output:
+	 * é 2 rune(s) base character + combining mark(s)
+	 * 👩‍💻 3 rune(s) emoji ZWJ sequence
+	 * 👍🏽 2 rune(s) emoji with skin tone modifier
+	 * 🇮🇩 2 rune(s) flag (two regional indicators)
+	 * 🇯🇵 2 rune(s) flag (two regional indicators) */
+	for _, g := range InspectUTF8("e\u0301👩\u200D💻👍🏽🇮🇩🇯🇵").Graphemes {
+		fmt.Fprintf(w, "%s %d rune(s) %s\n", g.Text, g.Runes, g.Kind)
+	}
+
+	/*
+	 * Cutting a string in the middle of a cluster changes what it shows,
+	 * even when every rune stays whole (and the string stays valid UTF-8). */
+	coder := "👩\u200D💻"
+	fmt.Fprintln(w, len(coder), utf8.RuneCountInString(coder)) // Output: 11 3
+	fmt.Fprintln(w, string([]rune(coder)[:1]))                 // Output: 👩
+}
+
+func getInvalidUTF8(w io.Writer) {
+	/*
+	 * A string can hold any bytes, not only valid UTF-8 (e.g., a file read with the wrong encoding).
+	 * Decoding a byte that does not start a valid sequence gives utf8.RuneError (U+FFFD, �)
+	 * and moves on by one byte. utf8.ValidString checks a whole string. */
+	bad := "a\xffb\xe4\xb8"
+	fmt.Fprintln(w, utf8.ValidString(bad), utf8.ValidString("héllo")) // Output: false true
+
+	/*
+	 * Output:
+	 * 0 U+0061 a
+	 * 1 U+FFFD �
+	 * 2 U+0062 b
+	 * 3 U+FFFD �
+	 * 4 U+FFFD � */
+	for i, r := range bad {
+		fmt.Fprintf(w, "%d %U %c\n", i, r, r)
+	}
+
+	/*
+	 * Output:
+	 * 0xFF never appears in UTF-8
+	 * 0xE4 starts a 3-byte sequence, but the string ends after 2 byte(s)
+	 * 0xB8 is a continuation byte (10xxxxxx) without a leading byte before it */
+	for _, info := range InspectUTF8(bad).Runes {
+		if info.Invalid {
+			fmt.Fprintln(w, info.Problem)
+		}
+	}
+
+	/*
+	 * strings.ToValidUTF8 replaces each run of invalid bytes.
+	 *
+	 * Try your own strings with go run . utf8 (e.g., go run . utf8 'é' '👩‍💻' '"\xff"'). */
+	fmt.Fprintln(w, strings.ToValidUTF8(bad, "?")) // Output: a?b?
+}
@@ -0,0 +1,315 @@
+package data_types
+
+import (
+	"fmt"
+	"unicode"
+	"unicode/utf8"
+)
+
+/*
+ * A RuneInfo is one decoded rune of a string, or one byte that is not valid UTF-8:
+ * 1. Offset : Index of its first byte in the string
+ * 2. Bytes  : Its UTF-8 encoding (1 to 4 bytes)
+ * 3. Rune   : The code point (utf8.RuneError when Invalid)
+ * 4. Invalid: The byte is not valid UTF-8; Problem tells why
+ * 5. Name   : The Unicode name (see RuneName)
+ * 6. Width  : Columns it takes in a terminal: 0 (combining, invisible), 1 or 2 (wide East Asian and emoji)
+ * 7. Cluster: Index of the grapheme cluster it belongs to */
+type RuneInfo struct {
+	Offset  int
+	Bytes   []byte
+	Rune    rune
+	Invalid bool
+	Problem string
+	Name    string
+	Width   int
+	Cluster int
+}
+
+/*
+ * A Grapheme is a grapheme cluster: what a reader sees as one character,
+ * even when it is made of several runes (e.g., e + ◌́, a flag, a family emoji):
+ * 1. Offset: Index of its first byte in the string
+ * 2. Text  : Its bytes
+ * 3. Runes : Number of runes (and invalid bytes) in it
+ * 4. Width : Columns it takes in a terminal
+ * 5. Kind  : Why the runes belong together, empty for a single rune */
+type Grapheme struct {
+	Offset int
+	Text   string
+	Runes  int
+	Width  int
+	Kind   string
+}
+
+/*
+ * A UTF8Report is the result of InspectUTF8.
+ * Valid is false when at least one byte is not valid UTF-8 (see RuneInfo.Problem). */
+type UTF8Report struct {
+	Runes     []RuneInfo
+	Graphemes []Grapheme
+	Valid     bool
+}
+
+/*
+ * InspectUTF8 decodes a string rune by rune, the way for range does,
+ * and groups the runes into grapheme clusters.
+ *
+ * Like for range, an invalid byte decodes to utf8.RuneError (U+FFFD) and only consumes that one byte.
+ * InspectUTF8 keeps those bytes apart (Invalid is true) and explains what is wrong with them. */
+func InspectUTF8(s string) UTF8Report {
+	report := UTF8Report{Valid: true}
+
+	var runes []rune // What the segmentation sees; invalid bytes are utf8RuneErrorMarker.
+	for offset := 0; offset < len(s); {
+		r, size := utf8.DecodeRuneInString(s[offset:])
+		info := RuneInfo{Offset: offset, Bytes: []byte(s[offset : offset+size]), Rune: r}
+
+		if r == utf8.RuneError && size == 1 {
+			info.Invalid = true
+			info.Problem = invalidUTF8(s[offset:])
+			info.Name = "<invalid UTF-8>"
+			report.Valid = false
+			runes = append(runes, utf8RuneErrorMarker)
+		} else {
+			info.Name = RuneName(r)
+			info.Width = RuneWidth(r)
+			runes = append(runes, r)
+		}
+
+		report.Runes = append(report.Runes, info)
+		offset += size
+	}
+
+	breaks := graphemeBreaks(runes)
+	for i, info := range report.Runes {
+		if breaks[i] {
+			report.Graphemes = append(report.Graphemes, Grapheme{Offset: info.Offset})
+		}
+
+		g := &report.Graphemes[len(report.Graphemes)-1]
+		g.Text += string(info.Bytes)
+		g.Runes++
+		report.Runes[i].Cluster = len(report.Graphemes) - 1
+	}
+
+	for i := range report.Graphemes {
+		g := &report.Graphemes[i]
+		g.Kind, g.Width = graphemeKind(g.Text)
+	}
+
+	return report
+}
+
+/*
+ * invalidUTF8 explains why the first byte of s does not start a valid UTF-8 sequence.
+ * A valid sequence is one of:
+ * 1. 0xxxxxxx                            : 1 byte, U+0000 to U+007F
+ * 2. 110xxxxx 10xxxxxx                   : 2 bytes, U+0080 to U+07FF
+ * 3. 1110xxxx 10xxxxxx 10xxxxxx          : 3 bytes, U+0800 to U+FFFF except the surrogates U+D800 to U+DFFF
+ * 4. 11110xxx 10xxxxxx 10xxxxxx 10xxxxxx : 4 bytes, U+10000 to U+10FFFF */
+func invalidUTF8(s string) string {
+	b := s[0]
+
+	var size int
+	switch {
+	case b&0xC0 == 0x80:
+		return fmt.Sprintf("0x%02X is a continuation byte (10xxxxxx) without a leading byte before it", b)
+	case b == 0xC0 || b == 0xC1:
+		return fmt.Sprintf("0x%02X can only start an overlong encoding (a character that fits in 1 byte)", b)
+	case b&0xE0 == 0xC0:
+		size = 2
+	case b&0xF0 == 0xE0:
+		size = 3
+	case b&0xF8 == 0xF0 && b <= 0xF4:
+		size = 4
+	default:
+		return fmt.Sprintf("0x%02X never appears in UTF-8", b)
+	}
+
+	for i := 1; i < size; i++ {
+		if i >= len(s) {
+			return fmt.Sprintf("0x%02X starts a %d-byte sequence, but the string ends after %d byte(s)", b, size, i)
+		}
+		if s[i]&0xC0 != 0x80 {
+			return fmt.Sprintf("0x%02X starts a %d-byte sequence, but byte %d (0x%02X) is not a continuation byte", b, size, i+1, s[i])
+		}
+	}
+
+	// Every byte has the right shape, so the decoded value is out of range.
+	switch {
+	case b == 0xE0 || b == 0xF0:
+		return fmt.Sprintf("% X is an overlong encoding (the character fits in fewer bytes)", []byte(s[:size]))
+	case b == 0xED:
+		return fmt.Sprintf("% X encodes a UTF-16 surrogate (U+D800 to U+DFFF), which is not a character", []byte(s[:size]))
+	}
+	return fmt.Sprintf("% X is above U+10FFFF, the last code point", []byte(s[:size]))
+}
+
+/*
+ * RuneWidth returns the number of columns a rune takes in a terminal (like wcwidth in C):
+ * 1. 0 for combining marks, joiners, variation selectors and other invisible format characters
+ * 2. 2 for wide East Asian characters (CJK, kana, Hangul, fullwidth forms) and emoji
+ * 3. 1 for everything else
+ * Control characters have no width; they return -1. */
+func RuneWidth(r rune) int {
+	switch {
+	case r == 0:
+		return 0
+	case unicode.Is(unicode.Cc, r):
+		return -1
+	case unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf), 0x1160 <= r && r <= 0x11FF:
+		return 0
+	case unicode.Is(wide, r):
+		return 2
+	}
+
+	return 1
+}
+
+// The ranges that terminals draw two columns wide (East Asian Wide and Fullwidth, and emoji).
+var wide = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x1100, Hi: 0x115F, Stride: 1},
+		{Lo: 0x231A, Hi: 0x231B, Stride: 1},
+		{Lo: 0x23E9, Hi: 0x23EC, Stride: 1},
+		{Lo: 0x23F0, Hi: 0x23F0, Stride: 1},
+		{Lo: 0x23F3, Hi: 0x23F3, Stride: 1},
+		{Lo: 0x25FD, Hi: 0x25FE, Stride: 1},
+		{Lo: 0x2614, Hi: 0x2615, Stride: 1},
+		{Lo: 0x2648, Hi: 0x2653, Stride: 1},
+		{Lo: 0x267F, Hi: 0x267F, Stride: 1},
+		{Lo: 0x2693, Hi: 0x2693, Stride: 1},
+		{Lo: 0x26A1, Hi: 0x26A1, Stride: 1},
+		{Lo: 0x26AA, Hi: 0x26AB, Stride: 1},
+		{Lo: 0x26BD, Hi: 0x26BE, Stride: 1},
+		{Lo: 0x26C4, Hi: 0x26C5, Stride: 1},
+		{Lo: 0x26CE, Hi: 0x26CE, Stride: 1},
+		{Lo: 0x26D4, Hi: 0x26D4, Stride: 1},
+		{Lo: 0x26EA, Hi: 0x26EA, Stride: 1},
+		{Lo: 0x26F2, Hi: 0x26F3, Stride: 1},
+		{Lo: 0x26F5, Hi: 0x26F5, Stride: 1},
+		{Lo: 0x26FA, Hi: 0x26FA, Stride: 1},
+		{Lo: 0x26FD, Hi: 0x26FD, Stride: 1},
+		{Lo: 0x2705, Hi: 0x2705, Stride: 1},
+		{Lo: 0x270A, Hi: 0x270B, Stride: 1},
+		{Lo: 0x2728, Hi: 0x2728, Stride: 1},
+		{Lo: 0x274C, Hi: 0x274C, Stride: 1},
+		{Lo: 0x274E, Hi: 0x274E, Stride: 1},
+		{Lo: 0x2753, Hi: 0x2755, Stride: 1},
+		{Lo: 0x2757, Hi: 0x2757, Stride: 1},
+		{Lo: 0x2795, Hi: 0x2797, Stride: 1},
+		{Lo: 0x27B0, Hi: 0x27B0, Stride: 1},
+		{Lo: 0x27BF, Hi: 0x27BF, Stride: 1},
+		{Lo: 0x2B1B, Hi: 0x2B1C, Stride: 1},
+		{Lo: 0x2B50, Hi: 0x2B50, Stride: 1},
+		{Lo: 0x2B55, Hi: 0x2B55, Stride: 1},
+		{Lo: 0x2E80, Hi: 0x303E, Stride: 1},
+		{Lo: 0x3041, Hi: 0x33FF, Stride: 1},
+		{Lo: 0x3400, Hi: 0x4DBF, Stride: 1},
+		{Lo: 0x4E00, Hi: 0x9FFF, Stride: 1},
+		{Lo: 0xA000, Hi: 0xA4CF, Stride: 1},
+		{Lo: 0xA960, Hi: 0xA97F, Stride: 1},
+		{Lo: 0xAC00, Hi: 0xD7A3, Stride: 1},
+		{Lo: 0xF900, Hi: 0xFAFF, Stride: 1},
+		{Lo: 0xFE10, Hi: 0xFE19, Stride: 1},
+		{Lo: 0xFE30, Hi: 0xFE6F, Stride: 1},
+		{Lo: 0xFF00, Hi: 0xFF60, Stride: 1},
+		{Lo: 0xFFE0, Hi: 0xFFE6, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x1F004, Hi: 0x1F004, Stride: 1},
+		{Lo: 0x1F0CF, Hi: 0x1F0CF, Stride: 1},
+		{Lo: 0x1F18E, Hi: 0x1F18E, Stride: 1},
+		{Lo: 0x1F191, Hi: 0x1F19A, Stride: 1},
+		{Lo: 0x1F1E6, Hi: 0x1F1FF, Stride: 1},
+		{Lo: 0x1F200, Hi: 0x1F320, Stride: 1},
+		{Lo: 0x1F32D, Hi: 0x1F335, Stride: 1},
+		{Lo: 0x1F337, Hi: 0x1F37C, Stride: 1},
+		{Lo: 0x1F37E, Hi: 0x1F393, Stride: 1},
+		{Lo: 0x1F3A0, Hi: 0x1F3CA, Stride: 1},
+		{Lo: 0x1F3CF, Hi: 0x1F3D3, Stride: 1},
+		{Lo: 0x1F3E0, Hi: 0x1F3F0, Stride: 1},
+		{Lo: 0x1F3F4, Hi: 0x1F3F4, Stride: 1},
+		{Lo: 0x1F3F8, Hi: 0x1F43E, Stride: 1},
+		{Lo: 0x1F440, Hi: 0x1F440, Stride: 1},
+		{Lo: 0x1F442, Hi: 0x1F4FC, Stride: 1},
+		{Lo: 0x1F4FF, Hi: 0x1F53D, Stride: 1},
+		{Lo: 0x1F54B, Hi: 0x1F54E, Stride: 1},
+		{Lo: 0x1F550, Hi: 0x1F567, Stride: 1},
+		{Lo: 0x1F57A, Hi: 0x1F57A, Stride: 1},
+		{Lo: 0x1F595, Hi: 0x1F596, Stride: 1},
+		{Lo: 0x1F5A4, Hi: 0x1F5A4, Stride: 1},
+		{Lo: 0x1F5FB, Hi: 0x1F64F, Stride: 1},
+		{Lo: 0x1F680, Hi: 0x1F6C5, Stride: 1},
+		{Lo: 0x1F6CC, Hi: 0x1F6CC, Stride: 1},
+		{Lo: 0x1F6D0, Hi: 0x1F6D2, Stride: 1},
+		{Lo: 0x1F6D5, Hi: 0x1F6D7, Stride: 1},
+		{Lo: 0x1F6DC, Hi: 0x1F6DF, Stride: 1},
+		{Lo: 0x1F6EB, Hi: 0x1F6EC, Stride: 1},
+		{Lo: 0x1F6F4, Hi: 0x1F6FC, Stride: 1},
+		{Lo: 0x1F7E0, Hi: 0x1F7EB, Stride: 1},
+		{Lo: 0x1F7F0, Hi: 0x1F7F0, Stride: 1},
+		{Lo: 0x1F90C, Hi: 0x1F93A, Stride: 1},
+		{Lo: 0x1F93C, Hi: 0x1F945, Stride: 1},
+		{Lo: 0x1F947, Hi: 0x1F9FF, Stride: 1},
+		{Lo: 0x1FA70, Hi: 0x1FAFF, Stride: 1},
+		{Lo: 0x20000, Hi: 0x2FFFD, Stride: 1},
+		{Lo: 0x30000, Hi: 0x3FFFD, Stride: 1},
+	},
+}
+
+/*
+ * graphemeKind names the reason the runes of a cluster belong together, and computes its width:
+ * the width of its first rune, or 2 when an emoji presentation selector (U+FE0F) makes it an emoji. */
+func graphemeKind(text string) (kind string, width int) {
+	runes := []rune(text)
+	first := runes[0]
+	width = max(RuneWidth(first), 0)
+	if !utf8.ValidString(text) {
+		return "", 0
+	}
+	if len(runes) == 1 {
+		return "", width
+	}
+
+	var hasZWJ, hasModifier, hasEmojiSelector, hasMark, hasTag bool
+	for _, r := range runes[1:] {
+		switch {
+		case r == ZERO_WIDTH_JOINER:
+			hasZWJ = true
+		case 0x1F3FB <= r && r <= 0x1F3FF:
+			hasModifier = true
+		case r == VARIATION_SELECTOR_16:
+			hasEmojiSelector = true
+		case 0xE0020 <= r && r <= 0xE007F:
+			hasTag = true
+		case unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc):
+			hasMark = true
+		}
+	}
+	if hasEmojiSelector {
+		width = 2
+	}
+
+	switch {
+	case text == "\r\n":
+		return "CR LF line break", 0
+	case classifyGrapheme(first) == GRAPHEME_REGIONAL_INDICATOR:
+		return "flag (two regional indicators)", 2
+	case hasTag:
+		return "flag (tag sequence)", 2
+	case hasZWJ && classifyGrapheme(first) == GRAPHEME_PICTOGRAPHIC:
+		return "emoji ZWJ sequence", 2
+	case hasModifier:
+		return "emoji with skin tone modifier", 2
+	case first >= 0x1100 && first <= 0x11FF:
+		return "Hangul syllable from jamo", 2
+	case hasMark:
+		return "base character + combining mark(s)", width
+	case hasEmojiSelector:
+		return "emoji presentation (variation selector 16)", 2
+	}
+
+	return "joined by the grapheme cluster rules", width
+}
@@ -0,0 +1,82 @@
+package error_handling
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+func getErrorValues(w io.Writer) {
+	/*
+	 * error is a built-in interface with a single method:
+	 *
+	 *	type error interface {
+	 *		Error() string
+	 *	}
+	 *
+	 * errors.New makes an error from a message, and printing an error prints its message. */
+	err := errors.New("connection refused")
+	fmt.Fprintln(w, err)                                 // Output: connection refused
+	fmt.Fprintf(w, "%T\n", err)                          // Output: *errors.errorString
+	fmt.Fprintln(w, err.Error() == "connection refused") // Output: true
+
+	// Every call to errors.New makes a different error, even with the same message: errors are compared by identity.
+	fmt.Fprintln(w, errors.New("timeout") == errors.New("timeout")) // Output: false
+
+	/*
+	 * fmt.Errorf builds the message like fmt.Sprintf. By convention, a message starts in lowercase
+	 * and does not end with punctuation, because it often ends up inside another message.
+	 *
+	 * error is a predeclared identifier, not a keyword: a variable named error compiles,
+	 * but hides the type for the rest of its scope. Error variables are named err. */
+	err = fmt.Errorf("port %d is already in use", 8080)
+	fmt.Fprintln(w, err) // Output: port 8080 is already in use
+}
+
+func getCheckingErrors(w io.Writer) {
+	/*
+	 * A function that can fail returns an error as its last result, and nil when it succeeded.
+	 * The caller checks it right away; when it is not nil, the other results should not be used
+	 * (parseAge returns 0 for them). */
+	age, err := parseAge("42")
+	fmt.Fprintln(w, age, err) // Output: 42 <nil>
+
+	age, err = parseAge("-3")
+	fmt.Fprintln(w, age, err) // Output: 0 age -3 is negative
+
+	age, err = parseAge("forty-two")
+	fmt.Fprintln(w, age, err) // Output: 0 strconv.Atoi: parsing "forty-two": invalid syntax
+
+	/*
+	 * A few functions document an exception. io.Reader can return data and an error in the same call
+	 * (e.g., 3 bytes and io.EOF), so a reader handles n first and looks at err after. */
+	r := strings.NewReader("abc")
+	buffer := make([]byte, 8)
+	n, err := r.Read(buffer)
+	fmt.Fprintf(w, "%d %q %v\n", n, buffer[:n], err) // Output: 3 "abc" <nil>
+
+	n, err = r.Read(buffer)
+	fmt.Fprintf(w, "%d %q %v\n", n, buffer[:n], err) // Output: 0 "" EOF
+
+	// Ignoring an error must be visible: assign it to _ (the blank identifier), never leave it unchecked by accident.
+	value, _ := strconv.Atoi("7")
+	fmt.Fprintln(w, value) // Output: 7
+}
+
+/*
+ * parseAge shows the usual shape of a function that can fail:
+ * every failure returns early with the zero value and an error, and the last line is the success. */
+func parseAge(s string) (int, error) {
+	age, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, err
+	}
+
+	if age < 0 {
+		return 0, fmt.Errorf("age %d is negative", age)
+	}
+
+	return age, nil
+}
@@ -0,0 +1,65 @@
+/*
+ * In Go, an error is a value: a function that can fail returns an error as its last result,
+ * and the caller checks it with if err != nil. There are no exceptions.
+ *
+ * error is a built-in interface, so any type with an Error() string method is an error.
+ * This package covers the ways to make, wrap, inspect and combine errors, and panic and recover.
+ *
+ * Which one to use:
+ * 1. errors.New     : A fixed message, when callers only need to know that something failed
+ * 2. fmt.Errorf     : A message with values; with %w it also keeps the error it was caused by
+ * 3. Sentinel error : A package-level error value (e.g., io.EOF), when callers test for one condition
+ * 4. Custom type    : A struct implementing error, when callers need data about the failure (e.g., a line number)
+ * 5. errors.Join    : Several independent failures reported together (e.g., every invalid field of a form)
+ * 6. panic          : A bug or an impossible state, never an expected failure such as bad input or a missing file */
+package error_handling
+
+import "github.com/fajarstrtn/golang-tutorial/lesson"
+
+/*
+ * errors.New and fmt.Errorf make error values. A function returns one as its last result,
+ * nil when it succeeded, and the caller checks it before using the other results. */
+func GenerateErrors(env *lesson.Env) {
+	getErrorValues(env.Out)
+	getCheckingErrors(env.Out)
+}
+
+/*
+ * fmt.Errorf with the %w verb wraps an error: the new error adds context to the message
+ * and keeps the original, so errors.Is and errors.As can still find it down the chain. */
+func GenerateWrapping(env *lesson.Env) {
+	getWrapping(env.Out)
+	getWrapVersusFormat(env.Out)
+}
+
+/*
+ * A sentinel error is a package-level error value (e.g., io.EOF, fs.ErrNotExist).
+ * Callers test for it with errors.Is, which also finds it when it was wrapped. */
+func GenerateSentinelErrors(env *lesson.Env) {
+	getSentinelErrors(env.Out)
+	getEOF(env.Out)
+}
+
+/*
+ * A custom error type carries data about the failure. errors.As finds an error of that type
+ * in the chain and gives it to the caller, and Unwrap and Is methods let the type take part in the chain. */
+func GenerateErrorTypes(env *lesson.Env) {
+	getErrorTypes(env.Out)
+	getCustomIs(env.Out)
+}
+
+/*
+ * errors.Join and fmt.Errorf with several %w put several errors into one:
+ * errors.Is and errors.As look into every one of them. */
+func GenerateJoin(env *lesson.Env) {
+	getJoin(env.Out)
+	getMultipleWraps(env.Out)
+}
+
+/*
+ * panic stops the normal flow of a goroutine: the deferred calls run, then the program crashes,
+ * unless a deferred function calls recover. It is for bugs, not for errors a caller can handle. */
+func GeneratePanic(env *lesson.Env) {
+	getPanic(env.Out)
+	getRecoverToError(env.Out)
+}
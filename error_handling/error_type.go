@@ -0,0 +1,122 @@
+package error_handling
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+/*
+ * A ParseError reports the line where parsing failed and why.
+ * Err is the cause: the Unwrap method makes it the next link of the chain. */
+type ParseError struct {
+	Line int
+	Err  error
+}
+
+// Error makes a *ParseError an error.
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+// Unwrap returns the cause, so errors.Is and errors.As look into it.
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// parseSettings parses lines of key=value with an integer value.
+func parseSettings(text string) (map[string]int, error) {
+	settings := map[string]int{}
+
+	for i, line := range strings.Split(strings.TrimSpace(text), "\n") {
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, &ParseError{Line: i + 1, Err: errors.New("missing =")}
+		}
+
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, &ParseError{Line: i + 1, Err: err}
+		}
+
+		settings[key] = n
+	}
+
+	return settings, nil
+}
+
+func getErrorTypes(w io.Writer) {
+	settings, err := parseSettings("width=80\nheight=24")
+	fmt.Fprintln(w, settings, err) // Output: map[height:24 width:80] <nil>
+
+	/*
+	 * errors.As finds the first error of the chain that has the type of its target,
+	 * and stores it there: the target is a pointer to a variable of that type (here **ParseError).
+	 * The caller then gets the fields, not only a message. */
+	_, err = parseSettings("width=80\nheight=tall")
+	fmt.Fprintln(w, err) // Output: line 2: strconv.Atoi: parsing "tall": invalid syntax
+
+	var parseErr *ParseError
+	found := errors.As(err, &parseErr)
+	fmt.Fprintln(w, found, parseErr.Line) // Output: true 2
+
+	// Thanks to Unwrap, errors.As also finds the *strconv.NumError inside it, and errors.Is the sentinel inside that.
+	var numErr *strconv.NumError
+	found = errors.As(err, &numErr)
+	fmt.Fprintln(w, found, numErr.Func, numErr.Num)    // Output: true Atoi tall
+	fmt.Fprintln(w, errors.Is(err, strconv.ErrSyntax)) // Output: true
+
+	/*
+	 * Not every failure has a cause: "missing =" comes from errors.New, so the chain ends there.
+	 * errors.As returns false and leaves its target alone when no error of the chain has the type. */
+	_, err = parseSettings("width")
+	numErr = nil
+	found = errors.As(err, &numErr)
+	fmt.Fprintln(w, err, found, numErr == nil) // Output: line 1: missing = false true
+
+	/*
+	 * The target must point to the exact type stored in the chain. The methods of ParseError
+	 * have pointer receivers, so the chain holds a *ParseError and the target is a **ParseError.
+	 * A *ParseError target (pointing to a ParseError, which is not an error) makes errors.As panic,
+	 * and go vet reports it before the program runs. */
+}
+
+/*
+ * A StatusError is an error for a status code of an HTTP response.
+ * It has a value receiver, so the chain holds a StatusError, not a pointer. */
+type StatusError struct {
+	Code int
+}
+
+// ErrClientError stands for every 4xx status code.
+var ErrClientError = errors.New("client error")
+
+// Error makes StatusError an error.
+func (e StatusError) Error() string {
+	return "status " + strconv.Itoa(e.Code)
+}
+
+/*
+ * Is is called by errors.Is for every error of the chain, with the error it looks for.
+ * Without it, errors.Is only compares with ==; with it, a StatusError with any 4xx code is an ErrClientError. */
+func (e StatusError) Is(target error) bool {
+	return target == ErrClientError && e.Code >= 400 && e.Code < 500
+}
+
+func getCustomIs(w io.Writer) {
+	notFound := fmt.Errorf("get /users/7: %w", StatusError{Code: 404})
+	unavailable := fmt.Errorf("get /users/7: %w", StatusError{Code: 503})
+
+	fmt.Fprintln(w, notFound)                                                                    // Output: get /users/7: status 404
+	fmt.Fprintln(w, errors.Is(notFound, ErrClientError), errors.Is(unavailable, ErrClientError)) // Output: true false
+
+	// errors.Is also compares with ==, so a comparable error value can be its own target.
+	fmt.Fprintln(w, errors.Is(unavailable, StatusError{Code: 503})) // Output: true
+
+	// With a value receiver, the target of errors.As is a *StatusError.
+	var status StatusError
+	found := errors.As(unavailable, &status)
+	fmt.Fprintln(w, found, status.Code) // Output: true 503
+}
@@ -0,0 +1,108 @@
+// Code generated by "go run . examples"; DO NOT EDIT.
+
+package error_handling_test
+
+import (
+	"os"
+
+	"github.com/fajarstrtn/golang-tutorial/error_handling"
+	"github.com/fajarstrtn/golang-tutorial/lesson"
+)
+
+// ExampleGenerateErrorTypes runs the error_handling/types lesson.
+func ExampleGenerateErrorTypes() {
+	error_handling.GenerateErrorTypes(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// map[height:24 width:80] <nil>
+	// line 2: strconv.Atoi: parsing "tall": invalid syntax
+	// true 2
+	// true Atoi tall
+	// true
+	// line 1: missing = false true
+	// get /users/7: status 404
+	// true false
+	// true
+	// true 503
+}
+
+// ExampleGenerateErrors runs the error_handling/errors lesson.
+func ExampleGenerateErrors() {
+	error_handling.GenerateErrors(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// connection refused
+	// *errors.errorString
+	// true
+	// false
+	// port 8080 is already in use
+	// 42 <nil>
+	// 0 age -3 is negative
+	// 0 strconv.Atoi: parsing "forty-two": invalid syntax
+	// 3 "abc" <nil>
+	// 0 "" EOF
+	// 7
+}
+
+// ExampleGenerateJoin runs the error_handling/join lesson.
+func ExampleGenerateJoin() {
+	error_handling.GenerateJoin(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// name is empty
+	// age -1: negative
+	// email "gopher" has no @
+	// true
+	// true
+	// true
+	// <nil>
+	// 3
+	// write: disk full
+	// close: already closed
+	// save order: out of stock, and then rollback: not found
+	// true true
+	// true
+}
+
+// ExampleGeneratePanic runs the error_handling/panic lesson.
+func ExampleGeneratePanic() {
+	error_handling.GeneratePanic(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// <nil>
+	// unreachable state
+	// [second deferred first deferred] boom
+	// [1 25 6]
+	// error parsing regexp: missing closing ): `v(\d+`
+	// *runtime.PanicNilError
+	// 3 <nil>
+	// 0 safe divide: runtime error: integer divide by zero
+	// true
+}
+
+// ExampleGenerateSentinelErrors runs the error_handling/sentinel lesson.
+func ExampleGenerateSentinelErrors() {
+	error_handling.GenerateSentinelErrors(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// buy durian: not found
+	// false true
+	// false
+	// apple: ok
+	// banana: sold out, come back tomorrow
+	// durian: we do not sell durian
+	// ["he" "ll" "o" ""]
+	// hello <nil>
+}
+
+// ExampleGenerateWrapping runs the error_handling/wrapping lesson.
+func ExampleGenerateWrapping() {
+	error_handling.GenerateWrapping(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// 8080 <nil>
+	// read port: open missing.conf: file does not exist
+	// *fmt.wrapError: read port: open missing.conf: file does not exist
+	// *fs.PathError: open missing.conf: file does not exist
+	// *errors.errorString: file does not exist
+	// true
+	// read port from broken.conf: strconv.Atoi: parsing "eighty": invalid syntax
+	// true
+	// true false
+	// <nil>
+	// false
+}
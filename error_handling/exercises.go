@@ -0,0 +1,239 @@
+package error_handling
+
+import "github.com/fajarstrtn/golang-tutorial/exercise"
+
+func init() {
+	exercise.Register(exercise.Exercise{
+		ID:     "error_handling/errors/parse-port",
+		Lesson: "error_handling/errors",
+		Title:  "Return errors with errors.New and fmt.Errorf",
+		Prompt: "parsePort ignores its errors, so main cannot tell a bad port from port 0. Make it return (int, error), with 0 as the port for every error: errors.New(\"empty port\") for an empty string (the message never changes), the error of strconv.Atoi as it is, and fmt.Errorf(\"port %d is out of range\", port) above 65535 (the message holds the port). The output must be:\n8080 <nil>\n0 empty port\n0 strconv.Atoi: parsing \"http\": invalid syntax\n0 port 70000 is out of range",
+		Starter: `package main
+
+import (
+	"fmt"
+	"strconv"
+)
+
+func parsePort(s string) int {
+	port, _ := strconv.Atoi(s)
+	return port
+}
+
+func main() {
+	for _, s := range []string{"8080", "", "http", "70000"} {
+		fmt.Println(parsePort(s))
+	}
+}
+`,
+		Checks: []exercise.Check{
+			exercise.Calls("errors.New", 1),
+			exercise.Calls("fmt.Errorf", 1),
+			exercise.OutputIs("8080 <nil>\n0 empty port\n0 strconv.Atoi: parsing \"http\": invalid syntax\n0 port 70000 is out of range"),
+		},
+	})
+
+	exercise.Register(exercise.Exercise{
+		ID:     "error_handling/wrapping/add-context",
+		Lesson: "error_handling/wrapping",
+		Title:  "Wrap an error with %w",
+		Prompt: "greetUser adds context to the error of findUser with %v, so main can no longer find ErrNotFound in it. Wrap the error instead. The output must be:\nHello, gopher <nil>\ngreet user 7: not found\ntrue",
+		Starter: `package main
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrNotFound = errors.New("not found")
+
+var USERS = map[int]string{1: "gopher"}
+
+func findUser(id int) (string, error) {
+	name, ok := USERS[id]
+	if !ok {
+		return "", ErrNotFound
+	}
+	return name, nil
+}
+
+func greetUser(id int) (string, error) {
+	name, err := findUser(id)
+	if err != nil {
+		return "", fmt.Errorf("greet user %d: %v", id, err)
+	}
+	return "Hello, " + name, nil
+}
+
+func main() {
+	fmt.Println(greetUser(1))
+
+	_, err := greetUser(7)
+	fmt.Println(err)
+	fmt.Println(errors.Is(err, ErrNotFound))
+}
+`,
+		Checks: []exercise.Check{
+			exercise.WrapsErrors(),
+			exercise.OutputIs("Hello, gopher <nil>\ngreet user 7: not found\ntrue"),
+		},
+	})
+
+	exercise.Register(exercise.Exercise{
+		ID:     "error_handling/sentinel/empty-name",
+		Lesson: "error_handling/sentinel",
+		Title:  "Declare and test a sentinel error",
+		Prompt: "Declare ErrEmptyName = errors.New(\"empty name\"), wrap it in the error of parseName, and make main print \"please type a name\" after the error when errors.Is finds it. The output must be:\nGopher\nerror: parse name \"   \": empty name\nplease type a name",
+		Starter: `package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+func parseName(s string) (string, error) {
+	name := strings.TrimSpace(s)
+	if name == "" {
+		return "", fmt.Errorf("parse name %q: empty", s)
+	}
+	return name, nil
+}
+
+func main() {
+	for _, s := range []string{" Gopher ", "   "} {
+		name, err := parseName(s)
+		if err != nil {
+			fmt.Println("error:", err)
+			continue
+		}
+		fmt.Println(name)
+	}
+}
+`,
+		Checks: []exercise.Check{
+			exercise.Calls("errors.New", 1),
+			exercise.Calls("errors.Is", 1),
+			exercise.WrapsErrors(),
+			exercise.OutputIs("Gopher\nerror: parse name \"   \": empty name\nplease type a name"),
+		},
+	})
+
+	exercise.Register(exercise.Exercise{
+		ID:     "error_handling/types/unwrap",
+		Lesson: "error_handling/types",
+		Title:  "Let a custom error type unwrap its cause",
+		Prompt: "errors.As finds the *QueryError, but errors.Is cannot see the ErrTimeout inside it. Give QueryError an Unwrap method that returns its cause. The output must be:\nload users: SELECT * FROM users: timeout\ntrue\nSELECT * FROM users",
+		Starter: `package main
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrTimeout = errors.New("timeout")
+
+type QueryError struct {
+	Query string
+	Err   error
+}
+
+func (e *QueryError) Error() string {
+	return e.Query + ": " + e.Err.Error()
+}
+
+func runQuery(query string) error {
+	return &QueryError{Query: query, Err: ErrTimeout}
+}
+
+func main() {
+	err := fmt.Errorf("load users: %w", runQuery("SELECT * FROM users"))
+	fmt.Println(err)
+	fmt.Println(errors.Is(err, ErrTimeout))
+
+	var queryErr *QueryError
+	if errors.As(err, &queryErr) {
+		fmt.Println(queryErr.Query)
+	}
+}
+`,
+		Checks: []exercise.Check{
+			exercise.Calls("errors.As", 1),
+			exercise.WrapsErrors(),
+			exercise.OutputIs("load users: SELECT * FROM users: timeout\ntrue\nSELECT * FROM users"),
+		},
+	})
+
+	exercise.Register(exercise.Exercise{
+		ID:     "error_handling/join/every-field",
+		Lesson: "error_handling/join",
+		Title:  "Report every invalid field",
+		Prompt: "validate stops at the first invalid field. Collect the error of every field and return them with errors.Join (nil when all are valid), keeping ErrNegative wrapped. The output must be:\nname is empty\nage -1: negative\ntrue\n<nil>",
+		Starter: `package main
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrNegative = errors.New("negative")
+
+func validate(name string, age int) error {
+	if name == "" {
+		return errors.New("name is empty")
+	}
+	if age < 0 {
+		return fmt.Errorf("age %d: %w", age, ErrNegative)
+	}
+	return nil
+}
+
+func main() {
+	err := validate("", -1)
+	fmt.Println(err)
+	fmt.Println(errors.Is(err, ErrNegative))
+	fmt.Println(validate("Gopher", 16))
+}
+`,
+		Checks: []exercise.Check{
+			exercise.Calls("errors.Join", 1),
+			exercise.WrapsErrors(),
+			exercise.OutputIs("name is empty\nage -1: negative\ntrue\n<nil>"),
+		},
+	})
+
+	exercise.Register(exercise.Exercise{
+		ID:     "error_handling/panic/recover-to-error",
+		Lesson: "error_handling/panic",
+		Title:  "Turn a panic into a wrapped error",
+		Prompt: "safeGet panics when the index is out of range. Name its results, and recover in a deferred function: set err to fmt.Errorf(\"safe get %d: %w\", i, e), where e is the recovered value as an error. The output must be:\nb <nil>\nsafe get 5: runtime error: index out of range [5] with length 2\ntrue",
+		Starter: `package main
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+)
+
+func safeGet(items []string, i int) (string, error) {
+	return items[i], nil
+}
+
+func main() {
+	items := []string{"a", "b"}
+	fmt.Println(safeGet(items, 1))
+
+	_, err := safeGet(items, 5)
+	fmt.Println(err)
+
+	var runtimeErr runtime.Error
+	fmt.Println(errors.As(err, &runtimeErr))
+}
+`,
+		Checks: []exercise.Check{
+			exercise.UsesKeyword("defer"),
+			exercise.Calls("recover", 1),
+			exercise.WrapsErrors(),
+			exercise.OutputIs("b <nil>\nsafe get 5: runtime error: index out of range [5] with length 2\ntrue"),
+		},
+	})
+}
@@ -0,0 +1,83 @@
+package error_handling
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ErrNegative is wrapped by validateUser for a negative number.
+var ErrNegative = errors.New("negative")
+
+/*
+ * validateUser checks every field and reports all the invalid ones at once,
+ * instead of stopping at the first: the user can fix the whole form in one go. */
+func validateUser(name string, age int, email string) error {
+	var errs []error
+
+	if name == "" {
+		errs = append(errs, errors.New("name is empty"))
+	}
+
+	if age < 0 {
+		errs = append(errs, fmt.Errorf("age %d: %w", age, ErrNegative))
+	}
+
+	if !strings.Contains(email, "@") {
+		errs = append(errs, fmt.Errorf("email %q has no @", email))
+	}
+
+	return errors.Join(errs...)
+}
+
+func getJoin(w io.Writer) {
+	err := validateUser("", -1, "gopher")
+
+	/*
+	 * errors.Join makes one error of several, and prints their messages on separate lines.
+	 *
+	 * Output:
+	 * name is empty
+	 * age -1: negative
+	 * email "gopher" has no @ */
+	fmt.Fprintln(w, err)
+
+	// errors.Is and errors.As look into every joined error.
+	fmt.Fprintln(w, errors.Is(err, ErrNegative)) // Output: true
+
+	/*
+	 * errors.Join ignores nil errors, and returns nil when all of them are nil,
+	 * so its result can be returned as it is. */
+	fmt.Fprintln(w, validateUser("Gopher", 16, "gopher@go.dev") == nil) // Output: true
+	fmt.Fprintln(w, errors.Join(nil, nil) == nil)                       // Output: true
+
+	/*
+	 * A joined error has an Unwrap() []error method instead of Unwrap() error,
+	 * so errors.Unwrap returns nil for it; a type assertion gets the list. */
+	fmt.Fprintln(w, errors.Unwrap(err)) // Output: <nil>
+
+	joined := err.(interface{ Unwrap() []error })
+	fmt.Fprintln(w, len(joined.Unwrap())) // Output: 3
+
+	writeErr, closeErr := errors.New("write: disk full"), errors.New("close: already closed")
+
+	/*
+	 * Joining is also how a second error is kept instead of lost,
+	 * e.g., the error of Close after the error of Write.
+	 *
+	 * Output:
+	 * write: disk full
+	 * close: already closed */
+	fmt.Fprintln(w, errors.Join(writeErr, closeErr))
+}
+
+func getMultipleWraps(w io.Writer) {
+	/*
+	 * fmt.Errorf can use %w more than once: the error wraps every one of them,
+	 * on one line, with the message the format gives. */
+	err := fmt.Errorf("save order: %w, and then rollback: %w", ErrOutOfStock, ErrNotFound)
+	fmt.Fprintln(w, err)                                                        // Output: save order: out of stock, and then rollback: not found
+	fmt.Fprintln(w, errors.Is(err, ErrOutOfStock), errors.Is(err, ErrNotFound)) // Output: true true
+	fmt.Fprintln(w, errors.Unwrap(err) == nil)                                  // Output: true
+}
@@ -0,0 +1,12 @@
+package error_handling
+
+import "github.com/fajarstrtn/golang-tutorial/lesson"
+
+func init() {
+	lesson.Register(lesson.Lesson{ID: "error_handling/errors", Title: "errors.New, fmt.Errorf and checking errors", Topic: "error_handling", Order: 1200, Run: GenerateErrors})
+	lesson.Register(lesson.Lesson{ID: "error_handling/wrapping", Title: "Wrapping errors with %w", Topic: "error_handling", Order: 1210, Run: GenerateWrapping})
+	lesson.Register(lesson.Lesson{ID: "error_handling/sentinel", Title: "Sentinel errors and errors.Is", Topic: "error_handling", Order: 1220, Run: GenerateSentinelErrors})
+	lesson.Register(lesson.Lesson{ID: "error_handling/types", Title: "Custom error types and errors.As", Topic: "error_handling", Order: 1230, Run: GenerateErrorTypes})
+	lesson.Register(lesson.Lesson{ID: "error_handling/join", Title: "errors.Join and several wrapped errors", Topic: "error_handling", Order: 1240, Run: GenerateJoin})
+	lesson.Register(lesson.Lesson{ID: "error_handling/panic", Title: "panic and recover", Topic: "error_handling", Order: 1250, Run: GeneratePanic})
+}
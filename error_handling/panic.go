@@ -0,0 +1,106 @@
+package error_handling
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"runtime"
+)
+
+/*
+ * VERSION_PATTERN is compiled when the package is initialized. The pattern is a constant,
+ * so an error here is a bug in the program: regexp.MustCompile panics instead of returning it. */
+var VERSION_PATTERN = regexp.MustCompile(`^v(\d+)\.(\d+)\.(\d+)$`)
+
+func getPanic(w io.Writer) {
+	/*
+	 * A panic runs the deferred calls of every function on the way up, then crashes the program
+	 * with the panic value and a stack trace. The runtime panics on bugs such as an index out of range,
+	 * and code panics with panic(value) on a state that should be impossible.
+	 *
+	 * recover, called by a deferred function, stops the panic and returns its value.
+	 * Everywhere else (or when there is no panic) it returns nil. */
+	fmt.Fprintln(w, recover()) // Output: <nil>
+
+	panicked := recovered(func() {
+		panic("unreachable state")
+	})
+	fmt.Fprintln(w, panicked) // Output: unreachable state
+
+	// The deferred calls run during the panic, last deferred first, before recover gets the value.
+	var steps []string
+	panicked = recovered(func() {
+		defer func() { steps = append(steps, "first deferred") }()
+		defer func() { steps = append(steps, "second deferred") }()
+		panic("boom")
+	})
+	fmt.Fprintln(w, steps, panicked) // Output: [second deferred first deferred] boom
+
+	/*
+	 * The Must functions of the standard library (e.g., regexp.MustCompile, template.Must) panic
+	 * on an error that can only come from the program itself, like a constant pattern.
+	 * An error that depends on input (a pattern typed by the user) goes through regexp.Compile and its error. */
+	fmt.Fprintln(w, VERSION_PATTERN.FindStringSubmatch("v1.25.6")[1:]) // Output: [1 25 6]
+
+	_, err := regexp.Compile(`v(\d+`)
+	fmt.Fprintln(w, err) // Output: error parsing regexp: missing closing ): `v(\d+`
+
+	/*
+	 * panic(nil) panics with a *runtime.PanicNilError, so recover never returns nil for a real panic.
+	 * A panic in a goroutine can only be recovered in that goroutine: a panic nobody recovers crashes the whole program. */
+	panicked = recovered(func() {
+		panic(nil)
+	})
+	fmt.Fprintf(w, "%T\n", panicked) // Output: *runtime.PanicNilError
+}
+
+// recovered calls f and returns the value it panicked with, or nil.
+func recovered(f func()) (value any) {
+	defer func() {
+		value = recover()
+	}()
+
+	f()
+	return nil
+}
+
+/*
+ * safeDivide turns a panic into an error, at the boundary where the caller expects errors:
+ * the deferred function sets the named result err. When the panic value is an error,
+ * %w wraps it, so the caller can still find it with errors.As. */
+func safeDivide(a, b int) (quotient int, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if e, ok := r.(error); ok {
+				err = fmt.Errorf("safe divide: %w", e)
+			} else {
+				err = fmt.Errorf("safe divide: %v", r)
+			}
+		}
+	}()
+
+	return a / b, nil
+}
+
+func getRecoverToError(w io.Writer) {
+	quotient, err := safeDivide(7, 2)
+	fmt.Fprintln(w, quotient, err) // Output: 3 <nil>
+
+	quotient, err = safeDivide(7, 0)
+	fmt.Fprintln(w, quotient, err) // Output: 0 safe divide: runtime error: integer divide by zero
+
+	// The runtime panics with values implementing runtime.Error.
+	var runtimeErr runtime.Error
+	fmt.Fprintln(w, errors.As(err, &runtimeErr)) // Output: true
+
+	/*
+	 * Recovering is for boundaries: a server recovers the panic of one request so that the others keep working
+	 * (net/http does it for every handler). Recovering everywhere hides bugs; returning errors is the normal way.
+	 *
+	 * A panic is right when:
+	 * 1. The program has a bug, and going on would do more harm (e.g., corrupt data)
+	 * 2. Initialization cannot succeed (e.g., a Must function at package level)
+	 *
+	 * An error is right for everything a caller can expect and handle: bad input, a missing file, a timeout. */
+}
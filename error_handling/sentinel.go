@@ -0,0 +1,100 @@
+package error_handling
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+/*
+ * Sentinel errors are package-level variables, made once with errors.New.
+ * Their names start with Err (io.EOF is an old exception), and they are exported
+ * so that callers can compare against them. */
+var (
+	ErrNotFound   = errors.New("not found")
+	ErrOutOfStock = errors.New("out of stock")
+)
+
+// STOCK is the inventory of the examples: the quantity of every item the shop sells.
+var STOCK = map[string]int{"apple": 3, "banana": 0}
+
+// buy checks that the quantity of an item can be bought, and wraps a sentinel error when it cannot.
+func buy(item string, quantity int) error {
+	stock, ok := STOCK[item]
+	if !ok {
+		return fmt.Errorf("buy %s: %w", item, ErrNotFound)
+	}
+
+	if stock < quantity {
+		return fmt.Errorf("buy %d %s: %w", quantity, item, ErrOutOfStock)
+	}
+
+	return nil
+}
+
+func getSentinelErrors(w io.Writer) {
+	/*
+	 * == compares with the error itself, so it stops working as soon as the sentinel is wrapped.
+	 * errors.Is compares with every error of the chain: use it instead of ==. */
+	err := buy("durian", 1)
+	fmt.Fprintln(w, err)                                             // Output: buy durian: not found
+	fmt.Fprintln(w, err == ErrNotFound, errors.Is(err, ErrNotFound)) // Output: false true
+	fmt.Fprintln(w, errors.Is(err, ErrOutOfStock))                   // Output: false
+
+	/*
+	 * A switch with errors.Is in its cases handles each sentinel in its own way.
+	 *
+	 * Output:
+	 * apple: ok
+	 * banana: sold out, come back tomorrow
+	 * durian: we do not sell durian */
+	for _, item := range []string{"apple", "banana", "durian"} {
+		fmt.Fprintf(w, "%s: %s\n", item, explainPurchase(item, buy(item, 1)))
+	}
+
+	/*
+	 * A sentinel is part of the API of its package: once callers test for it, it cannot be removed or renamed.
+	 * It cannot carry data either (which item was not found?): the message of the wrapping error has it,
+	 * and a custom error type is the way to give it to the code (see the error_handling/types lesson). */
+}
+
+func explainPurchase(item string, err error) string {
+	switch {
+	case err == nil:
+		return "ok"
+	case errors.Is(err, ErrOutOfStock):
+		return "sold out, come back tomorrow"
+	case errors.Is(err, ErrNotFound):
+		return "we do not sell " + item
+	default:
+		return err.Error()
+	}
+}
+
+func getEOF(w io.Writer) {
+	/*
+	 * io.EOF is the best-known sentinel: a reader returns it when there is nothing left to read.
+	 * It is an expected condition, not a failure, so the loop stops on it and handles every other error.
+	 * Readers return io.EOF itself, never wrapped (== works), but errors.Is works in every case. */
+	r := strings.NewReader("hello")
+	buffer := make([]byte, 2)
+
+	var chunks []string
+	for {
+		n, err := r.Read(buffer)
+		chunks = append(chunks, string(buffer[:n]))
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			fmt.Fprintln(w, "read:", err)
+			return
+		}
+	}
+	fmt.Fprintf(w, "%q\n", chunks) // Output: ["he" "ll" "o" ""]
+
+	// io.ReadAll reads until io.EOF and does not return it: reaching the end is how it succeeds.
+	data, err := io.ReadAll(strings.NewReader("hello"))
+	fmt.Fprintln(w, string(data), err) // Output: hello <nil>
+}
@@ -0,0 +1,91 @@
+package error_handling
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"strconv"
+	"strings"
+	"testing/fstest"
+)
+
+// CONFIG_FILES is an in-memory file system for the examples, so they do not depend on the disk.
+var CONFIG_FILES = fstest.MapFS{
+	"app.conf":    {Data: []byte("port=8080\n")},
+	"broken.conf": {Data: []byte("port=eighty\n")},
+}
+
+func getWrapping(w io.Writer) {
+	port, err := readPort("app.conf")
+	fmt.Fprintln(w, port, err) // Output: 8080 <nil>
+
+	/*
+	 * Returning the error of fs.ReadFile as it is would only say "file does not exist", with no clue about who read it.
+	 * readPort wraps it with fmt.Errorf("read port: %w", err): the message gets the context in front,
+	 * and the new error keeps the original one inside it. */
+	_, err = readPort("missing.conf")
+	fmt.Fprintln(w, err) // Output: read port: open missing.conf: file does not exist
+
+	/*
+	 * Each wrap adds a link to a chain, and errors.Unwrap returns the next link (nil at the end).
+	 * Here the chain has three errors: readPort's, the *fs.PathError of fs.ReadFile, and fs.ErrNotExist.
+	 *
+	 * Output:
+	 * *fmt.wrapError: read port: open missing.conf: file does not exist
+	 * *fs.PathError: open missing.conf: file does not exist
+	 * *errors.errorString: file does not exist */
+	for e := err; e != nil; e = errors.Unwrap(e) {
+		fmt.Fprintf(w, "%T: %v\n", e, e)
+	}
+
+	// errors.Is walks the chain, so the caller can still test for the cause.
+	fmt.Fprintln(w, errors.Is(err, fs.ErrNotExist)) // Output: true
+
+	/*
+	 * The context says what was being done, not that it failed (read port:, not failed to read port:):
+	 * every link of the chain failed, so "failed to" would repeat on every level. */
+	_, err = readPort("broken.conf")
+	fmt.Fprintln(w, err) // Output: read port from broken.conf: strconv.Atoi: parsing "eighty": invalid syntax
+}
+
+// readPort reads the port=<number> line of a configuration file and wraps every error with what it was doing.
+func readPort(name string) (int, error) {
+	data, err := fs.ReadFile(CONFIG_FILES, name)
+	if err != nil {
+		return 0, fmt.Errorf("read port: %w", err)
+	}
+
+	value, ok := strings.CutPrefix(strings.TrimSpace(string(data)), "port=")
+	if !ok {
+		return 0, fmt.Errorf("read port: %s has no port", name)
+	}
+
+	port, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("read port from %s: %w", name, err)
+	}
+
+	return port, nil
+}
+
+func getWrapVersusFormat(w io.Writer) {
+	_, cause := fs.ReadFile(CONFIG_FILES, "missing.conf")
+
+	/*
+	 * %v puts the message of an error into the new one without wrapping it:
+	 * both errors print the same, but the chain of the %v one ends there. */
+	wrapped := fmt.Errorf("read port: %w", cause)
+	flattened := fmt.Errorf("read port: %v", cause)
+	fmt.Fprintln(w, wrapped.Error() == flattened.Error())                                     // Output: true
+	fmt.Fprintln(w, errors.Is(wrapped, fs.ErrNotExist), errors.Is(flattened, fs.ErrNotExist)) // Output: true false
+	fmt.Fprintln(w, errors.Unwrap(flattened))                                                 // Output: <nil>
+
+	/*
+	 * Wrapping makes the cause part of the function's API: callers may start to depend on it.
+	 * 1. Use %w when callers may need to handle the cause (e.g., a missing file)
+	 * 2. Use %v to hide an implementation detail that could change (e.g., which database driver failed)
+	 *
+	 * Comparing messages (err.Error() == "...") is never a substitute: messages change, and wrapping changes them. */
+	fmt.Fprintln(w, wrapped.Error() == "file does not exist") // Output: false
+}
@@ -0,0 +1,354 @@
+package exercise
+
+import (
+	"fmt"
+	"go/ast"
+	"go/scanner"
+	"go/token"
+	"strconv"
+	"strings"
+)
+
+/*
+ * A Check is one hidden rule a submission must follow.
+ * It returns nil when the rule is followed,
+ * or an error whose message tells the learner what is missing. */
+type Check func(s *Submission) error
+
+/*
+ * OutputIs checks that the program printed exactly want.
+ * Trailing spaces and newlines are ignored, like in Go Example tests. */
+func OutputIs(want string) Check {
+	return func(s *Submission) error {
+		got := trimLines(s.Output)
+		if got != trimLines(want) {
+			return fmt.Errorf("The program should print:\n%s\nbut it printed:\n%s", indent(want), indent(got))
+		}
+		return nil
+	}
+}
+
+// OutputContains checks that every given piece of text appears in the output.
+func OutputContains(parts ...string) Check {
+	return func(s *Submission) error {
+		for _, part := range parts {
+			if !strings.Contains(s.Output, part) {
+				return fmt.Errorf("The output should contain %q.", part)
+			}
+		}
+		return nil
+	}
+}
+
+/*
+ * VarDecls checks the variables declared with the var keyword inside functions:
+ * at least total of them, and at least inferred of them without an explicit type. */
+func VarDecls(total, inferred int) Check {
+	return func(s *Submission) error {
+		names, withoutType := 0, 0
+
+		inFuncs(s.File, func(n ast.Node) {
+			decl, ok := n.(*ast.GenDecl)
+			if !ok || decl.Tok != token.VAR {
+				return
+			}
+
+			for _, spec := range decl.Specs {
+				vs := spec.(*ast.ValueSpec)
+				names += len(vs.Names)
+				if vs.Type == nil {
+					withoutType += len(vs.Names)
+				}
+			}
+		})
+
+		if names < total {
+			return fmt.Errorf("Declare at least %d variables with var (found %d).", total, names)
+		}
+
+		if withoutType < inferred {
+			return fmt.Errorf("Let Go infer the type of at least %d of the var variables, e.g., var age = 17 (found %d).", inferred, withoutType)
+		}
+
+		return nil
+	}
+}
+
+// ShortVarDecls checks that at least n variables are declared with := inside functions.
+func ShortVarDecls(n int) Check {
+	return func(s *Submission) error {
+		found := 0
+
+		inFuncs(s.File, func(node ast.Node) {
+			if assign, ok := node.(*ast.AssignStmt); ok && assign.Tok == token.DEFINE {
+				found += len(assign.Lhs)
+			}
+		})
+
+		if found < n {
+			return fmt.Errorf("Declare at least %d variables with := (found %d).", n, found)
+		}
+		return nil
+	}
+}
+
+// ConstDecls checks that at least n constants are declared, anywhere in the file.
+func ConstDecls(n int) Check {
+	return func(s *Submission) error {
+		found := 0
+
+		ast.Inspect(s.File, func(node ast.Node) bool {
+			if decl, ok := node.(*ast.GenDecl); ok && decl.Tok == token.CONST {
+				for _, spec := range decl.Specs {
+					found += len(spec.(*ast.ValueSpec).Names)
+				}
+			}
+			return true
+		})
+
+		if found < n {
+			return fmt.Errorf("Declare at least %d constants with const (found %d).", n, found)
+		}
+		return nil
+	}
+}
+
+/*
+ * Calls checks that the function is called at least n times.
+ * The name is written like in the code (e.g., fmt.Printf or len). */
+func Calls(name string, n int) Check {
+	return func(s *Submission) error {
+		if found := countCalls(s.File, name); found < n {
+			return fmt.Errorf("Call %s at least %d time(s) (found %d).", name, n, found)
+		}
+		return nil
+	}
+}
+
+// NoCalls checks that none of the functions are called.
+func NoCalls(names ...string) Check {
+	return func(s *Submission) error {
+		for _, name := range names {
+			if countCalls(s.File, name) > 0 {
+				return fmt.Errorf("Do not call %s in this exercise.", name)
+			}
+		}
+		return nil
+	}
+}
+
+/*
+ * UsesVerb checks that a string literal in the code contains the formatting verb
+ * (e.g., %b or %#x), which in these exercises means it is passed to Printf or Sprintf. */
+func UsesVerb(verbs ...string) Check {
+	return func(s *Submission) error {
+		var literals []string
+
+		ast.Inspect(s.File, func(node ast.Node) bool {
+			if lit, ok := node.(*ast.BasicLit); ok && lit.Kind == token.STRING {
+				if value, err := strconv.Unquote(lit.Value); err == nil {
+					literals = append(literals, value)
+				}
+			}
+			return true
+		})
+
+		for _, verb := range verbs {
+			found := false
+			for _, lit := range literals {
+				if strings.Contains(lit, verb) {
+					found = true
+					break
+				}
+			}
+
+			if !found {
+				return fmt.Errorf("Use the %s formatting verb.", verb)
+			}
+		}
+
+		return nil
+	}
+}
+
+/*
+ * UsesKeyword checks that the code uses every given keyword (e.g., defer or fallthrough).
+ * Keywords inside comments and strings do not count. */
+func UsesKeyword(keywords ...string) Check {
+	return func(s *Submission) error {
+		used := map[string]bool{}
+
+		var sc scanner.Scanner
+		sc.Init(token.NewFileSet().AddFile("", -1, len(s.Source)), s.Source, nil, 0)
+		for {
+			_, tok, _ := sc.Scan()
+			if tok == token.EOF {
+				break
+			}
+			if tok.IsKeyword() {
+				used[tok.String()] = true
+			}
+		}
+
+		for _, keyword := range keywords {
+			if !used[keyword] {
+				return fmt.Errorf("Use the %s keyword.", keyword)
+			}
+		}
+
+		return nil
+	}
+}
+
+/*
+ * WrapsErrors checks that fmt.Errorf wraps the errors it gets with %w, so that errors.Is and errors.As
+ * can still find them. Without type information, an argument counts as an error when its name
+ * is err or starts with err or Err (e.g., errNotFound, ErrNotFound). */
+func WrapsErrors() Check {
+	return func(s *Submission) error {
+		wraps := 0
+
+		var missing string
+		ast.Inspect(s.File, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok || len(call.Args) == 0 || !isCallTo(call, "fmt.Errorf") {
+				return true
+			}
+
+			lit, ok := call.Args[0].(*ast.BasicLit)
+			if !ok || lit.Kind != token.STRING {
+				return true
+			}
+			format, err := strconv.Unquote(lit.Value)
+			if err != nil {
+				return true
+			}
+
+			errs := 0
+			for _, arg := range call.Args[1:] {
+				if id, ok := arg.(*ast.Ident); ok && (strings.HasPrefix(id.Name, "err") || strings.HasPrefix(id.Name, "Err")) {
+					errs++
+				}
+			}
+
+			verbs := strings.Count(format, "%w")
+			wraps += verbs
+			if verbs < errs && missing == "" {
+				missing = format
+			}
+			return true
+		})
+
+		if missing != "" {
+			return fmt.Errorf("Wrap the error with %%w, not %%v, in fmt.Errorf(%q, ...), so callers can still find it with errors.Is and errors.As.", missing)
+		}
+
+		if wraps == 0 {
+			return fmt.Errorf("Wrap an error with the %%w verb of fmt.Errorf.")
+		}
+		return nil
+	}
+}
+
+/*
+ * HasComment checks that the file has a comment of the given style
+ * ("//" for single-line or "/*" for multi-line) apart from the starter's own comments,
+ * which are recognised by the words they contain. */
+func HasComment(style string, ignore ...string) Check {
+	return func(s *Submission) error {
+		for _, group := range s.File.Comments {
+		next:
+			for _, c := range group.List {
+				if !strings.HasPrefix(c.Text, style) || strings.HasPrefix(c.Text, "//go:") {
+					continue
+				}
+
+				for _, text := range ignore {
+					if strings.Contains(c.Text, text) {
+						continue next
+					}
+				}
+
+				return nil
+			}
+		}
+
+		if style == "//" {
+			return fmt.Errorf("Write a single-line comment of your own (// ...).")
+		}
+		return fmt.Errorf("Write a multi-line comment of your own (/* ... */).")
+	}
+}
+
+// ExportedPackageVar checks that a variable with an exported name is declared at package level.
+func ExportedPackageVar() Check {
+	return func(s *Submission) error {
+		for _, decl := range s.File.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.VAR {
+				continue
+			}
+
+			for _, spec := range gen.Specs {
+				for _, name := range spec.(*ast.ValueSpec).Names {
+					if name.IsExported() {
+						return nil
+					}
+				}
+			}
+		}
+
+		return fmt.Errorf("Declare a package-level variable whose name starts with an uppercase letter.")
+	}
+}
+
+// inFuncs calls fn for every node inside a function body.
+func inFuncs(file *ast.File, fn func(ast.Node)) {
+	for _, decl := range file.Decls {
+		if f, ok := decl.(*ast.FuncDecl); ok && f.Body != nil {
+			ast.Inspect(f.Body, func(n ast.Node) bool {
+				if n != nil {
+					fn(n)
+				}
+				return true
+			})
+		}
+	}
+}
+
+func countCalls(file *ast.File, name string) int {
+	found := 0
+
+	ast.Inspect(file, func(n ast.Node) bool {
+		if call, ok := n.(*ast.CallExpr); ok && isCallTo(call, name) {
+			found++
+		}
+		return true
+	})
+
+	return found
+}
+
+// isCallTo reports whether the call is to the function written name in the code (e.g., fmt.Errorf or len).
+func isCallTo(call *ast.CallExpr, name string) bool {
+	switch fn := call.Fun.(type) {
+	case *ast.Ident:
+		return fn.Name == name
+	case *ast.SelectorExpr:
+		x, ok := fn.X.(*ast.Ident)
+		return ok && x.Name+"."+fn.Sel.Name == name
+	}
+	return false
+}
+
+func trimLines(s string) string {
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t\r")
+	}
+	return strings.TrimRight(strings.Join(lines, "\n"), "\n")
+}
+
+func indent(s string) string {
+	return "    " + strings.ReplaceAll(trimLines(s), "\n", "\n    ")
+}
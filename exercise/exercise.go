@@ -0,0 +1,97 @@
+/*
+ * The exercise package gives every lesson something for the learner to do.
+ *
+ * An exercise has:
+ * 1. A prompt that says what to write
+ * 2. A starter file the learner edits (a complete main package)
+ * 3. Hidden checks that look at the learner's code and at what it prints
+ *
+ * Grading compiles and runs the learner's file in a temporary module,
+ * so a submission can never break the tutorial itself.
+ *
+ * Lesson packages register their exercises from init(),
+ * the same way they register their lessons. */
+package exercise
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+/*
+ * An Exercise is one task attached to a lesson:
+ * 1. ID     : Unique identifier in the form lesson-id/name (e.g., identifier/var/three-variables)
+ * 2. Lesson : ID of the lesson the exercise belongs to
+ * 3. Title  : Short description shown by tools
+ * 4. Prompt : What the learner has to do
+ * 5. Starter: Content of the starter main.go
+ * 6. Checks : Hidden checks run against the submission, in order
+ * 7. Race   : Build the submission with the race detector, so a data race fails it */
+type Exercise struct {
+	ID      string
+	Lesson  string
+	Title   string
+	Prompt  string
+	Starter string
+	Checks  []Check
+	Race    bool
+}
+
+var registry = map[string]Exercise{}
+
+/*
+ * Register adds an exercise to the registry.
+ * Like lesson.Register, it panics on an empty or duplicate ID,
+ * and also when the ID does not start with the lesson ID. */
+func Register(e Exercise) {
+	if e.ID == "" || e.Lesson == "" {
+		panic("exercise: Register called with an empty ID or lesson")
+	}
+
+	if !strings.HasPrefix(e.ID, e.Lesson+"/") {
+		panic(fmt.Sprintf("exercise: ID %q must start with its lesson %q", e.ID, e.Lesson))
+	}
+
+	if len(e.Checks) == 0 {
+		panic(fmt.Sprintf("exercise: Register called without checks for %q", e.ID))
+	}
+
+	if _, ok := registry[e.ID]; ok {
+		panic(fmt.Sprintf("exercise: Register called twice for %q", e.ID))
+	}
+
+	registry[e.ID] = e
+}
+
+// All returns every registered exercise sorted by ID.
+func All() []Exercise {
+	exercises := make([]Exercise, 0, len(registry))
+	for _, e := range registry {
+		exercises = append(exercises, e)
+	}
+
+	sort.Slice(exercises, func(i, j int) bool {
+		return exercises[i].ID < exercises[j].ID
+	})
+
+	return exercises
+}
+
+// Lookup returns the exercise registered with the given ID.
+func Lookup(id string) (Exercise, bool) {
+	e, ok := registry[id]
+	return e, ok
+}
+
+// ForLesson returns the exercises of one lesson sorted by ID.
+func ForLesson(lessonID string) []Exercise {
+	var exercises []Exercise
+	for _, e := range All() {
+		if e.Lesson == lessonID {
+			exercises = append(exercises, e)
+		}
+	}
+
+	return exercises
+}
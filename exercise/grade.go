@@ -0,0 +1,168 @@
+package exercise
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/scanner"
+	"go/token"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const (
+	// Go version written to the go.mod of the temporary module (1.22 is the first with per-iteration loop variables).
+	MODULE_GO_VERSION = "1.22"
+
+	BUILD_TIMEOUT = 60 * time.Second
+	RUN_TIMEOUT   = 10 * time.Second
+)
+
+/*
+ * A Submission is the learner's code after it was compiled and run:
+ * 1. Source: Content of the submitted file
+ * 2. Fset  : File set the file was parsed with (for positions in feedback)
+ * 3. File  : Parsed file, for checks that look at the code
+ * 4. Output: What the program printed to stdout
+ * 5. Stderr: What the program printed to stderr */
+type Submission struct {
+	Source []byte
+	Fset   *token.FileSet
+	File   *ast.File
+	Output string
+	Stderr string
+}
+
+/*
+ * A Result is the feedback for one submission.
+ * Passed is true only if the code compiled, ran, and every check passed.
+ * Feedback holds one message per failed step or check. */
+type Result struct {
+	Exercise Exercise
+	Passed   bool
+	Feedback []string
+	Output   string
+}
+
+/*
+ * Grade compiles and runs src as the main package of a temporary module
+ * (with the race detector when the exercise sets Race), then runs the hidden checks of the exercise against it.
+ *
+ * A submission that does not compile, crashes, or takes too long is a failed result, not an error.
+ * Errors are kept for problems of the grader itself (e.g., the go tool is missing). */
+func Grade(e Exercise, src []byte) (*Result, error) {
+	result := &Result{Exercise: e}
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "main.go", src, parser.ParseComments)
+	if err != nil {
+		// Show every syntax error, not only the first one.
+		var list scanner.ErrorList
+		if errors.As(err, &list) {
+			var lines []string
+			for _, e := range list {
+				lines = append(lines, e.Error())
+			}
+			err = errors.New(strings.Join(lines, "\n"))
+		}
+
+		result.Feedback = append(result.Feedback, "The code does not compile:\n"+err.Error())
+		return result, nil
+	}
+
+	if file.Name.Name != "main" {
+		result.Feedback = append(result.Feedback, fmt.Sprintf("The file must be in package main, not package %s.", file.Name.Name))
+		return result, nil
+	}
+
+	dir, err := os.MkdirTemp("", "exercise")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	goMod := fmt.Sprintf("module exercise\n\ngo %s\n", MODULE_GO_VERSION)
+	if err := os.WriteFile(filepath.Join(dir, "go.mod"), []byte(goMod), 0o644); err != nil {
+		return nil, err
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "main.go"), src, 0o644); err != nil {
+		return nil, err
+	}
+
+	binary := filepath.Join(dir, "exercise")
+	buildArgs := []string{"build", "-o", binary}
+	if e.Race {
+		buildArgs = append(buildArgs, "-race")
+	}
+
+	buildOut, err := runIn(dir, BUILD_TIMEOUT, "go", append(buildArgs, ".")...)
+	if err != nil {
+		var exitErr *exec.ExitError
+		if !errors.As(err, &exitErr) {
+			return nil, fmt.Errorf("running the go tool: %w", err)
+		}
+
+		result.Feedback = append(result.Feedback, "The code does not compile:\n"+buildOut)
+		return result, nil
+	}
+
+	var stdout, stderr bytes.Buffer
+	ctx, cancel := context.WithTimeout(context.Background(), RUN_TIMEOUT)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, binary)
+	cmd.Dir = dir
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	runErr := cmd.Run()
+
+	result.Output = stdout.String()
+
+	switch {
+	case ctx.Err() != nil:
+		result.Feedback = append(result.Feedback, fmt.Sprintf("The program did not finish within %v.", RUN_TIMEOUT))
+		return result, nil
+	case e.Race && strings.Contains(stderr.String(), "WARNING: DATA RACE"):
+		result.Feedback = append(result.Feedback, "The race detector found a data race:\n"+stderr.String())
+		return result, nil
+	case runErr != nil:
+		result.Feedback = append(result.Feedback, fmt.Sprintf("The program failed (%v):\n%s", runErr, stderr.String()))
+		return result, nil
+	}
+
+	submission := &Submission{
+		Source: src,
+		Fset:   fset,
+		File:   file,
+		Output: stdout.String(),
+		Stderr: stderr.String(),
+	}
+
+	for _, check := range e.Checks {
+		if err := check(submission); err != nil {
+			result.Feedback = append(result.Feedback, err.Error())
+		}
+	}
+
+	result.Passed = len(result.Feedback) == 0
+	return result, nil
+}
+
+func runIn(dir string, timeout time.Duration, name string, args ...string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GOWORK=off", "GOFLAGS=-mod=mod")
+
+	out, err := cmd.CombinedOutput()
+	return string(out), err
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/fajarstrtn/golang-tutorial/exercise"
+)
+
+// SOLUTIONS_DIR holds a reference solution for every exercise, at <id>/main.go like in the workspace.
+const SOLUTIONS_DIR = "testdata/solutions"
+
+/*
+ * Every exercise must be solvable: its reference solution passes every check,
+ * and its starter file fails at least one of them (otherwise there is nothing to do).
+ * This imports every lesson package through main.go, so every registered exercise is graded. */
+func TestExercisesCanBeSolved(t *testing.T) {
+	if testing.Short() {
+		t.Skip("compiles and runs two programs per exercise")
+	}
+
+	for _, e := range exercise.All() {
+		t.Run(e.ID, func(t *testing.T) {
+			t.Parallel()
+
+			solution, err := os.ReadFile(filepath.Join(SOLUTIONS_DIR, e.ID, "main.go"))
+			if err != nil {
+				t.Fatalf("no reference solution: %v", err)
+			}
+
+			result, err := exercise.Grade(e, solution)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !result.Passed {
+				t.Errorf("the reference solution fails:\n%s", strings.Join(result.Feedback, "\n"))
+			}
+
+			result, err = exercise.Grade(e, []byte(e.Starter))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if result.Passed || len(result.Feedback) == 0 {
+				t.Errorf("the starter passes without any change")
+			}
+		})
+	}
+}
+
+// A solution without an exercise is left over from a renamed or removed exercise.
+func TestEverySolutionHasAnExercise(t *testing.T) {
+	err := filepath.WalkDir(SOLUTIONS_DIR, func(path string, d os.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+
+		id := filepath.ToSlash(filepath.Dir(strings.TrimPrefix(path, SOLUTIONS_DIR+string(filepath.Separator))))
+		if _, ok := exercise.Lookup(id); !ok || d.Name() != "main.go" {
+			t.Errorf("%s is not the solution of an exercise", path)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
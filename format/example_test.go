@@ -0,0 +1,99 @@
+// Code generated by "go run . examples"; DO NOT EDIT.
+
+package format_test
+
+import (
+	"os"
+
+	"github.com/fajarstrtn/golang-tutorial/format"
+	"github.com/fajarstrtn/golang-tutorial/lesson"
+)
+
+// ExamplePrintSomething runs the format/print lesson.
+func ExamplePrintSomething() {
+	format.PrintSomething(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// Hello, John Doe!Welcome to Old Trafford!Hello, John Doe!Welcome to Old Trafford!
+	// Hello, John Doe!
+	// Welcome to Old Trafford!
+	// Hello, John Doe!
+	// Welcome to Old Trafford!Hello, John Doe! Welcome to Old Trafford!
+	// 10 20
+}
+
+// ExamplePrintSomethingWithFormattingVerbs runs the format/verbs lesson.
+func ExamplePrintSomethingWithFormattingVerbs() {
+	format.PrintSomethingWithFormattingVerbs(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// 10
+	// 10
+	// int
+	// 10%
+	// Hello World
+	// "Hello World"
+	// string
+	// {John Doe 20}
+	// format.User{Name:"John Doe", Age:20}
+	// {Name:John Doe Age:20}
+	// [1 2 3]
+	// map[a:1]
+	// %b: |1010|
+	// %d: |10|
+	// %+d: |+10|
+	// %o: |12|
+	// %O: |0o12|
+	// %x: |a|
+	// %X: |A|
+	// %#x: |0xa|
+	// %8d: |      10|
+	// %-8d: |10      |
+	// %08d: |00000010|
+	// %s: |Gopher|
+	// %q: |"Gopher"|
+	// %8s: |  Gopher|
+	// %-8s: |Gopher  |
+	// %.2s: |Go|
+	// %x: |476f70686572|
+	// %X: |476F70686572|
+	// % x: |47 6f 70 68 65 72|
+	// % X: |47 6F 70 68 65 72|
+	// true
+	// %v: true
+	// %T: bool
+	// %t: true
+	// 17 other verbs print an error for true
+	// %e: |3.141000e+00|
+	// %f: |3.141000|
+	// %.2f: |3.14|
+	// %8.2f: |    3.14|
+	// %g: |3.141|
+	// XYZ
+	// X Y
+	// AB 10
+}
+
+// ExamplePrintSomethingWithLog runs the format/log lesson.
+func ExamplePrintSomethingWithLog() {
+	format.PrintSomethingWithLog(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// 2026/02/10 16:30:31 User has been created
+}
+
+// ExamplePrintSomethingWithNewLine runs the format/println lesson.
+func ExamplePrintSomethingWithNewLine() {
+	format.PrintSomethingWithNewLine(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// Hello, John Doe! Welcome to Old Trafford!
+	// Hello World
+	// 10 Hello true
+}
+
+// ExamplePrintSomethingWithSprintf runs the format/sprintf lesson.
+func ExamplePrintSomethingWithSprintf() {
+	format.PrintSomethingWithSprintf(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// Hello, John Doe! You can call me John
+	// something went wrong
+	// |  John Doe|
+	// |John Doe  |
+}
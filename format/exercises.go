@@ -0,0 +1,106 @@
+package format
+
+import "github.com/fajarstrtn/golang-tutorial/exercise"
+
+func init() {
+	exercise.Register(exercise.Exercise{
+		ID:     "format/print/manage-spaces",
+		Lesson: "format/print",
+		Title:  "Manage spaces and newlines with fmt.Print",
+		Prompt: "Using only fmt.Print (no Println or Printf), print the two messages on one line separated by a space, followed by a newline: Hello, John Doe! Welcome to Old Trafford!",
+		Starter: `package main
+
+import "fmt"
+
+func main() {
+	message1, message2 := "Hello, John Doe!", "Welcome to Old Trafford!"
+
+	fmt.Print(message1)
+	fmt.Print(message2)
+}
+`,
+		Checks: []exercise.Check{
+			exercise.Calls("fmt.Print", 1),
+			exercise.NoCalls("fmt.Println", "fmt.Printf"),
+			exercise.OutputIs("Hello, John Doe! Welcome to Old Trafford!\n"),
+		},
+	})
+
+	exercise.Register(exercise.Exercise{
+		ID:     "format/println/one-call",
+		Lesson: "format/println",
+		Title:  "Print several values with one fmt.Println",
+		Prompt: "Print the string Go, the number 100 and the boolean true with a single fmt.Println call. The output must be: Go 100 true",
+		Starter: `package main
+
+import "fmt"
+
+func main() {
+	fmt.Println("TODO")
+}
+`,
+		Checks: []exercise.Check{
+			exercise.Calls("fmt.Println", 1),
+			exercise.OutputIs("Go 100 true"),
+		},
+	})
+
+	exercise.Register(exercise.Exercise{
+		ID:     "format/verbs/one-number-three-ways",
+		Lesson: "format/verbs",
+		Title:  "Print one number in binary, hex and padded",
+		Prompt: "Print x := 255 three times with fmt.Printf: in base 2, in base 16 with a leading 0x, and right justified in a width of 6 between | characters. The output must be:\n11111111\n0xff\n|   255|",
+		Starter: `package main
+
+import "fmt"
+
+func main() {
+	x := 255
+	fmt.Printf("%v\n", x)
+}
+`,
+		Checks: []exercise.Check{
+			exercise.UsesVerb("%b", "%#x", "%6d"),
+			exercise.OutputIs("11111111\n0xff\n|   255|"),
+		},
+	})
+
+	exercise.Register(exercise.Exercise{
+		ID:     "format/sprintf/build-a-label",
+		Lesson: "format/sprintf",
+		Title:  "Build a string with fmt.Sprintf",
+		Prompt: "Use fmt.Sprintf to build the string \"John Doe (20)\" from name and age, store it in a variable, and print the variable. The output must be: John Doe (20)",
+		Starter: `package main
+
+import "fmt"
+
+func main() {
+	name, age := "John Doe", 20
+	fmt.Println(name, age)
+}
+`,
+		Checks: []exercise.Check{
+			exercise.Calls("fmt.Sprintf", 1),
+			exercise.OutputIs("John Doe (20)"),
+		},
+	})
+
+	exercise.Register(exercise.Exercise{
+		ID:     "format/log/own-logger",
+		Lesson: "format/log",
+		Title:  "Create your own logger",
+		Prompt: "Create a logger with log.New that writes to os.Stdout with the prefix \"app: \" and no flags (so no timestamp), and log the message started. The output must be: app: started",
+		Starter: `package main
+
+import "log"
+
+func main() {
+	log.Println("started")
+}
+`,
+		Checks: []exercise.Check{
+			exercise.Calls("log.New", 1),
+			exercise.OutputIs("app: started"),
+		},
+	})
+}
@@ -2,7 +2,8 @@ package format
 
 import (
 	"fmt"
-	"log"
+
+	"github.com/fajarstrtn/golang-tutorial/lesson"
 )
 
 var (
@@ -10,7 +11,7 @@ var (
 	message2 string = "Welcome to Old Trafford!"
 )
 
-func PrintSomething() {
+func PrintSomething(env *lesson.Env) {
 	/*
 	 * The Print() function prints its arguments with their default format.
 	 * It prints without a newline and doesn't add space automatically.
@@ -18,33 +19,38 @@ func PrintSomething() {
 	 * When to use:
 	 * 1. You want full control over spacing and line breaks
 	 * 2. Low-level or very simple output */
-	fmt.Print(message1)
-	fmt.Print(message2)
+	fmt.Fprint(env.Out, message1) // Output: Hello, John Doe!
+	fmt.Fprint(env.Out, message2) // Output: Welcome to Old Trafford!
 
 	// You have to manage spaces and newlines yourself.
-	fmt.Print(message1)
-	fmt.Print(message2 + "\n")
+	fmt.Fprint(env.Out, message1)      // Output: Hello, John Doe!
+	fmt.Fprint(env.Out, message2+"\n") // Output: Welcome to Old Trafford!
 
 	/*
 	 * If we want to print the arguments in new lines,
 	 * we need to use \n (it creates new lines). */
-	fmt.Print(message1, "\n")
-	fmt.Print(message2, "\n")
+	fmt.Fprint(env.Out, message1, "\n") // Output: Hello, John Doe!
+	fmt.Fprint(env.Out, message2, "\n") // Output: Welcome to Old Trafford!
 
-	// It is also possible to only use one Print() for printing multiple variables.
-	fmt.Print(message1, "\n", message2)
+	/*
+	 * It is also possible to only use one Print() for printing multiple variables.
+	 *
+	 * Output:
+	 * Hello, John Doe!
+	 * Welcome to Old Trafford! */
+	fmt.Fprint(env.Out, message1, "\n", message2)
 
 	// If we want to add a space between string arguments, we need to use " ".
-	fmt.Print(message1, " ", message2, "\n")
+	fmt.Fprint(env.Out, message1, " ", message2, "\n") // Output: Hello, John Doe! Welcome to Old Trafford!
 
 	x, y := 10, 20
 
 	// Print() inserts a space between the arguments if neither are strings.
-	fmt.Print(x, y)
-	fmt.Print("\n")
+	fmt.Fprint(env.Out, x, y) // Output: 10 20
+	fmt.Fprint(env.Out, "\n") // Output:
 }
 
-func PrintSomethingWithNewLine() {
+func PrintSomethingWithNewLine(env *lesson.Env) {
 	/*
 	 * fmt.Println() prints with a newline at the end
 	 * and automatically adds spaces between arguments.
@@ -62,12 +68,12 @@ func PrintSomethingWithNewLine() {
 	 * 2. Applies default formatting
 	 * 3. Converts to string
 	 * 4. Writes to stdout */
-	fmt.Println(message1, message2)
-	fmt.Println("Hello", "World")
-	fmt.Println(10, "Hello", true)
+	fmt.Fprintln(env.Out, message1, message2) // Output: Hello, John Doe! Welcome to Old Trafford!
+	fmt.Fprintln(env.Out, "Hello", "World")   // Output: Hello World
+	fmt.Fprintln(env.Out, 10, "Hello", true)  // Output: 10 Hello true
 }
 
-func PrintSomethingWithFormattingVerbs() {
+func PrintSomethingWithFormattingVerbs(env *lesson.Env) {
 	x, y, text, t := 10, 3.141, "Hello World", true
 
 	/*
@@ -91,13 +97,13 @@ func PrintSomethingWithFormattingVerbs() {
 	 * The + or # symbol is a flag that modifies the behavior of the general %v verb.
 	 *
 	 * It prints using a format string. */
-	fmt.Printf("%v\n", x)     // Output: 10
-	fmt.Printf("%#v\n", x)    // Output: 10
-	fmt.Printf("%T\n", x)     // Output: int
-	fmt.Printf("%v%%\n", x)   // Output: 10%
-	fmt.Printf("%v\n", text)  // Output: Hello World
-	fmt.Printf("%#v\n", text) // Output: "Hello World"
-	fmt.Printf("%T\n", text)  // Output: string
+	fmt.Fprintf(env.Out, "%v\n", x)     // Output: 10
+	fmt.Fprintf(env.Out, "%#v\n", x)    // Output: 10
+	fmt.Fprintf(env.Out, "%T\n", x)     // Output: int
+	fmt.Fprintf(env.Out, "%v%%\n", x)   // Output: 10%
+	fmt.Fprintf(env.Out, "%v\n", text)  // Output: Hello World
+	fmt.Fprintf(env.Out, "%#v\n", text) // Output: "Hello World"
+	fmt.Fprintf(env.Out, "%T\n", text)  // Output: string
 
 	type User struct {
 		Name string
@@ -107,10 +113,10 @@ func PrintSomethingWithFormattingVerbs() {
 	user := User{"John Doe", 20}
 
 	// Using %v is the same as "use Go's default rule".
-	fmt.Printf("%v\n", user)
+	fmt.Fprintf(env.Out, "%v\n", user) // Output: {John Doe 20}
 
 	// It's Go-syntax representation (great for debugging).
-	fmt.Printf("%#v\n", user)
+	fmt.Fprintf(env.Out, "%#v\n", user) // Output: format.User{Name:"John Doe", Age:20}
 
 	/*
 	 * The primary use of %+v is to display the field names
@@ -120,14 +126,16 @@ func PrintSomethingWithFormattingVerbs() {
 	 * the + flag generally has no effect,
 	 * and the output is the same as %v.
 	 *
-	 * Use %+v while learning structs. */
-	fmt.Printf("%+v\n", user)
+	 * Use %+v while learning structs
+	 * (the struct_types lessons show every verb on pointers, anonymous and embedded structs,
+	 * and the interface_types/formatting lesson how a type can change what they print). */
+	fmt.Fprintf(env.Out, "%+v\n", user) // Output: {Name:John Doe Age:20}
 
 	/*
 	 * It works with any type and makes %v perfect for debugging.
 	 * Works automatically. No loops needed. */
-	fmt.Printf("%v\n", []int{1, 2, 3})         // Output: [1 2 3]
-	fmt.Printf("%v\n", map[string]int{"a": 1}) // Output: map[a:1]
+	fmt.Fprintf(env.Out, "%v\n", []int{1, 2, 3})         // Output: [1 2 3]
+	fmt.Fprintf(env.Out, "%v\n", map[string]int{"a": 1}) // Output: map[a:1]
 
 	/*
 	 * The following verbs can be used with the integer data type:
@@ -139,20 +147,26 @@ func PrintSomethingWithFormattingVerbs() {
 	 * 6. %x   : Base 16 lowercase
 	 * 7. %X   : Base 16 uppercase
 	 * 8. %#x  : Base 16 with leading 0x
-	 * 9. %4d  : Pad with spaces (width 4, right justified)
-	 * 10. %-4d: Pad with spaces (width 4, left justified)
-	 * 11. %04d: Pad with zeroes (width 4) */
-	fmt.Printf("%b\n", x)   // Output: 1010
-	fmt.Printf("%d\n", x)   // Output: 10
-	fmt.Printf("%+d\n", x)  // Output: +10
-	fmt.Printf("%o\n", x)   // Output: 12
-	fmt.Printf("%O\n", x)   // Output: 0o12
-	fmt.Printf("%x\n", x)   // Output: a
-	fmt.Printf("%X\n", x)   // Output: A
-	fmt.Printf("%#X\n", x)  // Output: 0XA
-	fmt.Printf("%5d\n", x)  // Output:    10
-	fmt.Printf("%-5d\n", x) // Output: 10
-	fmt.Printf("%05d\n", x) // Output: 00010
+	 * 9. %8d  : Pad with spaces (width 8, right justified)
+	 * 10. %-8d: Pad with spaces (width 8, left justified)
+	 * 11. %08d: Pad with zeroes (width 8)
+	 *
+	 * The tables of this lesson are printed by ExploreVerbs, which formats the value with fmt itself.
+	 * The | characters around every output only make the padding visible.
+	 *
+	 * Output:
+	 * %b: |1010|
+	 * %d: |10|
+	 * %+d: |+10|
+	 * %o: |12|
+	 * %O: |0o12|
+	 * %x: |a|
+	 * %X: |A|
+	 * %#x: |0xa|
+	 * %8d: |      10|
+	 * %-8d: |10      |
+	 * %08d: |00000010| */
+	printVerbs(env.Out, x, "%b", "%d", "%+d", "%o", "%O", "%x", "%X", "%#x", "%8d", "%-8d", "%08d")
 
 	/*
 	 * The following verbs can be used with the string data type:
@@ -160,36 +174,68 @@ func PrintSomethingWithFormattingVerbs() {
 	 * 2. %q  : Prints the value as a double-quoted string
 	 * 3. %8s : Prints the value as plain string (width 8, right justified)
 	 * 4. %-8s: Prints the value as plain string (width 8, left justified)
-	 * 5. %x  : Prints the value as hex dump of byte values with lowercase
-	 * 6. %X  : Prints the value as hex dump of byte values with uppercase
-	 * 7. % x : Prints the value as hex dump with spaces with lowercase
-	 * 8. % X : Prints the value as hex dump with spaces with uppercase */
-	fmt.Printf("%s\n", text)    // Output: Hello World
-	fmt.Printf("%q\n", text)    // Output: "Hello World"
-	fmt.Printf("%15s\n", text)  // Output:     Hello World
-	fmt.Printf("%-15s\n", text) // Output: Hello World
-	fmt.Printf("%x\n", text)    // Output: 48656c6c6f20576f726c64
-	fmt.Printf("%X\n", text)    // Output: 48656C6C6F20576F726C64
-	fmt.Printf("% x\n", text)   // Output: 48 65 6c 6c 6f 20 57 6f 72 6c 64
-	fmt.Printf("% X\n", text)   // Output: 48 65 6C 6C 6F 20 57 6F 72 6C 64
+	 * 5. %.2s: Prints at most 2 characters of the value
+	 * 6. %x  : Prints the value as hex dump of byte values with lowercase
+	 * 7. %X  : Prints the value as hex dump of byte values with uppercase
+	 * 8. % x : Prints the value as hex dump with spaces with lowercase
+	 * 9. % X : Prints the value as hex dump with spaces with uppercase
+	 *
+	 * A width is only a minimum: text (Hello World) is longer than 8 characters, so this table uses a shorter string.
+	 *
+	 * Output:
+	 * %s: |Gopher|
+	 * %q: |"Gopher"|
+	 * %8s: |  Gopher|
+	 * %-8s: |Gopher  |
+	 * %.2s: |Go|
+	 * %x: |476f70686572|
+	 * %X: |476F70686572|
+	 * % x: |47 6f 70 68 65 72|
+	 * % X: |47 6F 70 68 65 72| */
+	printVerbs(env.Out, "Gopher", "%s", "%q", "%8s", "%-8s", "%.2s", "%x", "%X", "% x", "% X")
 
 	/*
 	 * The following verb can be used with the boolean data type:
 	 * 1. %t: Value of the boolean operator in true or false format (same as using %v) */
-	fmt.Printf("%t\n", t) // Output: true
+	fmt.Fprintf(env.Out, "%t\n", t) // Output: true
+
+	bad := 0
+
+	/*
+	 * These tables are a selection: `go run . verbs <literal>` prints every verb, flag, width and precision
+	 * for any literal (e.g., go run . verbs 42 '"héllo"' 3.141).
+	 *
+	 * A verb that does not apply prints an error such as %!d(bool=true) instead of failing.
+	 *
+	 * Output:
+	 * %v: true
+	 * %T: bool
+	 * %t: true */
+	for _, r := range ExploreVerbs(t) {
+		switch {
+		case r.Bad:
+			bad++
+		case len(r.Format) == 2:
+			fmt.Fprintf(env.Out, "%s: %s\n", r.Format, r.Output)
+		}
+	}
+	fmt.Fprintf(env.Out, "%d other verbs print an error for %v\n", bad, t) // Output: 17 other verbs print an error for true
 
 	/*
 	 * The following verbs can be used with the float data type:
 	 * 1. %e   : Scientific notation with 'e' as exponent
 	 * 2. %f   : Decimal point, no exponent
 	 * 3. %.2f : Default width, precision 2
-	 * 4. %6.2f: Width 6, precision 2
-	 * 5. %g   : Exponent as needed, only necessary digits */
-	fmt.Printf("%e\n", y)     // Output: 3.141000e+00
-	fmt.Printf("%f\n", y)     // Output: 3.141000
-	fmt.Printf("%.1f\n", y)   // Output: 3.1
-	fmt.Printf("%10.2f\n", y) // Output:       3.14
-	fmt.Printf("%g\n", y)     // Output: 3.141
+	 * 4. %8.2f: Width 8, precision 2
+	 * 5. %g   : Exponent as needed, only necessary digits
+	 *
+	 * Output:
+	 * %e: |3.141000e+00|
+	 * %f: |3.141000|
+	 * %.2f: |3.14|
+	 * %8.2f: |    3.14|
+	 * %g: |3.141| */
+	printVerbs(env.Out, y, "%e", "%f", "%.2f", "%8.2f", "%g")
 
 	/*
 	 * Think of fmt as Go's text formatting engine.
@@ -212,12 +258,12 @@ func PrintSomethingWithFormattingVerbs() {
 	 * 5. %T     : Prints type
 	 * 6. %+v    : Prints struct fields
 	 * 7. %#v    : Prints Go-syntax. */
-	fmt.Print("XYZ\n")              // Output: XYQ\n
-	fmt.Println("X", "Y")           // Output: X Y\n
-	fmt.Printf("%s %d\n", "AB", 10) // Output: AB 10\n
+	fmt.Fprint(env.Out, "XYZ\n")              // Output: XYZ
+	fmt.Fprintln(env.Out, "X", "Y")           // Output: X Y
+	fmt.Fprintf(env.Out, "%s %d\n", "AB", 10) // Output: AB 10
 }
 
-func PrintSomethingWithSprintf() {
+func PrintSomethingWithSprintf(env *lesson.Env) {
 	const fullName, nickName = "John Doe", "John"
 
 	/*
@@ -233,22 +279,29 @@ func PrintSomethingWithSprintf() {
 	 * 3. Building strings
 	 * 4. APIs */
 	message := fmt.Sprintf("Hello, %s! You can call me %s", fullName, nickName)
-	fmt.Println(message) // Output: Hello, John Doe! You can call me John
+	fmt.Fprintln(env.Out, message) // Output: Hello, John Doe! You can call me John
 
-	error := fmt.Sprintf("%s", "Something went wrong!")
-	fmt.Println(error) // Output: Something went wrong!
+	/*
+	 * fmt.Errorf formats like fmt.Sprintf, but returns an error instead of a string.
+	 * (Do not name the variable error: it would shadow the built-in error type.
+	 * Error messages start in lowercase and do not end with punctuation: see the error_handling lessons.) */
+	err := fmt.Errorf("%s went wrong", "something")
+	fmt.Fprintln(env.Out, err) // Output: something went wrong
 
 	// You can also print with width, alignment, and precision.
-	fmt.Printf("|%10s|\n", fullName)  // Output: |  John Doe|
-	fmt.Printf("|%-10s|\n", fullName) // Output: |John Doe  |
+	fmt.Fprintf(env.Out, "|%10s|\n", fullName)  // Output: |  John Doe|
+	fmt.Fprintf(env.Out, "|%-10s|\n", fullName) // Output: |John Doe  |
 }
 
-func PrintSomethingWithLog() {
+func PrintSomethingWithLog(env *lesson.Env) {
 	/*
 	* For real apps:
 	* 1. Adds timestamp
 	* 2. Writes to stderr
-	* 3. Better for production */
+	* 3. Better for production
+	*
+	* The lesson logs through env.Log, a *log.Logger that writes to stderr by default.
+	* log.Println(logMessage) would do the same through the global logger. */
 	logMessage := "User has been created"
-	log.Println(logMessage) // Output: 2026/02/10 16:30:31 User has been created
+	env.Log.Println(logMessage) // Output: 2026/02/10 16:30:31 User has been created
 }
@@ -0,0 +1,11 @@
+package format
+
+import "github.com/fajarstrtn/golang-tutorial/lesson"
+
+func init() {
+	lesson.Register(lesson.Lesson{ID: "format/print", Title: "fmt.Print", Topic: "format", Order: 400, Run: PrintSomething})
+	lesson.Register(lesson.Lesson{ID: "format/println", Title: "fmt.Println", Topic: "format", Order: 410, Run: PrintSomethingWithNewLine})
+	lesson.Register(lesson.Lesson{ID: "format/verbs", Title: "fmt.Printf and formatting verbs", Topic: "format", Order: 420, Run: PrintSomethingWithFormattingVerbs})
+	lesson.Register(lesson.Lesson{ID: "format/sprintf", Title: "fmt.Sprintf", Topic: "format", Order: 430, Run: PrintSomethingWithSprintf})
+	lesson.Register(lesson.Lesson{ID: "format/log", Title: "Logging with the log package", Topic: "format", Order: 440, Run: PrintSomethingWithLog})
+}
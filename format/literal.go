@@ -0,0 +1,508 @@
+package format
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"math"
+	"reflect"
+	"strconv"
+	"unicode"
+	"unicode/utf8"
+)
+
+/*
+ * ParseLiteral turns Go source text into the value it describes, so it can be formatted.
+ *
+ * It understands:
+ * 1. Basic literals    : 42, 0x2A, 3.141, 1e6, 2i, 'é', "héllo", `raw`
+ * 2. Predeclared values: true, false, nil
+ * 3. Signs             : -42, +3.141, ^0, !true
+ * 4. Conversions       : int8(42), float32(3.141), byte('a'), []byte("hi")
+ * 5. Composite literals: []int{1, 2}, [...]string{"a"}, map[string]int{"a": 1},
+ *    struct{ Name string; Age int }{"Ann", 20}, &struct{ X int }{1}
+ *
+ * Untyped constants get their default type, like in a := declaration
+ * (42 is an int, 3.141 a float64, 'é' a rune).
+ * Struct fields must be exported, because reflect cannot build structs with unexported fields.
+ *
+ * The value is built in memory, so arrays are limited to MAX_ARRAY_LEN elements and MAX_ARRAY_SIZE bytes.
+ * Anything else reflect refuses to build is returned as an error, never as a panic. */
+func ParseLiteral(src string) (value any, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			value, err = nil, fmt.Errorf("cannot build %s: %v", src, r)
+		}
+	}()
+
+	expr, err := parser.ParseExpr(src)
+	if err != nil {
+		return nil, fmt.Errorf("%s is not a Go expression: %w", src, err)
+	}
+
+	v, err := evalLiteral(expr, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return v.Interface(), nil
+}
+
+// The largest array ParseLiteral builds: [1<<40]int{} would need 8 TiB, and running out of memory cannot be recovered.
+const (
+	MAX_ARRAY_LEN  = 1 << 16
+	MAX_ARRAY_SIZE = 1 << 20
+)
+
+var anyType = reflect.TypeOf((*any)(nil)).Elem()
+
+var basicTypes = map[string]reflect.Type{
+	"bool":       reflect.TypeOf(false),
+	"string":     reflect.TypeOf(""),
+	"int":        reflect.TypeOf(int(0)),
+	"int8":       reflect.TypeOf(int8(0)),
+	"int16":      reflect.TypeOf(int16(0)),
+	"int32":      reflect.TypeOf(int32(0)),
+	"int64":      reflect.TypeOf(int64(0)),
+	"uint":       reflect.TypeOf(uint(0)),
+	"uint8":      reflect.TypeOf(uint8(0)),
+	"uint16":     reflect.TypeOf(uint16(0)),
+	"uint32":     reflect.TypeOf(uint32(0)),
+	"uint64":     reflect.TypeOf(uint64(0)),
+	"uintptr":    reflect.TypeOf(uintptr(0)),
+	"byte":       reflect.TypeOf(byte(0)),
+	"rune":       reflect.TypeOf(rune(0)),
+	"float32":    reflect.TypeOf(float32(0)),
+	"float64":    reflect.TypeOf(float64(0)),
+	"complex64":  reflect.TypeOf(complex64(0)),
+	"complex128": reflect.TypeOf(complex128(0)),
+	"any":        anyType,
+	"error":      reflect.TypeOf((*error)(nil)).Elem(),
+}
+
+// evalLiteral evaluates expr as a value of type t, or of its default type when t is nil.
+func evalLiteral(expr ast.Expr, t reflect.Type) (reflect.Value, error) {
+	if c, def, ok, err := evalConstant(expr); err != nil {
+		return reflect.Value{}, err
+	} else if ok {
+		if t == nil {
+			t = def
+		}
+		return constantValue(c, def, t)
+	}
+
+	switch e := expr.(type) {
+	case *ast.ParenExpr:
+		return evalLiteral(e.X, t)
+
+	case *ast.Ident:
+		if e.Name != "nil" {
+			return reflect.Value{}, fmt.Errorf("%s is not a literal (a string needs its quotes: '\"%s\"')", e.Name, e.Name)
+		}
+		if t == nil {
+			t = anyType
+		}
+		switch t.Kind() {
+		case reflect.Pointer, reflect.Slice, reflect.Map, reflect.Interface:
+			return reflect.Zero(t), nil
+		}
+		return reflect.Value{}, fmt.Errorf("cannot use nil as %s", t)
+
+	case *ast.CallExpr:
+		if len(e.Args) != 1 {
+			return reflect.Value{}, fmt.Errorf("only conversions such as int8(42) can be called")
+		}
+		to, err := literalType(e.Fun)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		v, err := evalLiteral(e.Args[0], to)
+		if err != nil {
+			// Conversions between strings and byte or rune slices, such as []byte("hi").
+			// Numeric constants are never converted this way: int8(300) must not compile.
+			if to.Kind() != reflect.Slice && to.Kind() != reflect.String {
+				return reflect.Value{}, err
+			}
+			if v, err2 := evalLiteral(e.Args[0], nil); err2 == nil && v.Type().ConvertibleTo(to) {
+				return v.Convert(to), nil
+			}
+			return reflect.Value{}, err
+		}
+		return assignTo(v, t)
+
+	case *ast.UnaryExpr:
+		if e.Op != token.AND {
+			break
+		}
+		var elem reflect.Type
+		if t != nil && t.Kind() == reflect.Pointer {
+			elem = t.Elem()
+		}
+		v, err := evalLiteral(e.X, elem)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		p := reflect.New(v.Type())
+		p.Elem().Set(v)
+		return p, nil
+
+	case *ast.CompositeLit:
+		return evalComposite(e, t)
+	}
+
+	return reflect.Value{}, fmt.Errorf("unsupported expression %s: only literals, conversions and composite literals are supported", exprString(expr))
+}
+
+// evalConstant evaluates constant expressions and reports their default type.
+func evalConstant(expr ast.Expr) (c constant.Value, def reflect.Type, ok bool, err error) {
+	switch e := expr.(type) {
+	case *ast.BasicLit:
+		c = constant.MakeFromLiteral(e.Value, e.Kind, 0)
+		if c.Kind() == constant.Unknown {
+			return nil, nil, false, fmt.Errorf("malformed literal %s", e.Value)
+		}
+		switch e.Kind {
+		case token.INT:
+			def = basicTypes["int"]
+		case token.FLOAT:
+			def = basicTypes["float64"]
+		case token.IMAG:
+			def = basicTypes["complex128"]
+		case token.CHAR:
+			def = basicTypes["rune"]
+		case token.STRING:
+			def = basicTypes["string"]
+		}
+		return c, def, true, nil
+
+	case *ast.Ident:
+		switch e.Name {
+		case "true", "false":
+			return constant.MakeBool(e.Name == "true"), basicTypes["bool"], true, nil
+		}
+
+	case *ast.ParenExpr:
+		return evalConstant(e.X)
+
+	case *ast.UnaryExpr:
+		switch e.Op {
+		case token.ADD, token.SUB, token.XOR, token.NOT:
+		default:
+			return nil, nil, false, nil
+		}
+		x, def, ok, err := evalConstant(e.X)
+		if !ok || err != nil {
+			return nil, nil, ok, err
+		}
+		if (e.Op == token.NOT) != (x.Kind() == constant.Bool) || (e.Op == token.XOR && x.Kind() != constant.Int) || x.Kind() == constant.String {
+			return nil, nil, false, fmt.Errorf("operator %s is not defined on %s", e.Op, exprString(e.X))
+		}
+		return constant.UnaryOp(e.Op, x, 0), def, true, nil
+	}
+
+	return nil, nil, false, nil
+}
+
+// constantValue gives an untyped constant the type t, failing like the compiler would on overflow.
+func constantValue(c constant.Value, def, t reflect.Type) (reflect.Value, error) {
+	v := reflect.New(t).Elem()
+	fail := func() (reflect.Value, error) {
+		return reflect.Value{}, fmt.Errorf("cannot use %s as %s (it does not fit or has the wrong kind)", c.ExactString(), t)
+	}
+
+	switch t.Kind() {
+	case reflect.Interface:
+		if t.NumMethod() > 0 {
+			return fail()
+		}
+		dv, err := constantValue(c, def, def)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		v.Set(dv)
+
+	case reflect.Bool:
+		if c.Kind() != constant.Bool {
+			return fail()
+		}
+		v.SetBool(constant.BoolVal(c))
+
+	case reflect.String:
+		if c.Kind() != constant.String {
+			return fail()
+		}
+		v.SetString(constant.StringVal(c))
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, exact := constant.Int64Val(constant.ToInt(c))
+		if !exact || c.Kind() == constant.Bool || c.Kind() == constant.String || v.OverflowInt(n) {
+			return fail()
+		}
+		v.SetInt(n)
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, exact := constant.Uint64Val(constant.ToInt(c))
+		if !exact || c.Kind() == constant.Bool || c.Kind() == constant.String || v.OverflowUint(n) {
+			return fail()
+		}
+		v.SetUint(n)
+
+	case reflect.Float32, reflect.Float64:
+		f := constant.ToFloat(c)
+		if f.Kind() != constant.Float && f.Kind() != constant.Int {
+			return fail()
+		}
+		// Float64Val rounds what does not fit a float64 to ±Inf, which OverflowFloat accepts.
+		x, _ := constant.Float64Val(f)
+		if math.IsInf(x, 0) || v.OverflowFloat(x) {
+			return fail()
+		}
+		v.SetFloat(x)
+
+	case reflect.Complex64, reflect.Complex128:
+		z := constant.ToComplex(c)
+		if z.Kind() != constant.Complex {
+			return fail()
+		}
+		re, _ := constant.Float64Val(constant.Real(z))
+		im, _ := constant.Float64Val(constant.Imag(z))
+		if math.IsInf(re, 0) || math.IsInf(im, 0) || v.OverflowComplex(complex(re, im)) {
+			return fail()
+		}
+		v.SetComplex(complex(re, im))
+
+	default:
+		return fail()
+	}
+
+	return v, nil
+}
+
+func evalComposite(lit *ast.CompositeLit, t reflect.Type) (reflect.Value, error) {
+	if array, ok := lit.Type.(*ast.ArrayType); ok && isEllipsis(array.Len) {
+		// [...]T takes its length from the elements.
+		elem, err := literalType(array.Elt)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		t = reflect.ArrayOf(len(lit.Elts), elem)
+	} else if lit.Type != nil {
+		var err error
+		if t, err = literalType(lit.Type); err != nil {
+			return reflect.Value{}, err
+		}
+	}
+	if t == nil {
+		return reflect.Value{}, fmt.Errorf("composite literal %s has no type", exprString(lit))
+	}
+
+	switch t.Kind() {
+	case reflect.Slice, reflect.Array:
+		v := reflect.New(t).Elem()
+		if t.Kind() == reflect.Slice {
+			v = reflect.MakeSlice(t, len(lit.Elts), len(lit.Elts))
+		} else if len(lit.Elts) > t.Len() {
+			return reflect.Value{}, fmt.Errorf("%d elements do not fit in %s", len(lit.Elts), t)
+		}
+		for i, elt := range lit.Elts {
+			if _, ok := elt.(*ast.KeyValueExpr); ok {
+				return reflect.Value{}, fmt.Errorf("indexed elements are not supported in %s", t)
+			}
+			x, err := evalLiteral(elt, t.Elem())
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			v.Index(i).Set(x)
+		}
+		return v, nil
+
+	case reflect.Map:
+		v := reflect.MakeMapWithSize(t, len(lit.Elts))
+		for _, elt := range lit.Elts {
+			kv, ok := elt.(*ast.KeyValueExpr)
+			if !ok {
+				return reflect.Value{}, fmt.Errorf("map elements need a key: %s", exprString(elt))
+			}
+			key, err := evalLiteral(kv.Key, t.Key())
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			// An interface key is only checked at run time: map[any]int{[]int{1}: 1} compiles, then panics.
+			if !key.Comparable() {
+				return reflect.Value{}, fmt.Errorf("invalid map key %s: %s cannot be hashed", exprString(kv.Key), dynamicType(key))
+			}
+			value, err := evalLiteral(kv.Value, t.Elem())
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			v.SetMapIndex(key, value)
+		}
+		return v, nil
+
+	case reflect.Struct:
+		v := reflect.New(t).Elem()
+		for i, elt := range lit.Elts {
+			field, value := i, elt
+			if kv, ok := elt.(*ast.KeyValueExpr); ok {
+				name, ok := kv.Key.(*ast.Ident)
+				if !ok {
+					return reflect.Value{}, fmt.Errorf("field name expected: %s", exprString(kv.Key))
+				}
+				f, ok := t.FieldByName(name.Name)
+				if !ok {
+					return reflect.Value{}, fmt.Errorf("%s has no field %s", t, name.Name)
+				}
+				field, value = f.Index[0], kv.Value
+			} else if i >= t.NumField() {
+				return reflect.Value{}, fmt.Errorf("too many values for %s", t)
+			}
+			x, err := evalLiteral(value, t.Field(field).Type)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			v.Field(field).Set(x)
+		}
+		return v, nil
+	}
+
+	return reflect.Value{}, fmt.Errorf("%s cannot be built with a composite literal", t)
+}
+
+func isEllipsis(expr ast.Expr) bool {
+	_, ok := expr.(*ast.Ellipsis)
+	return ok
+}
+
+// literalType turns a type expression such as map[string]int into a reflect.Type.
+func literalType(expr ast.Expr) (reflect.Type, error) {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		if t, ok := basicTypes[e.Name]; ok {
+			return t, nil
+		}
+		return nil, fmt.Errorf("unknown type %s: only predeclared types and type literals are supported", e.Name)
+
+	case *ast.ParenExpr:
+		return literalType(e.X)
+
+	case *ast.StarExpr:
+		elem, err := literalType(e.X)
+		if err != nil {
+			return nil, err
+		}
+		return reflect.PointerTo(elem), nil
+
+	case *ast.ArrayType:
+		elem, err := literalType(e.Elt)
+		if err != nil {
+			return nil, err
+		}
+		if e.Len == nil {
+			return reflect.SliceOf(elem), nil
+		}
+		// The length can be any constant expression (e.g., 1<<10), so it is evaluated like the compiler would.
+		tv, err := types.Eval(token.NewFileSet(), nil, token.NoPos, exprString(e.Len))
+		if err != nil || tv.Value == nil {
+			return nil, fmt.Errorf("array length must be a constant: %s", exprString(e.Len))
+		}
+		c := constant.ToInt(tv.Value)
+		if c.Kind() != constant.Int || constant.Sign(c) < 0 {
+			return nil, fmt.Errorf("invalid array length %s", exprString(e.Len))
+		}
+		n, exact := constant.Int64Val(c)
+		if !exact || n > MAX_ARRAY_LEN {
+			return nil, fmt.Errorf("array length %s is too large (at most %d)", c, MAX_ARRAY_LEN)
+		}
+		if elem.Size() > 0 && uint64(n) > MAX_ARRAY_SIZE/uint64(elem.Size()) {
+			return nil, fmt.Errorf("[%d]%s is too large (at most %d bytes)", n, elem, MAX_ARRAY_SIZE)
+		}
+		return reflect.ArrayOf(int(n), elem), nil
+
+	case *ast.MapType:
+		key, err := literalType(e.Key)
+		if err != nil {
+			return nil, err
+		}
+		if !key.Comparable() {
+			return nil, fmt.Errorf("invalid map key type %s", key)
+		}
+		value, err := literalType(e.Value)
+		if err != nil {
+			return nil, err
+		}
+		return reflect.MapOf(key, value), nil
+
+	case *ast.InterfaceType:
+		if e.Methods.NumFields() > 0 {
+			return nil, fmt.Errorf("only the empty interface is supported")
+		}
+		return anyType, nil
+
+	case *ast.StructType:
+		var fields []reflect.StructField
+		seen := map[string]bool{}
+		for _, f := range e.Fields.List {
+			t, err := literalType(f.Type)
+			if err != nil {
+				return nil, err
+			}
+			if len(f.Names) == 0 {
+				return nil, fmt.Errorf("embedded fields are not supported")
+			}
+			var tag reflect.StructTag
+			if f.Tag != nil {
+				s, err := strconv.Unquote(f.Tag.Value)
+				if err != nil {
+					return nil, err
+				}
+				tag = reflect.StructTag(s)
+			}
+			for _, name := range f.Names {
+				if !token.IsExported(name.Name) {
+					return nil, fmt.Errorf("field %s must be exported (e.g., %s)", name.Name, exportedName(name.Name))
+				}
+				if seen[name.Name] {
+					return nil, fmt.Errorf("duplicate field %s", name.Name)
+				}
+				seen[name.Name] = true
+				fields = append(fields, reflect.StructField{Name: name.Name, Type: t, Tag: tag})
+			}
+		}
+		return reflect.StructOf(fields), nil
+	}
+
+	return nil, fmt.Errorf("unsupported type %s", exprString(expr))
+}
+
+// assignTo converts v to t when v is used where a value of type t is expected (e.g., inside a composite literal).
+func assignTo(v reflect.Value, t reflect.Type) (reflect.Value, error) {
+	if t == nil || v.Type() == t {
+		return v, nil
+	}
+	if !v.Type().AssignableTo(t) {
+		return reflect.Value{}, fmt.Errorf("cannot use %s value as %s", v.Type(), t)
+	}
+	w := reflect.New(t).Elem()
+	w.Set(v)
+	return w, nil
+}
+
+// dynamicType returns the type of the value stored in v when v is an interface, and the type of v otherwise.
+func dynamicType(v reflect.Value) reflect.Type {
+	if v.Kind() == reflect.Interface && !v.IsNil() {
+		return v.Elem().Type()
+	}
+	return v.Type()
+}
+
+func exportedName(name string) string {
+	r, size := utf8.DecodeRuneInString(name)
+	return string(unicode.ToUpper(r)) + name[size:]
+}
+
+func exprString(expr ast.Expr) string {
+	return types.ExprString(expr)
+}
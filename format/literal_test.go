@@ -0,0 +1,48 @@
+package format
+
+import (
+	"strings"
+	"testing"
+)
+
+// Literals typed by the user must come back as an error, never crash the verbs command.
+func TestParseLiteralRejectsWhatCannotBeBuilt(t *testing.T) {
+	tests := []struct {
+		src  string
+		want string
+	}{
+		{"[100000000000000000]int{}", "array length 100000000000000000 is too large"},
+		{"[1<<40]int{}", "array length 1099511627776 is too large"},
+		{"[65536][65536]int{}", "is too large (at most 1048576 bytes)"},
+		{"[-1]int{}", "invalid array length -1"},
+		{"[n]int{}", "array length must be a constant: n"},
+		{"struct{A int; A string}{}", "duplicate field A"},
+		{"map[any]int{[]int{1}: 1}", "[]int cannot be hashed"},
+		{"map[any]int{[1]any{[]int{1}}: 1}", "[1]interface {} cannot be hashed"},
+		{"1e400", "as float64"},
+		{"-1e400", "as float64"},
+		{"complex64(1e40)", "as complex64"},
+		{"complex128(1e400i)", "as complex128"},
+	}
+
+	for _, tt := range tests {
+		_, err := ParseLiteral(tt.src)
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("ParseLiteral(%q) returned %v, want an error containing %q", tt.src, err, tt.want)
+		}
+	}
+}
+
+func TestParseLiteralBuildsConstantLengths(t *testing.T) {
+	v, err := ParseLiteral("[1<<2]int{1, 2}")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, ok := v.([4]int); !ok || got != [4]int{1, 2} {
+		t.Errorf("got %#v, want [4]int{1, 2, 0, 0}", v)
+	}
+
+	if _, err := ParseLiteral(`map[any]int{1: 1, "a": 2, [1]int{3}: 3}`); err != nil {
+		t.Errorf("hashable interface keys are rejected: %v", err)
+	}
+}
@@ -0,0 +1,97 @@
+package format
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+/*
+ * The verbs of the fmt package, in the order of its documentation:
+ * 1. General   : %v %T
+ * 2. Boolean   : %t
+ * 3. Integer   : %b %c %d %o %O %q %x %X %U
+ * 4. Float     : %b %e %E %f %F %g %G %x %X
+ * 5. String    : %s %q %x %X
+ * 6. Pointer   : %p */
+const VERBS = "vTtbcdoOqxXUeEfFgGsp"
+
+/*
+ * The variations tried for every verb (V stands for the verb):
+ * 1. Flags    : + (sign), # (alternate form), space (space for the sign), - (left justify), 0 (pad with zeroes)
+ * 2. Width    : Minimum number of characters (8)
+ * 3. Precision: Digits after the point, or maximum characters for strings (.2) */
+var VERB_VARIANTS = []string{"%+V", "%#V", "% V", "%8V", "%-8V", "%08V", "%.2V", "%8.2V"}
+
+/*
+ * A VerbResult is one format string applied to a value:
+ * 1. Format: The format string (e.g., %-8d)
+ * 2. Output: What fmt.Sprintf printed
+ * 3. Bad   : The verb does not apply to the value and fmt printed a %!verb(type=value) error instead */
+type VerbResult struct {
+	Format string
+	Output string
+	Bad    bool
+}
+
+/*
+ * ExploreVerbs formats value with every verb of VERBS, and with every variant of VERB_VARIANTS
+ * for the verbs that apply to it.
+ *
+ * A verb that does not apply is reported once, as Bad, because flags cannot make it apply.
+ * Variants that print exactly what the plain verb prints are left out: the flag, width or precision does nothing there.
+ * For composite values (e.g., a struct), a verb is Bad when it fails on any of the elements. */
+func ExploreVerbs(value any) []VerbResult {
+	var results []VerbResult
+
+	for _, verb := range VERBS {
+		plain := "%" + string(verb)
+		output := fmt.Sprintf(plain, value)
+
+		if isBadVerb(output, verb) {
+			results = append(results, VerbResult{Format: plain, Output: output, Bad: true})
+			continue
+		}
+		results = append(results, VerbResult{Format: plain, Output: output})
+
+		for _, variant := range VERB_VARIANTS {
+			format := strings.Replace(variant, "V", string(verb), 1)
+			out := fmt.Sprintf(format, value)
+			if out == output {
+				continue
+			}
+
+			results = append(results, VerbResult{Format: format, Output: out, Bad: isBadVerb(out, verb)})
+		}
+	}
+
+	return results
+}
+
+// isBadVerb reports whether fmt wrote a %!verb(...) error instead of a value.
+func isBadVerb(output string, verb rune) bool {
+	return strings.Contains(output, "%!"+string(verb)+"(")
+}
+
+/*
+ * printVerbs prints what ExploreVerbs reports for each of the formats, between | so the padding stays visible.
+ * The formats must be verbs of VERBS or variants of VERB_VARIANTS. A variant that ExploreVerbs left out
+ * prints the same as its plain verb, and a verb that does not apply is marked as such. */
+func printVerbs(w io.Writer, value any, formats ...string) {
+	results := map[string]VerbResult{}
+	for _, r := range ExploreVerbs(value) {
+		results[r.Format] = r
+	}
+
+	for _, format := range formats {
+		r, ok := results[format]
+		switch {
+		case !ok:
+			fmt.Fprintf(w, "%s: same as %%%c\n", format, format[len(format)-1])
+		case r.Bad:
+			fmt.Fprintf(w, "%s: does not apply to %T\n", format, value)
+		default:
+			fmt.Fprintf(w, "%s: |%s|\n", format, r.Output)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package functions
+
+import (
+	"fmt"
+	"io"
+)
+
+func getClosureState(w io.Writer) {
+	/*
+	 * counter returns a closure that captures count.
+	 * count outlives the call to counter (it is moved to the heap, see the pointer_types/escape lesson),
+	 * and every call to counter makes a new count, so two counters are independent. */
+	next := counter()
+	fmt.Fprintln(w, next(), next(), next()) // Output: 1 2 3
+
+	other := counter()
+	fmt.Fprintln(w, other(), next()) // Output: 1 4
+
+	fib := fibonacci()
+	fmt.Fprintln(w, fib(), fib(), fib(), fib(), fib(), fib(), fib()) // Output: 0 1 1 2 3 5 8
+}
+
+func counter() func() int {
+	count := 0
+	return func() int {
+		count++
+		return count
+	}
+}
+
+// fibonacci returns a generator: every call returns the next Fibonacci number.
+func fibonacci() func() int {
+	a, b := 0, 1
+	return func() int {
+		result := a
+		a, b = b, a+b
+		return result
+	}
+}
+
+func getClosureSharing(w io.Writer) {
+	/*
+	 * A closure captures the variable itself, not a copy of its value:
+	 * a change made outside is seen inside, and the other way around. */
+	greeting := "Hello"
+	greet := func(name string) string {
+		return greeting + ", " + name
+	}
+	greeting = "Hi"
+	fmt.Fprintln(w, greet("Ana")) // Output: Hi, Ana
+
+	// Closures made together share the variables they capture.
+	increment, get := sharedCounter()
+	increment()
+	increment()
+	fmt.Fprintln(w, get()) // Output: 2
+}
+
+func sharedCounter() (increment func(), get func() int) {
+	count := 0
+	increment = func() { count++ }
+	get = func() int { return count }
+	return increment, get
+}
+
+func getMemoize(w io.Writer) {
+	/*
+	 * A closure can keep a cache: memoize wraps a slow function and remembers its results.
+	 * calls counts how many times the slow function really ran. */
+	calls := 0
+	square := memoize(func(n int) int {
+		calls++
+		return n * n
+	})
+
+	fmt.Fprintln(w, square(4), square(4), square(5), square(4)) // Output: 16 16 25 16
+	fmt.Fprintln(w, calls)                                      // Output: 2
+}
+
+func memoize(f func(int) int) func(int) int {
+	cache := map[int]int{}
+	return func(n int) int {
+		if result, ok := cache[n]; ok {
+			return result
+		}
+		result := f(n)
+		cache[n] = result
+		return result
+	}
+}
@@ -0,0 +1,103 @@
+// Code generated by "go run . examples"; DO NOT EDIT.
+
+package functions_test
+
+import (
+	"os"
+
+	"github.com/fajarstrtn/golang-tutorial/functions"
+	"github.com/fajarstrtn/golang-tutorial/lesson"
+)
+
+// ExampleGenerateClosures runs the functions/closures lesson.
+func ExampleGenerateClosures() {
+	functions.GenerateClosures(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// 1 2 3
+	// 1 4
+	// 0 1 1 2 3 5 8
+	// Hi, Ana
+	// 2
+	// 16 16 25 16
+	// 2
+}
+
+// ExampleGenerateFunctionValues runs the functions/values lesson.
+func ExampleGenerateFunctionValues() {
+	functions.GenerateFunctionValues(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// func(int, int) (int, int)
+	// 3 remainder 1
+	// 5
+	// true true
+	// 7 + 3 = 10
+	// 7 - 3 = 4
+	// 7 * 3 = 21
+	// 8
+	// [fig Kiwi apple banana]
+	// [FIG KIWI APPLE BANANA]
+	// Tbcure
+}
+
+// ExampleGenerateInitOrder runs the functions/init lesson.
+func ExampleGenerateInitOrder() {
+	functions.GenerateInitOrder(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// var base
+	// var bonus
+	// var total
+	// first init()
+	// second init()
+	// 42
+	// config -> cache -> database -> functions
+	// postgres://localhost/tutorial memory
+}
+
+// ExampleGenerateRecursion runs the functions/recursion lesson.
+func ExampleGenerateRecursion() {
+	functions.GenerateRecursion(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// 120 2432902008176640000
+	// 5000050000
+	// 6765 21891
+	// golang-tutorial
+	//   functions
+	//     closure.go
+	//     recursion.go
+	//   lesson
+	//     lesson.go
+	// 6 nodes
+	// true true false
+}
+
+// ExampleGenerateResults runs the functions/results lesson.
+func ExampleGenerateResults() {
+	functions.GenerateResults(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// 3 2
+	// 2
+	// "" no words
+	// "Hello" <nil>
+	// 4 remainder 1
+	// -2 9
+	// 0 0
+	// gopher example.com true
+}
+
+// ExampleGenerateVariadic runs the functions/variadic lesson.
+func ExampleGenerateVariadic() {
+	functions.GenerateVariadic(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// 0
+	// 6
+	// a, b, c
+	// 6
+	// [2 4 6]
+	// [2 4 6]
+	// 13
+	// func(...interface {}) (int, error)
+	// true
+	// go 1 true
+	// [go 1 true]
+	// [functions] functions has 6 lessons
+}
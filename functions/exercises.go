@@ -0,0 +1,154 @@
+package functions
+
+import "github.com/fajarstrtn/golang-tutorial/exercise"
+
+func init() {
+	exercise.Register(exercise.Exercise{
+		ID:     "functions/results/divide-with-error",
+		Lesson: "functions/results",
+		Title:  "Return a value and an error",
+		Prompt: "divide panics when b is 0. Make it return (int, error): the quotient and nil, or 0 and errors.New(\"division by zero\"). The output must be:\n3 <nil>\n0 division by zero",
+		Starter: `package main
+
+import "fmt"
+
+func divide(a, b int) int {
+	return a / b
+}
+
+func main() {
+	fmt.Println(divide(7, 2))
+	fmt.Println(divide(1, 0))
+}
+`,
+		Checks: []exercise.Check{
+			exercise.Calls("errors.New", 1),
+			exercise.OutputIs("3 <nil>\n0 division by zero"),
+		},
+	})
+
+	exercise.Register(exercise.Exercise{
+		ID:     "functions/variadic/average",
+		Lesson: "functions/variadic",
+		Title:  "Write a variadic function",
+		Prompt: "Write average(numbers ...float64) float64, which returns 0 when it gets no numbers. main calls it with separate arguments, with none, and with a slice followed by .... The output must be:\n2\n0\n2.5",
+		Starter: `package main
+
+import "fmt"
+
+func main() {
+	fmt.Println(average(1, 2, 3))
+	fmt.Println(average())
+
+	scores := []float64{1, 2, 3, 4}
+	fmt.Println(average(scores...))
+}
+`,
+		Checks: []exercise.Check{
+			exercise.Calls("average", 3),
+			exercise.OutputIs("2\n0\n2.5"),
+		},
+	})
+
+	exercise.Register(exercise.Exercise{
+		ID:     "functions/closures/accumulator",
+		Lesson: "functions/closures",
+		Title:  "Keep state in a closure",
+		Prompt: "Write accumulator(start int) func(int) int: the returned closure adds its argument to a running total (starting at start) and returns the total. Two accumulators must not share their totals. The output must be:\n15 18\n100",
+		Starter: `package main
+
+import "fmt"
+
+func main() {
+	a := accumulator(10)
+	b := accumulator(100)
+
+	first := a(5)
+	fmt.Println(first, a(3))
+	fmt.Println(b(0))
+}
+`,
+		Checks: []exercise.Check{
+			exercise.Calls("accumulator", 2),
+			exercise.OutputIs("15 18\n100"),
+		},
+	})
+
+	exercise.Register(exercise.Exercise{
+		ID:     "functions/values/apply",
+		Lesson: "functions/values",
+		Title:  "Pass a function as a value",
+		Prompt: "Write apply(values []int, f func(int) int) []int, which returns a new slice with f applied to every value. main passes it a named function and a function literal. The output must be:\n[2 4 6]\n[1 4 9]",
+		Starter: `package main
+
+import "fmt"
+
+func double(n int) int {
+	return n * 2
+}
+
+func main() {
+	values := []int{1, 2, 3}
+	fmt.Println(apply(values, double))
+	fmt.Println(apply(values, func(n int) int { return n * n }))
+}
+`,
+		Checks: []exercise.Check{
+			exercise.Calls("apply", 2),
+			exercise.OutputIs("[2 4 6]\n[1 4 9]"),
+		},
+	})
+
+	exercise.Register(exercise.Exercise{
+		ID:     "functions/recursion/sum-digits",
+		Lesson: "functions/recursion",
+		Title:  "Sum the digits recursively",
+		Prompt: "Write sumDigits(n int) int without a loop: the sum of the digits of n is its last digit (n % 10) plus the sum of the digits of n / 10, and 0 for 0. The output must be:\n0\n6\n45",
+		Starter: `package main
+
+import "fmt"
+
+func sumDigits(n int) int {
+	return 0
+}
+
+func main() {
+	fmt.Println(sumDigits(0))
+	fmt.Println(sumDigits(123))
+	fmt.Println(sumDigits(123456789))
+}
+`,
+		Checks: []exercise.Check{
+			exercise.Calls("sumDigits", 4),
+			exercise.UsesKeyword("if"),
+			exercise.OutputIs("0\n6\n45"),
+		},
+	})
+
+	exercise.Register(exercise.Exercise{
+		ID:     "functions/init/before-main",
+		Lesson: "functions/init",
+		Title:  "Set up a package in init",
+		Prompt: "main calls setup itself, too late: greeting is still empty when it prints it. Rename setup to init and remove the call, so it runs before main. The output must be:\nsetting up\nHello from init",
+		Starter: `package main
+
+import "fmt"
+
+var greeting string
+
+func setup() {
+	fmt.Println("setting up")
+	greeting = "Hello from init"
+}
+
+func main() {
+	fmt.Println(greeting)
+	setup()
+}
+`,
+		Checks: []exercise.Check{
+			exercise.NoCalls("setup"),
+			exercise.OutputIs("setting up\nHello from init"),
+		},
+	})
+}
@@ -0,0 +1,66 @@
+/*
+ * A function is declared with func, its parameters, and its results:
+ *
+ *	func name(parameter type, ...) (result type, ...) { ... }
+ *
+ * In Go, functions are values: they can be stored in variables, passed to other functions,
+ * returned, and they can capture the variables around them (closures).
+ *
+ * This package covers results, variadic parameters, closures, function values and types,
+ * recursion, and the order in which packages and their init functions are initialized. */
+package functions
+
+import "github.com/fajarstrtn/golang-tutorial/lesson"
+
+/*
+ * A function can return several results, and the results can have names.
+ * The identifier/variables lesson already uses greet, which returns two strings. */
+func GenerateResults(env *lesson.Env) {
+	getMultipleResults(env.Out)
+	getNamedResults(env.Out)
+}
+
+/*
+ * The last parameter of a function can be variadic (...T): it takes any number of arguments,
+ * which the function receives as a []T. fmt.Print and fmt.Println are variadic. */
+func GenerateVariadic(env *lesson.Env) {
+	getVariadic(env.Out)
+	getVariadicSlice(env.Out)
+	getPrintIsVariadic(env.Out)
+}
+
+/*
+ * A closure is a function literal that uses variables declared outside of it.
+ * The variables are captured by reference and live as long as the closure does,
+ * so a closure can keep state between calls. */
+func GenerateClosures(env *lesson.Env) {
+	getClosureState(env.Out)
+	getClosureSharing(env.Out)
+	getMemoize(env.Out)
+}
+
+/*
+ * Functions are values of function types (e.g., func(int, int) int):
+ * they can be assigned, stored in maps and slices, passed and returned. */
+func GenerateFunctionValues(env *lesson.Env) {
+	getFunctionValues(env.Out)
+	getFunctionTypes(env.Out)
+	getHigherOrderFunctions(env.Out)
+}
+
+/*
+ * A recursive function calls itself. Every call needs a case that does not recurse (the base case),
+ * and every recursive call must get closer to it. */
+func GenerateRecursion(env *lesson.Env) {
+	getRecursion(env.Out)
+	getRecursiveStructures(env.Out)
+	getMutualRecursion(env.Out)
+}
+
+/*
+ * init functions run before main, once per package, in an order fixed by the language:
+ * first the package variables, then the init functions, and every package after the packages it imports. */
+func GenerateInitOrder(env *lesson.Env) {
+	getInitInPackage(env.Out)
+	getInitAcrossPackages(env.Out)
+}
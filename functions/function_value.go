@@ -0,0 +1,106 @@
+package functions
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+)
+
+func getFunctionValues(w io.Writer) {
+	/*
+	 * A function name without parentheses is a value of a function type,
+	 * which is written like a declaration without names: func(int, int) (int, int). */
+	f := divide
+	fmt.Fprintf(w, "%T\n", f)                // Output: func(int, int) (int, int)
+	fmt.Fprintln(w, formatDivision(f(7, 2))) // Output: 3 remainder 1
+
+	// A function literal is a function without a name, written where it is used.
+	add := func(a, b int) int { return a + b }
+	fmt.Fprintln(w, add(2, 3)) // Output: 5
+
+	/*
+	 * The zero value of a function type is nil, and calling a nil function panics.
+	 * Functions can only be compared to nil: f == g does not compile. */
+	var missing func(int) int
+	fmt.Fprintln(w, missing == nil, f != nil) // Output: true true
+}
+
+// An Operation is a function type: any func(int, int) int can be used as one.
+type Operation func(a, b int) int
+
+// Apply is a method on a function type: a named function type can have methods like any other type.
+func (op Operation) Apply(a, b int) string {
+	return fmt.Sprintf("%d", op(a, b))
+}
+
+func getFunctionTypes(w io.Writer) {
+	/*
+	 * A named function type makes signatures shorter and can have methods.
+	 * A map from names to functions replaces a long switch.
+	 *
+	 * Output:
+	 * 7 + 3 = 10
+	 * 7 - 3 = 4
+	 * 7 * 3 = 21 */
+	for _, symbol := range []string{"+", "-", "*"} {
+		fmt.Fprintf(w, "7 %s 3 = %s\n", symbol, OPERATIONS[symbol].Apply(7, 3))
+	}
+
+	// A function can return a function of the type too.
+	fmt.Fprintln(w, power(3)(2, 0)) // Output: 8
+}
+
+// OPERATIONS maps the symbol of an operation to its function.
+var OPERATIONS = map[string]Operation{
+	"+": func(a, b int) int { return a + b },
+	"-": func(a, b int) int { return a - b },
+	"*": func(a, b int) int { return a * b },
+}
+
+// power returns an Operation that raises its first argument to the given power (and ignores the second).
+func power(exponent int) Operation {
+	return func(a, _ int) int {
+		result := 1
+		for range exponent {
+			result *= a
+		}
+		return result
+	}
+}
+
+func getHigherOrderFunctions(w io.Writer) {
+	/*
+	 * A function that takes or returns functions is a higher-order function.
+	 * The standard library uses them for anything that needs a piece of custom logic,
+	 * like the comparison of a sort. */
+	words := []string{"banana", "Kiwi", "apple", "fig"}
+	slices.SortFunc(words, func(a, b string) int {
+		return cmp.Or(cmp.Compare(len(a), len(b)), strings.Compare(a, b))
+	})
+	fmt.Fprintln(w, words) // Output: [fig Kiwi apple banana]
+
+	fmt.Fprintln(w, mapStrings(words, strings.ToUpper)) // Output: [FIG KIWI APPLE BANANA]
+	fmt.Fprintln(w, strings.Map(rot13, "Gopher"))       // Output: Tbcure
+}
+
+// mapStrings applies f to every string, and returns the results in a new slice.
+func mapStrings(values []string, f func(string) string) []string {
+	result := make([]string, len(values))
+	for i, v := range values {
+		result[i] = f(v)
+	}
+	return result
+}
+
+// rot13 moves a letter 13 places in the alphabet; strings.Map calls it for every rune.
+func rot13(r rune) rune {
+	switch {
+	case r >= 'a' && r <= 'z':
+		return 'a' + (r-'a'+13)%26
+	case r >= 'A' && r <= 'Z':
+		return 'A' + (r-'A'+13)%26
+	}
+	return r
+}
@@ -0,0 +1,89 @@
+package functions
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/fajarstrtn/golang-tutorial/functions/init_order/cache"
+	"github.com/fajarstrtn/golang-tutorial/functions/init_order/database"
+	"github.com/fajarstrtn/golang-tutorial/functions/init_order/trace"
+)
+
+// initLog records the initialization steps of this package, in the order they ran.
+var initLog []string
+
+/*
+ * Package variables are initialized in dependency order, not in the order they are written:
+ * total uses base and bonus, so both are initialized before it. */
+var total = record("var total", base+bonus)
+var base = record("var base", 40)
+var bonus = record("var bonus", 2)
+
+func record(step string, value int) int {
+	initLog = append(initLog, step)
+	return value
+}
+
+// A package can have several init functions, even in one file; they run in the order they are written.
+func init() {
+	initLog = append(initLog, "first init()")
+}
+
+func init() {
+	initLog = append(initLog, "second init()")
+}
+
+// The packages this file imports are initialized before any of its init functions run, so this one is recorded last.
+func init() {
+	trace.Record("functions")
+}
+
+func getInitInPackage(w io.Writer) {
+	/*
+	 * Inside a package, initialization runs in two steps before main (or before the package's importers):
+	 * 1. Package variables, each one after the variables it depends on
+	 * 2. init functions, in the order of the files (sorted by name) and then of the declarations
+	 *
+	 * init takes no arguments, returns nothing, and cannot be called or referred to by the program.
+	 * Every lesson package of the tutorial uses one to register its lessons.
+	 *
+	 * Output:
+	 * var base
+	 * var bonus
+	 * var total
+	 * first init()
+	 * second init() */
+	for _, step := range initLog {
+		fmt.Fprintln(w, step)
+	}
+
+	fmt.Fprintln(w, total) // Output: 42
+}
+
+func getInitAcrossPackages(w io.Writer) {
+	/*
+	 * A package is initialized only once, after every package it imports.
+	 * Among the packages whose imports are all initialized, the one whose import path sorts first goes next
+	 * (a rule fixed by the language since Go 1.21, so the order is the same on every build).
+	 *
+	 * The packages of functions/init_order show it on a small scale:
+	 * this package imports cache and database, which both import config.
+	 * 1. config first: cache and database import it
+	 * 2. cache before database: both are ready once config is, and cache sorts first
+	 * 3. functions after both, because it imports them
+	 * 4. main after every package, and only then main() runs
+	 *
+	 * Output:
+	 * config -> cache -> database -> functions */
+	fmt.Fprintln(w, strings.Join(trace.STEPS, " -> "))
+
+	// database could read the settings in its init, because config was initialized before it.
+	fmt.Fprintln(w, database.DSN, cache.BACKEND) // Output: postgres://localhost/tutorial memory
+
+	/*
+	 * The same rules order every package of the tutorial, and the standard library packages they import.
+	 * That order changes whenever a package is added, so it is not written here; the runtime prints it:
+	 *
+	 *	go build -o tutorial . && GODEBUG=inittrace=1 ./tutorial list 2>&1 >/dev/null | grep golang-tutorial */
+}
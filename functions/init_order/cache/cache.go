@@ -0,0 +1,15 @@
+// The cache package depends on config, like the database package.
+package cache
+
+import (
+	"github.com/fajarstrtn/golang-tutorial/functions/init_order/config"
+	"github.com/fajarstrtn/golang-tutorial/functions/init_order/trace"
+)
+
+// BACKEND is read from the settings of config.
+var BACKEND string
+
+func init() {
+	BACKEND = config.SETTINGS["cache"]
+	trace.Record("cache")
+}
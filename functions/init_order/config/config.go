@@ -0,0 +1,12 @@
+// The config package stands for the settings an application reads before anything else.
+package config
+
+import "github.com/fajarstrtn/golang-tutorial/functions/init_order/trace"
+
+// SETTINGS is filled by init, before any package importing config is initialized.
+var SETTINGS map[string]string
+
+func init() {
+	SETTINGS = map[string]string{"database": "postgres://localhost/tutorial", "cache": "memory"}
+	trace.Record("config")
+}
@@ -0,0 +1,15 @@
+// The database package depends on config: its init can use SETTINGS, because config is initialized first.
+package database
+
+import (
+	"github.com/fajarstrtn/golang-tutorial/functions/init_order/config"
+	"github.com/fajarstrtn/golang-tutorial/functions/init_order/trace"
+)
+
+// DSN is read from the settings of config.
+var DSN string
+
+func init() {
+	DSN = config.SETTINGS["database"]
+	trace.Record("database")
+}
@@ -0,0 +1,12 @@
+/*
+ * The trace package records the packages of functions/init_order as they are initialized,
+ * so the functions/init lesson can print the order the runtime chose. */
+package trace
+
+// STEPS holds the name of every package that called Record, in the order of the calls.
+var STEPS []string
+
+// Record appends step to STEPS.
+func Record(step string) {
+	STEPS = append(STEPS, step)
+}
@@ -0,0 +1,54 @@
+package functions
+
+import (
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+/*
+ * Whatever packages the tutorial has, the runtime must initialize every package after the packages it imports.
+ * The order comes from GODEBUG=inittrace=1, which prints "init <package> @..." for every package with something to initialize,
+ * and the imports from go list. */
+func TestInitOrderFollowsImports(t *testing.T) {
+	binary := filepath.Join(t.TempDir(), "tutorial")
+	if out, err := exec.Command("go", "build", "-o", binary, "..").CombinedOutput(); err != nil {
+		t.Fatalf("go build: %v\n%s", err, out)
+	}
+
+	cmd := exec.Command(binary, "list")
+	cmd.Env = append(cmd.Environ(), "GODEBUG=inittrace=1")
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("%s list: %v\n%s", binary, err, out)
+	}
+
+	position := map[string]int{}
+	trace := regexp.MustCompile(`(?m)^init (\S+) @`)
+	for i, m := range trace.FindAllStringSubmatch(string(out), -1) {
+		position[m[1]] = i
+	}
+	if _, ok := position["github.com/fajarstrtn/golang-tutorial/functions"]; !ok {
+		t.Fatalf("the functions package is missing from the trace:\n%s", out)
+	}
+
+	out, err = exec.Command("go", "list", "-deps", "-f", `{{.ImportPath}}{{range .Imports}} {{.}}{{end}}`, "..").Output()
+	if err != nil {
+		t.Fatalf("go list: %v", err)
+	}
+
+	for _, line := range strings.Split(strings.TrimSpace(string(out)), "\n") {
+		fields := strings.Fields(line)
+		pkg, ok := position[fields[0]]
+		if !ok {
+			continue
+		}
+		for _, imported := range fields[1:] {
+			if dep, ok := position[imported]; ok && dep > pkg {
+				t.Errorf("%s was initialized before %s, which it imports", fields[0], imported)
+			}
+		}
+	}
+}
@@ -0,0 +1,12 @@
+package functions
+
+import "github.com/fajarstrtn/golang-tutorial/lesson"
+
+func init() {
+	lesson.Register(lesson.Lesson{ID: "functions/results", Title: "Multiple and named results", Topic: "functions", Order: 1100, Run: GenerateResults})
+	lesson.Register(lesson.Lesson{ID: "functions/variadic", Title: "Variadic parameters", Topic: "functions", Order: 1110, Run: GenerateVariadic})
+	lesson.Register(lesson.Lesson{ID: "functions/closures", Title: "Closures and captured state", Topic: "functions", Order: 1120, Run: GenerateClosures})
+	lesson.Register(lesson.Lesson{ID: "functions/values", Title: "Functions as values and types", Topic: "functions", Order: 1130, Run: GenerateFunctionValues})
+	lesson.Register(lesson.Lesson{ID: "functions/recursion", Title: "Recursion", Topic: "functions", Order: 1140, Run: GenerateRecursion})
+	lesson.Register(lesson.Lesson{ID: "functions/init", Title: "init functions and initialization order", Topic: "functions", Order: 1150, Run: GenerateInitOrder})
+}
@@ -0,0 +1,112 @@
+package functions
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+func getRecursion(w io.Writer) {
+	/*
+	 * factorial(n) is n * factorial(n - 1), down to the base case factorial(0) = 1.
+	 * Every call has its own n, on its own part of the stack. */
+	fmt.Fprintln(w, factorial(5), factorial(20)) // Output: 120 2432902008176640000
+
+	/*
+	 * Go stacks start small and grow as needed, so deep recursion works (up to 1 GB of stack on 64-bit),
+	 * but Go does not optimize tail calls: a loop is cheaper when it is as clear. */
+	fmt.Fprintln(w, sumTo(100_000)) // Output: 5000050000
+
+	// The naive Fibonacci makes an exponential number of calls; closures can memoize it (see functions/closures).
+	fmt.Fprintln(w, fib(20), fibCalls(20)) // Output: 6765 21891
+}
+
+func factorial(n int) int {
+	if n == 0 {
+		return 1
+	}
+	return n * factorial(n-1)
+}
+
+func sumTo(n int) int {
+	if n == 0 {
+		return 0
+	}
+	return n + sumTo(n-1)
+}
+
+func fib(n int) int {
+	if n < 2 {
+		return n
+	}
+	return fib(n-1) + fib(n-2)
+}
+
+// fibCalls counts the calls fib(n) makes, itself included.
+func fibCalls(n int) int {
+	if n < 2 {
+		return 1
+	}
+	return 1 + fibCalls(n-1) + fibCalls(n-2)
+}
+
+// A Node is a tree: every node has a name and children, which are nodes too.
+type Node struct {
+	Name     string
+	Children []*Node
+}
+
+func getRecursiveStructures(w io.Writer) {
+	tree := &Node{Name: "golang-tutorial", Children: []*Node{
+		{Name: "functions", Children: []*Node{{Name: "closure.go"}, {Name: "recursion.go"}}},
+		{Name: "lesson", Children: []*Node{{Name: "lesson.go"}}},
+	}}
+
+	/*
+	 * Recursion fits data that contains itself, like a tree of folders:
+	 * printing a node means printing its name, then printing each child one level deeper.
+	 *
+	 * Output:
+	 * golang-tutorial
+	 *   functions
+	 *     closure.go
+	 *     recursion.go
+	 *   lesson
+	 *     lesson.go */
+	printTree(w, tree, 0)
+	fmt.Fprintln(w, countNodes(tree), "nodes") // Output: 6 nodes
+}
+
+func printTree(w io.Writer, node *Node, depth int) {
+	fmt.Fprintln(w, strings.Repeat("  ", depth)+node.Name)
+	for _, child := range node.Children {
+		printTree(w, child, depth+1)
+	}
+}
+
+func countNodes(node *Node) int {
+	count := 1
+	for _, child := range node.Children {
+		count += countNodes(child)
+	}
+	return count
+}
+
+func getMutualRecursion(w io.Writer) {
+	// Two functions can call each other: the order of declarations does not matter in Go.
+	fmt.Fprintln(w, isEven(10), isOdd(7), isEven(3)) // Output: true true false
+}
+
+func isEven(n int) bool {
+	if n == 0 {
+		return true
+	}
+	return isOdd(n - 1)
+}
+
+func isOdd(n int) bool {
+	if n == 0 {
+		return false
+	}
+	return isEven(n - 1)
+}
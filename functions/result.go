@@ -0,0 +1,88 @@
+package functions
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+func getMultipleResults(w io.Writer) {
+	/*
+	 * The results are listed in parentheses, and return gives a value for each of them.
+	 * The caller must take all of them; _ (the blank identifier) throws one away. */
+	q, r := divide(17, 5)
+	fmt.Fprintln(w, q, r) // Output: 3 2
+
+	_, r = divide(20, 6)
+	fmt.Fprintln(w, r) // Output: 2
+
+	/*
+	 * The most common pair is a value and an error: the error is the last result,
+	 * and it is nil when everything went well (see the error handling lessons). */
+	first, err := firstWord("   ")
+	fmt.Fprintf(w, "%q %v\n", first, err) // Output: "" no words
+
+	first, err = firstWord("Hello Gopher")
+	fmt.Fprintf(w, "%q %v\n", first, err) // Output: "Hello" <nil>
+
+	/*
+	 * A call returning several results can be passed straight to a function taking as many parameters,
+	 * when it is the only argument: fmt.Fprintln(w, divide(9, 2)) does not compile. */
+	fmt.Fprintln(w, formatDivision(divide(9, 2))) // Output: 4 remainder 1
+}
+
+func formatDivision(quotient, remainder int) string {
+	return fmt.Sprintf("%d remainder %d", quotient, remainder)
+}
+
+func divide(a, b int) (int, int) {
+	return a / b, a % b
+}
+
+func firstWord(s string) (string, error) {
+	words := strings.Fields(s)
+	if len(words) == 0 {
+		return "", errors.New("no words")
+	}
+	return words[0], nil
+}
+
+func getNamedResults(w io.Writer) {
+	/*
+	 * Results can be named, like parameters:
+	 * 1. They start as zero values and can be set anywhere in the function
+	 * 2. A bare return (naked return) returns their current values
+	 * 3. Their names document what each result means
+	 * 4. A deferred function can change them (see the control_flow/defer lesson)
+	 *
+	 * Naked returns hurt readability in long functions: use them only in short ones. */
+	minimum, maximum := minMax([]int{4, -2, 9, 0})
+	fmt.Fprintln(w, minimum, maximum) // Output: -2 9
+
+	minimum, maximum = minMax(nil)
+	fmt.Fprintln(w, minimum, maximum) // Output: 0 0
+
+	name, domain, ok := splitEmail("gopher@example.com")
+	fmt.Fprintln(w, name, domain, ok) // Output: gopher example.com true
+}
+
+// minMax returns 0, 0 for an empty slice: the zero values of its named results.
+func minMax(numbers []int) (minimum, maximum int) {
+	if len(numbers) == 0 {
+		return
+	}
+
+	minimum, maximum = numbers[0], numbers[0]
+	for _, n := range numbers[1:] {
+		minimum = min(minimum, n)
+		maximum = max(maximum, n)
+	}
+	return
+}
+
+// splitEmail names its results so the signature says which string is which.
+func splitEmail(email string) (name, domain string, ok bool) {
+	name, domain, ok = strings.Cut(email, "@")
+	return name, domain, ok
+}
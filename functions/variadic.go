@@ -0,0 +1,79 @@
+package functions
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+)
+
+func getVariadic(w io.Writer) {
+	/*
+	 * sum takes any number of ints, including none.
+	 * Inside sum, numbers is a []int; with no arguments it is nil. */
+	fmt.Fprintln(w, sum())        // Output: 0
+	fmt.Fprintln(w, sum(1, 2, 3)) // Output: 6
+
+	// Other parameters come first: only the last one can be variadic.
+	fmt.Fprintln(w, join(", ", "a", "b", "c")) // Output: a, b, c
+}
+
+func sum(numbers ...int) int {
+	total := 0
+	for _, n := range numbers {
+		total += n
+	}
+	return total
+}
+
+func join(separator string, parts ...string) string {
+	return strings.Join(parts, separator)
+}
+
+func getVariadicSlice(w io.Writer) {
+	/*
+	 * A slice followed by ... is passed as the variadic parameter itself:
+	 * no new slice is made, so the function sees (and can change) the caller's backing array.
+	 * Separate arguments are put in a new slice on every call. */
+	numbers := []int{1, 2, 3}
+	fmt.Fprintln(w, sum(numbers...)) // Output: 6
+
+	double(numbers...)
+	fmt.Fprintln(w, numbers) // Output: [2 4 6]
+
+	double(numbers[0], numbers[1])
+	fmt.Fprintln(w, numbers) // Output: [2 4 6]
+
+	// A slice cannot be mixed with separate arguments: sum(1, numbers...) does not compile.
+	fmt.Fprintln(w, sum(append([]int{1}, numbers...)...)) // Output: 13
+}
+
+// double doubles the elements of its variadic parameter in place.
+func double(numbers ...int) {
+	for i := range numbers {
+		numbers[i] *= 2
+	}
+}
+
+func getPrintIsVariadic(w io.Writer) {
+	/*
+	 * fmt.Println is declared as func Println(a ...any) (n int, err error),
+	 * which is why it takes any number of values of any type.
+	 * A []any can be passed to it with ..., but a []string cannot: []string is not []any. */
+	fmt.Fprintf(w, "%T\n", fmt.Println)                       // Output: func(...interface {}) (int, error)
+	fmt.Fprintln(w, reflect.TypeOf(fmt.Println).IsVariadic()) // Output: true
+
+	values := []any{"go", 1, true}
+	fmt.Fprintln(w, values...) // Output: go 1 true
+	fmt.Fprintln(w, values)    // Output: [go 1 true]
+
+	/*
+	 * A function can pass its own variadic parameter on with ...,
+	 * which is how wrappers around fmt are written. */
+	logf(w, "%s has %d lessons", "functions", 6) // Output: [functions] functions has 6 lessons
+}
+
+// logf adds a prefix and passes its arguments on to fmt.Fprintf.
+func logf(w io.Writer, format string, args ...any) {
+	fmt.Fprintf(w, "[functions] "+format+"\n", args...)
+}
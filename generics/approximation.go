@@ -0,0 +1,63 @@
+package generics
+
+import (
+	"fmt"
+	"io"
+)
+
+// Celsius is a defined type: its underlying type is float64, but it is a type of its own, with its own methods.
+type Celsius float64
+
+// String makes fmt print a Celsius with its unit.
+func (c Celsius) String() string {
+	return fmt.Sprintf("%g°C", float64(c))
+}
+
+// Average returns the average of the values as a float64, or NaN without values.
+func Average[T Number](values ...T) float64 {
+	return float64(Sum(values...)) / float64(len(values))
+}
+
+func getApproximation(w io.Writer) {
+	/*
+	 * Sum accepts Celsius because Float has ~float64: every type whose underlying type is float64.
+	 * T is Celsius, not float64, so the result is a Celsius too, and keeps its String method. */
+	total := Sum(Celsius(20.5), Celsius(1.5))
+	fmt.Fprintf(w, "%v %T\n", total, total) // Output: 22°C generics.Celsius
+
+	/*
+	 * Without ~, the type set has float64 only, and Celsius is not in it.
+	 * The compiler reports it, and its message (not printed here, its wording changes between releases) suggests the missing ~.
+	 *
+	 * Output:
+	 * compile error: Celsius does not satisfy Float */
+	fmt.Fprintln(w, compileError(`
+type Float interface{ float32 | float64 }
+
+func Sum[T Float](values ...T) T {
+	var total T
+	for _, v := range values {
+		total += v
+	}
+	return total
+}
+
+type Celsius float64
+
+var total = Sum(Celsius(20.5), Celsius(1.5))`, "Celsius does not satisfy Float"))
+
+	/*
+	 * Inside a generic function, a conversion is allowed when every type of the type set allows it:
+	 * every Number converts to float64, so Average works for Celsius, int16 and the rest. */
+	fmt.Fprintln(w, Average(Celsius(20), Celsius(25)), Average[int16](1, 2)) // Output: 22.5 1.5
+
+	/*
+	 * ~ applies to an underlying type only: ~Celsius is an error, because the underlying type of Celsius is float64.
+	 *
+	 * Output:
+	 * compile error: invalid use of ~ */
+	fmt.Fprintln(w, compileError(`
+type Celsius float64
+
+type Temperature interface{ ~Celsius }`, "invalid use of ~"))
+}
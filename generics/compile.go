@@ -0,0 +1,58 @@
+package generics
+
+import (
+	"errors"
+	"go/ast"
+	"go/parser"
+	"go/scanner"
+	"go/token"
+	"go/types"
+	"strings"
+)
+
+/*
+ * compileError type-checks declarations as the compiler would, and tells whether it rejects them for reason:
+ * 1. "ok" when they compile
+ * 2. "compile error: " and reason when the first error the compiler reports contains reason
+ * 3. That whole first error otherwise (without its position), so an example failing for another reason shows up in verify
+ *
+ * The examples show with it the code that cannot be part of the tutorial, because it does not compile.
+ * The wording of the errors changes between Go releases, so only reason, a few stable words of the error, is printed.
+ * The declarations cannot import packages: they declare the constraints they use. */
+func compileError(declarations, reason string) string {
+	msg := firstCompileError(declarations)
+	switch {
+	case msg == "":
+		return "ok"
+	case reason != "" && strings.Contains(msg, reason):
+		return "compile error: " + reason
+	}
+	return msg
+}
+
+// firstCompileError returns the first error the parser or the type checker reports (without its position), or "".
+func firstCompileError(declarations string) string {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "example.go", "package example\n\n"+declarations, 0)
+	if err != nil {
+		var list scanner.ErrorList
+		if errors.As(err, &list) && len(list) > 0 {
+			return list[0].Msg
+		}
+		return err.Error()
+	}
+
+	var first error
+	conf := types.Config{Error: func(err error) {
+		if first == nil {
+			first = err
+		}
+	}}
+	conf.Check("example", fset, []*ast.File{file}, nil)
+
+	var typeErr types.Error
+	if errors.As(first, &typeErr) {
+		return typeErr.Msg
+	}
+	return ""
+}
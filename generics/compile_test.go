@@ -0,0 +1,45 @@
+package generics
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCompileError(t *testing.T) {
+	tests := []struct {
+		declarations string
+		reason       string
+		want         string
+	}{
+		{"func Sum[T int | float64](a, b T) T { return a + b }", "", "ok"},
+		{"func Sum[T int | float64](a, b T) T { return a + b }", "operator + not defined", "ok"},
+		{"func Sum[T any](a, b T) T { return a + b }", "operator + not defined", "compile error: operator + not defined"},
+	}
+
+	for _, tt := range tests {
+		if got := compileError(tt.declarations, tt.reason); got != tt.want {
+			t.Errorf("compileError(%q, %q) = %q, want %q", tt.declarations, tt.reason, got, tt.want)
+		}
+	}
+}
+
+// An error for another reason is printed whole, so verify reports the example; only stable words of it are checked.
+func TestCompileErrorForAnotherReason(t *testing.T) {
+	tests := []struct {
+		declarations string
+		reason       string
+		want         string
+	}{
+		{"func Sum[T any](a, b T) T { return a + b }", "cannot infer T", "operator + not defined"},
+		// Source that does not even parse is reported by the parser, without its position.
+		{"func Sum[T any](a, b T) T { return a + }", "", "expected operand"},
+		{"type", "", "expected 'IDENT'"},
+	}
+
+	for _, tt := range tests {
+		got := compileError(tt.declarations, tt.reason)
+		if !strings.Contains(got, tt.want) || strings.HasPrefix(got, "compile error: ") || strings.Contains(got, "example.go") {
+			t.Errorf("compileError(%q, %q) = %q, want the whole error, containing %q", tt.declarations, tt.reason, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package generics
+
+import (
+	"fmt"
+	"io"
+)
+
+/*
+ * The constraints of the examples, one per family of numeric types of the data_types lessons.
+ * A constraint that lists types (a type set) joins them with |, and ~ includes every type
+ * whose underlying type is the listed one (see the generics/approximation lesson).
+ * They are the same as the ones of the golang.org/x/exp/constraints package. */
+type (
+	Signed interface {
+		~int | ~int8 | ~int16 | ~int32 | ~int64
+	}
+
+	Unsigned interface {
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+	}
+
+	Integer interface {
+		Signed | Unsigned
+	}
+
+	Float interface {
+		~float32 | ~float64
+	}
+
+	// Number is every integer and float type: the types +, -, * and / work on.
+	Number interface {
+		Integer | Float
+	}
+)
+
+// Remainder returns a % b. % is not defined on floats, so it needs Integer, not Number.
+func Remainder[T Integer](a, b T) T {
+	return a % b
+}
+
+func getConstraints(w io.Writer) {
+	fmt.Fprintln(w, Remainder(int16(16_000), int16(120)), Remainder(uint(1200), uint(1800))) // Output: 40 1200
+
+	/*
+	 * Inside a generic function, an operation is allowed only when every type of the constraint supports it:
+	 * % works with Integer, but not with a constraint that has float64 in its type set.
+	 *
+	 * Output:
+	 * compile error: operator % not defined */
+	fmt.Fprintln(w, compileError(`func Rem[T interface{ ~int | ~float64 }](a, b T) T { return a % b }`, "operator % not defined"))
+
+	/*
+	 * An interface with a type set can only be a constraint: a variable cannot have that type,
+	 * because no value could hold "an int or a float64" without saying which.
+	 *
+	 * Output:
+	 * compile error: outside a type constraint */
+	fmt.Fprintln(w, compileError(`
+type Number interface{ ~int | ~float64 }
+
+var n Number`, "outside a type constraint"))
+
+	/*
+	 * A constraint can also require methods, like any interface, and combine them with a type set:
+	 * Named accepts only the string types that have a String method.
+	 *
+	 * Output:
+	 * compile error: missing method String */
+	fmt.Fprintln(w, compileError(`
+type Named interface {
+	~string
+	String() string
+}
+
+type Name string
+
+func Print[T Named](v T) string { return v.String() }
+
+var printed = Print(Name("Gopher"))`, "missing method String"))
+
+	/*
+	 * A type outside the type set is reported at the call, with the types the constraint expected.
+	 *
+	 * Output:
+	 * compile error: string does not satisfy Number */
+	fmt.Fprintln(w, compileError(`
+type Number interface{ ~int | ~float64 }
+
+func Half[T Number](v T) T { return v / 2 }
+
+var half = Half("4")`, "string does not satisfy Number"))
+}
+
+func getComparable(w io.Writer) {
+	/*
+	 * There are two predeclared constraints:
+	 * 1. any       : Every type (an alias of interface{}); only assignment and conversion to any are allowed
+	 * 2. comparable: Every type that supports == and != (e.g., the keys of a map)
+	 *
+	 * With any, even == does not compile, because some types (slices, maps, functions) cannot be compared.
+	 *
+	 * Output:
+	 * compile error: incomparable types in type set */
+	fmt.Fprintln(w, compileError(`
+func Index[T any](s []T, v T) int {
+	for i, x := range s {
+		if x == v {
+			return i
+		}
+	}
+	return -1
+}`, "incomparable types in type set"))
+
+	// Index is the same function with comparable.
+	fmt.Fprintln(w, Index([]string{"go", "is", "fun"}, "fun"), Index([]float64{1.5, 2.5}, 3)) // Output: 2 -1
+
+	/*
+	 * < needs an ordered type: cmp.Ordered (the integers, the floats and the strings), not comparable.
+	 *
+	 * Output:
+	 * compile error: cannot use operator < */
+	fmt.Fprintln(w, compileError(`func Less[T comparable](a, b T) bool { return a < b }`, "cannot use operator <"))
+}
+
+// Index returns the index of the first element equal to v, or -1 (slices.Index does the same).
+func Index[T comparable](s []T, v T) int {
+	for i, x := range s {
+		if x == v {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,97 @@
+// Code generated by "go run . examples"; DO NOT EDIT.
+
+package generics_test
+
+import (
+	"os"
+
+	"github.com/fajarstrtn/golang-tutorial/generics"
+	"github.com/fajarstrtn/golang-tutorial/lesson"
+)
+
+// ExampleGenerateApproximation runs the generics/approximation lesson.
+func ExampleGenerateApproximation() {
+	generics.GenerateApproximation(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// 22°C generics.Celsius
+	// compile error: Celsius does not satisfy Float
+	// 22.5 1.5
+	// compile error: invalid use of ~
+}
+
+// ExampleGenerateConstraints runs the generics/constraints lesson.
+func ExampleGenerateConstraints() {
+	generics.GenerateConstraints(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// 40 1200
+	// compile error: operator % not defined
+	// compile error: outside a type constraint
+	// compile error: missing method String
+	// compile error: string does not satisfy Number
+	// compile error: incomparable types in type set
+	// 2 -1
+	// compile error: cannot use operator <
+}
+
+// ExampleGenerateFunctions runs the generics/functions lesson.
+func ExampleGenerateFunctions() {
+	generics.GenerateFunctions(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// int16: 16120 15880 19456 133
+	// int: 1200000 -300000 337500000000 0
+	// float32: 19.602001 -4.6220007 90.71888 0.6183949
+	// float64: 20.016 -9.516 77.5215 0.3555465258025193
+	// 6 3.75 0
+	// -56
+	// 1 5
+	// apple pear
+	// NaN NaN
+	// func(...int16) int16
+	// 16040
+	// func(...string) (string, string)
+	// compile error: without instantiation
+}
+
+// ExampleGenerateGenericTypes runs the generics/types lesson.
+func ExampleGenerateGenericTypes() {
+	generics.GenerateGenericTypes(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// 2 true 1
+	// "" false
+	// generics.Stack[int] generics.Stack[string]
+	// [go=2009 rust=2015]
+	// compile error: without instantiation
+}
+
+// ExampleGenerateInference runs the generics/inference lesson.
+func ExampleGenerateInference() {
+	generics.GenerateInference(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// int16 float64
+	// ["1" "2" "3"] []string
+	// [40°C 51°C 36°C] generics.Temperatures 51°C
+	// []generics.Celsius
+	// compile error: cannot infer T
+	// compile error: does not match inferred type int
+	// compile error: overflows
+	// ok
+}
+
+// ExampleGenerateSlicesAndMaps runs the generics/slices-maps lesson.
+func ExampleGenerateSlicesAndMaps() {
+	generics.GenerateSlicesAndMaps(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// true 1
+	// 60 95
+	// [70 95 80 95 60] [60 70 80 95 95]
+	// 2 true
+	// [60 70 80 95]
+	// [Budi=31 Maya=31 Adi=25]
+	// 2
+	// [18°C 20°C 25.5°C] 25.5°C
+	// [Bandung Jakarta Medan]
+	// 15559363
+	// map[Bandung:2444160 Medan:2435252] false
+	// [Bandung=2444160 Medan=2435252]
+	// [1=false 2=true 3=true]
+}
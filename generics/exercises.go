@@ -0,0 +1,199 @@
+package generics
+
+import "github.com/fajarstrtn/golang-tutorial/exercise"
+
+func init() {
+	exercise.Register(exercise.Exercise{
+		ID:     "generics/functions/max",
+		Lesson: "generics/functions",
+		Title:  "Write one generic function instead of three",
+		Prompt: "Replace MaxInt, MaxString and MaxFloat with one generic function Max[T cmp.Ordered](a, b T) T, so main compiles. The output must be:\n7\npear\n2.5",
+		Starter: `package main
+
+import "fmt"
+
+func MaxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func MaxString(a, b string) string {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func MaxFloat(a, b float64) float64 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func main() {
+	fmt.Println(Max(3, 7))
+	fmt.Println(Max("apple", "pear"))
+	fmt.Println(Max(2.5, -1))
+}
+`,
+		Checks: []exercise.Check{
+			exercise.NoCalls("MaxInt", "MaxString", "MaxFloat"),
+			exercise.OutputIs("7\npear\n2.5"),
+		},
+	})
+
+	exercise.Register(exercise.Exercise{
+		ID:     "generics/constraints/number",
+		Lesson: "generics/constraints",
+		Title:  "Declare a constraint with a type set",
+		Prompt: "Declare a constraint Number whose type set is int, int64 and float64, and replace AverageInts and AverageFloats with Average[T Number](values ...T) float64. The output must be:\n2\n2.25",
+		Starter: `package main
+
+import "fmt"
+
+func AverageInts(values ...int) float64 {
+	total := 0
+	for _, v := range values {
+		total += v
+	}
+	return float64(total) / float64(len(values))
+}
+
+func AverageFloats(values ...float64) float64 {
+	total := 0.0
+	for _, v := range values {
+		total += v
+	}
+	return total / float64(len(values))
+}
+
+func main() {
+	fmt.Println(AverageInts(1, 2, 3))
+	fmt.Println(AverageFloats(1.5, 3))
+}
+`,
+		Checks: []exercise.Check{
+			exercise.Calls("Average", 2),
+			exercise.NoCalls("AverageInts", "AverageFloats"),
+			exercise.OutputIs("2\n2.25"),
+		},
+	})
+
+	exercise.Register(exercise.Exercise{
+		ID:     "generics/approximation/celsius",
+		Lesson: "generics/approximation",
+		Title:  "Accept defined types with ~",
+		Prompt: "Sum does not accept Celsius, whose underlying type is float64 but which is not float64 itself. Change the Number constraint so that it does. The output must be:\n41.5\n6",
+		Starter: `package main
+
+import "fmt"
+
+type Number interface {
+	int | float64
+}
+
+func Sum[T Number](values ...T) T {
+	var total T
+	for _, v := range values {
+		total += v
+	}
+	return total
+}
+
+type Celsius float64
+
+func main() {
+	fmt.Println(Sum(Celsius(20), Celsius(21.5)))
+	fmt.Println(Sum(1, 2, 3))
+}
+`,
+		Checks: []exercise.Check{
+			exercise.OutputIs("41.5\n6"),
+		},
+	})
+
+	exercise.Register(exercise.Exercise{
+		ID:     "generics/inference/explicit",
+		Lesson: "generics/inference",
+		Title:  "Give the type arguments that cannot be inferred",
+		Prompt: "Zero has no parameter of type T, so the compiler cannot infer T in main. Give the type arguments explicitly: int, string and bool. The output must be:\n0 \"\" false",
+		Starter: `package main
+
+import "fmt"
+
+func Zero[T any]() T {
+	var zero T
+	return zero
+}
+
+func main() {
+	fmt.Printf("%v %q %v\n", Zero(), Zero(), Zero())
+}
+`,
+		Checks: []exercise.Check{
+			exercise.Calls("fmt.Printf", 1),
+			exercise.OutputIs("0 \"\" false"),
+		},
+	})
+
+	exercise.Register(exercise.Exercise{
+		ID:     "generics/types/queue",
+		Lesson: "generics/types",
+		Title:  "Write a generic type",
+		Prompt: "Write a generic type Queue[T any] with two methods: Push(v T), which adds v at the end, and Pop() (T, bool), which removes the first value and returns it, or the zero value and false when the queue is empty. The output must be:\nfirst true\n1 true\n0 false",
+		Starter: `package main
+
+import "fmt"
+
+func main() {
+	var words Queue[string]
+	words.Push("first")
+	words.Push("second")
+	fmt.Println(words.Pop())
+
+	var numbers Queue[int]
+	numbers.Push(1)
+	fmt.Println(numbers.Pop())
+	fmt.Println(numbers.Pop())
+}
+`,
+		Checks: []exercise.Check{
+			exercise.OutputIs("first true\n1 true\n0 false"),
+		},
+	})
+
+	exercise.Register(exercise.Exercise{
+		ID:     "generics/slices-maps/sorted-keys",
+		Lesson: "generics/slices-maps",
+		Title:  "Sort the keys of a map with slices and maps",
+		Prompt: "Replace the loop and sort.Strings with one call to slices.Sorted over maps.Keys. The output must be:\n[Bandung Jakarta Medan]",
+		Starter: `package main
+
+import (
+	"fmt"
+	"sort"
+)
+
+func main() {
+	population := map[string]int{"Jakarta": 10_679_951, "Bandung": 2_444_160, "Medan": 2_435_252}
+
+	var cities []string
+	for city := range population {
+		cities = append(cities, city)
+	}
+	sort.Strings(cities)
+
+	fmt.Println(cities)
+}
+`,
+		Checks: []exercise.Check{
+			exercise.Calls("slices.Sorted", 1),
+			exercise.Calls("maps.Keys", 1),
+			exercise.NoCalls("sort.Strings"),
+			exercise.OutputIs("[Bandung Jakarta Medan]"),
+		},
+	})
+}
@@ -0,0 +1,98 @@
+package generics
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"math"
+)
+
+// Sum returns the sum of the values, or 0 without values, for any Number type.
+func Sum[T Number](values ...T) T {
+	var total T
+	for _, v := range values {
+		total += v
+	}
+	return total
+}
+
+/*
+ * MinMax returns the smallest and the largest of the values, for any ordered type
+ * (cmp.Ordered: the integers, the floats and the strings). It panics without values, like slices.Min. */
+func MinMax[T cmp.Ordered](values ...T) (lowest, highest T) {
+	if len(values) == 0 {
+		panic("MinMax: no values")
+	}
+
+	lowest, highest = values[0], values[0]
+	for _, v := range values[1:] {
+		lowest, highest = min(lowest, v), max(highest, v)
+	}
+	return lowest, highest
+}
+
+// Arithmetic returns the type of the operands and the results of a + b, a - b, a * b and a / b.
+func Arithmetic[T Number](a, b T) string {
+	return fmt.Sprintf("%T: %v %v %v %v", a, a+b, a-b, a*b, a/b)
+}
+
+func getGenericFunctions(w io.Writer) {
+	/*
+	 * The data_types lessons declare two variables of each type and repeat the four operations:
+	 * a parameter has one type, so a function for int16 cannot take float64.
+	 * Arithmetic takes a type parameter T, constrained by Number, and does it once for all of them.
+	 * The results are the ones of the data_types lessons, overflow of int16 (19456) and rounding of float32 included;
+	 * %v prints every digit a float needs, where data_types rounds them with %f and %.2f. */
+	var f, g int16 = 16_000, 120
+	h, i := 450_000, 750_000
+	var d, e float32 = 7.49, 12.112
+
+	fmt.Fprintln(w, Arithmetic(f, g))         // Output: int16: 16120 15880 19456 133
+	fmt.Fprintln(w, Arithmetic(h, i))         // Output: int: 1200000 -300000 337500000000 0
+	fmt.Fprintln(w, Arithmetic(d, e))         // Output: float32: 19.602001 -4.6220007 90.71888 0.6183949
+	fmt.Fprintln(w, Arithmetic(5.25, 14.766)) // Output: float64: 20.016 -9.516 77.5215 0.3555465258025193
+
+	// Sum takes any number of values of one Number type.
+	fmt.Fprintln(w, Sum(1, 2, 3), Sum(1.5, 2.25), Sum[float64]()) // Output: 6 3.75 0
+	fmt.Fprintln(w, Sum[int8](100, 100))                          // Output: -56
+
+	/*
+	 * MinMax needs <, so its constraint is cmp.Ordered, which has strings too.
+	 * It uses the built-in min and max (which are generic too): like them, a NaN makes the result NaN. */
+	low, high := MinMax(3, 1, 4, 1, 5)
+	fmt.Fprintln(w, low, high) // Output: 1 5
+
+	first, last := MinMax("pear", "apple", "fig")
+	fmt.Fprintln(w, first, last) // Output: apple pear
+
+	low64, high64 := MinMax(2.5, math.NaN(), 1)
+	fmt.Fprintln(w, low64, high64) // Output: NaN NaN
+}
+
+func getInstantiation(w io.Writer) {
+	/*
+	 * Giving the type arguments makes a normal (non-generic) function: instantiation.
+	 * A generic function cannot be used as a value before it is instantiated,
+	 * because a function value must have one type. */
+	var f int16 = 16_000
+	sum16 := Sum[int16]
+	fmt.Fprintf(w, "%T\n", sum16) // Output: func(...int16) int16
+	fmt.Fprintln(w, sum16(f, 40)) // Output: 16040
+
+	minMaxStrings := MinMax[string]
+	fmt.Fprintf(w, "%T\n", minMaxStrings) // Output: func(...string) (string, string)
+
+	/*
+	 * Output:
+	 * compile error: without instantiation */
+	fmt.Fprintln(w, compileError(`
+func Sum[T interface{ ~int | ~float64 }](values ...T) T {
+	var total T
+	for _, v := range values {
+		total += v
+	}
+	return total
+}
+
+var sum = Sum`, "without instantiation"))
+}
@@ -0,0 +1,62 @@
+/*
+ * Generics let one function or type work with many types: it declares type parameters
+ * in square brackets, each with a constraint that says which types it accepts:
+ *
+ *	func Sum[T Number](values ...T) T
+ *
+ * The caller's type (e.g., int16 or float64) replaces T, either given explicitly (Sum[int16])
+ * or inferred from the arguments. The function is type-checked once, against its constraint,
+ * so only the operations every type of the constraint supports are allowed inside it.
+ *
+ * The data_types lessons repeat the same arithmetic for int16, int, float32 and float64;
+ * this package writes it once with type parameters, then looks at constraints, ~ (approximation),
+ * type inference, generic types, and the generic slices and maps packages. */
+package generics
+
+import "github.com/fajarstrtn/golang-tutorial/lesson"
+
+/*
+ * A generic function declares type parameters before its parameters.
+ * Sum, MinMax and Arithmetic replace the per-type arithmetic of the data_types lessons. */
+func GenerateFunctions(env *lesson.Env) {
+	getGenericFunctions(env.Out)
+	getInstantiation(env.Out)
+}
+
+/*
+ * A constraint is an interface. Besides methods, it can list types (a type set):
+ * a type parameter accepts exactly the types of the set, and allows the operations they all support. */
+func GenerateConstraints(env *lesson.Env) {
+	getConstraints(env.Out)
+	getComparable(env.Out)
+}
+
+/*
+ * ~T in a constraint stands for every type whose underlying type is T,
+ * so a defined type such as type Celsius float64 satisfies ~float64 but not float64. */
+func GenerateApproximation(env *lesson.Env) {
+	getApproximation(env.Out)
+}
+
+/*
+ * The compiler infers the type arguments from the types of the arguments,
+ * and reports an error when it cannot, or when the arguments disagree. */
+func GenerateInference(env *lesson.Env) {
+	getInference(env.Out)
+	getInferenceFailures(env.Out)
+}
+
+/*
+ * Types can have type parameters too (e.g., Stack[T any]), and their methods use them.
+ * A generic type must be instantiated (Stack[int]) before it is used. */
+func GenerateGenericTypes(env *lesson.Env) {
+	getGenericTypes(env.Out)
+}
+
+/*
+ * The slices and maps packages of the standard library are generic:
+ * they work on a slice or a map of any element type, with the constraint each function needs. */
+func GenerateSlicesAndMaps(env *lesson.Env) {
+	getSlices(env.Out)
+	getMaps(env.Out)
+}
@@ -0,0 +1,77 @@
+package generics
+
+import (
+	"fmt"
+	"io"
+)
+
+// A Stack is a last-in first-out list of values of any type. Its zero value is an empty stack.
+type Stack[T any] struct {
+	items []T
+}
+
+// Push puts v on top of the stack.
+func (s *Stack[T]) Push(v T) {
+	s.items = append(s.items, v)
+}
+
+// Pop removes the value on top of the stack and returns it, or the zero value of T and false when it is empty.
+func (s *Stack[T]) Pop() (T, bool) {
+	var zero T
+	if len(s.items) == 0 {
+		return zero, false
+	}
+
+	v := s.items[len(s.items)-1]
+	s.items = s.items[:len(s.items)-1]
+	return v, true
+}
+
+// Len returns the number of values on the stack.
+func (s *Stack[T]) Len() int {
+	return len(s.items)
+}
+
+// A Pair holds two values of possibly different types, e.g., a key and its value.
+type Pair[K comparable, V any] struct {
+	Key   K
+	Value V
+}
+
+// String makes fmt print a Pair as key=value.
+func (p Pair[K, V]) String() string {
+	return fmt.Sprintf("%v=%v", p.Key, p.Value)
+}
+
+func getGenericTypes(w io.Writer) {
+	/*
+	 * A generic type is instantiated with its type arguments, like a function: Stack[int] and Stack[string]
+	 * are two different types. The methods are written once, with the receiver Stack[T],
+	 * and each instantiation gets them with T replaced. */
+	var numbers Stack[int]
+	numbers.Push(1)
+	numbers.Push(2)
+	top, ok := numbers.Pop()
+	fmt.Fprintln(w, top, ok, numbers.Len()) // Output: 2 true 1
+
+	var words Stack[string]
+	word, ok := words.Pop()
+	fmt.Fprintf(w, "%q %v\n", word, ok)       // Output: "" false
+	fmt.Fprintf(w, "%T %T\n", numbers, words) // Output: generics.Stack[int] generics.Stack[string]
+
+	// A composite literal can infer nothing: the type arguments of a generic type are always written.
+	pairs := []Pair[string, int]{{"go", 2009}, {"rust", 2015}}
+	fmt.Fprintln(w, pairs) // Output: [go=2009 rust=2015]
+
+	/*
+	 * A generic type cannot be used without its type arguments, even where they could be guessed.
+	 *
+	 * Output:
+	 * compile error: without instantiation */
+	fmt.Fprintln(w, compileError(`
+type Stack[T any] struct {
+	items []T
+}
+
+var stack Stack`, "without instantiation"))
+}
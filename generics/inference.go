@@ -0,0 +1,129 @@
+package generics
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// Map returns the results of f for every element of s. S and T are inferred from s and f.
+func Map[S, T any](s []S, f func(S) T) []T {
+	result := make([]T, 0, len(s))
+	for _, v := range s {
+		result = append(result, f(v))
+	}
+	return result
+}
+
+/*
+ * Scale multiplies every element by factor. S ~[]E keeps the slice type of the caller
+ * (e.g., Temperatures), where a []E parameter would turn it into a plain []E.
+ * The slices package declares its functions the same way. */
+func Scale[S ~[]E, E Number](s S, factor E) S {
+	result := make(S, len(s))
+	for i, v := range s {
+		result[i] = v * factor
+	}
+	return result
+}
+
+// ScaleSlice is Scale with a []E parameter: it always returns a []E.
+func ScaleSlice[E Number](s []E, factor E) []E {
+	return Scale(s, factor)
+}
+
+// Temperatures is a slice type with a method, to show which functions keep it.
+type Temperatures []Celsius
+
+// Max returns the highest temperature, or 0 without temperatures.
+func (t Temperatures) Max() Celsius {
+	if len(t) == 0 {
+		return 0
+	}
+	_, highest := MinMax(t...)
+	return highest
+}
+
+func getInference(w io.Writer) {
+	/*
+	 * The type arguments are usually inferred from the types of the arguments:
+	 * 1. A typed argument gives its type: Sum(f, 2) with f an int16 is Sum[int16]
+	 * 2. Only untyped constants: the default type of the "largest" kind, float64 for Sum(1, 2.5)
+	 * 3. A function argument gives its parameter and result types: Map with strconv.Itoa is Map[int, string] */
+	var f int16 = 16_000
+	fmt.Fprintf(w, "%T %T\n", Sum(f, 2), Sum(1, 2.5)) // Output: int16 float64
+
+	words := Map([]int{1, 2, 3}, strconv.Itoa)
+	fmt.Fprintf(w, "%q %T\n", words, words) // Output: ["1" "2" "3"] []string
+
+	/*
+	 * Inference also works through constraints: for Scale(temperatures, 2),
+	 * S is Temperatures, and S ~[]E makes E its element type, Celsius (the core type inference).
+	 * ScaleSlice infers E only, and returns a []Celsius, which has lost the Max method. */
+	temperatures := Temperatures{20, 25.5, 18}
+	scaled := Scale(temperatures, 2)
+	fmt.Fprintf(w, "%v %T %v\n", scaled, scaled, scaled.Max()) // Output: [40°C 51°C 36°C] generics.Temperatures 51°C
+
+	plain := ScaleSlice(temperatures, 2)
+	fmt.Fprintf(w, "%T\n", plain) // Output: []generics.Celsius
+}
+
+func getInferenceFailures(w io.Writer) {
+	/*
+	 * Inference uses the arguments only, never the result or the variable it is assigned to:
+	 * when no parameter uses T, the type argument must be given (Zero[int]()).
+	 *
+	 * Output:
+	 * compile error: cannot infer T */
+	fmt.Fprintln(w, compileError(`
+func Zero[T any]() T {
+	var zero T
+	return zero
+}
+
+var zero int = Zero()`, "cannot infer T"))
+
+	/*
+	 * Typed arguments must agree: one T cannot be int and float64, and nothing is converted implicitly
+	 * (untyped constants would be: Max(a, 2.5) would fail only because 2.5 does not fit in an int).
+	 *
+	 * Output:
+	 * compile error: does not match inferred type int */
+	fmt.Fprintln(w, compileError(`
+func Max[T interface{ ~int | ~float64 }](a, b T) T { return max(a, b) }
+
+var a int = 1
+var b float64 = 2.5
+var m = Max(a, b)`, "does not match inferred type int"))
+
+	/*
+	 * Given explicitly, the type argument converts the untyped constants,
+	 * so a constant that does not fit is reported as for any call.
+	 *
+	 * Output:
+	 * compile error: overflows */
+	fmt.Fprintln(w, compileError(`
+func Sum[T interface{ ~int8 | ~int }](values ...T) T {
+	var total T
+	for _, v := range values {
+		total += v
+	}
+	return total
+}
+
+var total = Sum[int8](1000)`, "overflows"))
+
+	/*
+	 * Assigning a generic function to a variable of a function type is the one place the target type is used:
+	 * the function is instantiated to match it.
+	 *
+	 * Output:
+	 * ok */
+	fmt.Fprintln(w, compileError(`
+func Zero[T any]() T {
+	var zero T
+	return zero
+}
+
+var zeroInt func() int = Zero`, ""))
+}
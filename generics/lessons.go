@@ -0,0 +1,12 @@
+package generics
+
+import "github.com/fajarstrtn/golang-tutorial/lesson"
+
+func init() {
+	lesson.Register(lesson.Lesson{ID: "generics/functions", Title: "Generic functions", Topic: "generics", Order: 1300, Run: GenerateFunctions})
+	lesson.Register(lesson.Lesson{ID: "generics/constraints", Title: "Constraint interfaces and type sets", Topic: "generics", Order: 1310, Run: GenerateConstraints})
+	lesson.Register(lesson.Lesson{ID: "generics/approximation", Title: "~ approximation constraints", Topic: "generics", Order: 1320, Run: GenerateApproximation})
+	lesson.Register(lesson.Lesson{ID: "generics/inference", Title: "Type inference and its failures", Topic: "generics", Order: 1330, Run: GenerateInference})
+	lesson.Register(lesson.Lesson{ID: "generics/types", Title: "Generic types", Topic: "generics", Order: 1340, Run: GenerateGenericTypes})
+	lesson.Register(lesson.Lesson{ID: "generics/slices-maps", Title: "The generic slices and maps packages", Topic: "generics", Order: 1350, Run: GenerateSlicesAndMaps})
+}
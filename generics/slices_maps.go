@@ -0,0 +1,83 @@
+package generics
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+)
+
+func getSlices(w io.Writer) {
+	/*
+	 * The slices package works on a slice of any element type.
+	 * Each function asks for the constraint it needs:
+	 * 1. any       : Clone, Reverse, Insert, Delete, ContainsFunc, SortFunc, ...
+	 * 2. comparable: Contains, Index, Equal, Compact
+	 * 3. cmp.Ordered: Sort, Min, Max, BinarySearch */
+	scores := []int{70, 95, 80, 95, 60}
+	fmt.Fprintln(w, slices.Contains(scores, 80), slices.Index(scores, 95)) // Output: true 1
+	fmt.Fprintln(w, slices.Min(scores), slices.Max(scores))                // Output: 60 95
+
+	sorted := slices.Clone(scores)
+	slices.Sort(sorted)
+	fmt.Fprintln(w, scores, sorted) // Output: [70 95 80 95 60] [60 70 80 95 95]
+
+	position, found := slices.BinarySearch(sorted, 80)
+	fmt.Fprintln(w, position, found) // Output: 2 true
+
+	fmt.Fprintln(w, slices.Compact(sorted)) // Output: [60 70 80 95]
+
+	/*
+	 * The Func versions take a function instead of a constraint, for types that have no order of their own.
+	 * cmp.Compare gives the order of two cmp.Ordered values, and cmp.Or picks the first comparison that is not 0. */
+	people := []Pair[string, int]{{"Maya", 31}, {"Adi", 25}, {"Budi", 31}}
+	slices.SortFunc(people, func(a, b Pair[string, int]) int {
+		return cmp.Or(cmp.Compare(b.Value, a.Value), strings.Compare(a.Key, b.Key))
+	})
+	fmt.Fprintln(w, people) // Output: [Budi=31 Maya=31 Adi=25]
+
+	younger := slices.IndexFunc(people, func(p Pair[string, int]) bool { return p.Value < 30 })
+	fmt.Fprintln(w, younger) // Output: 2
+
+	/*
+	 * The functions keep the slice type of their argument (their parameter is S ~[]E, like Scale):
+	 * sorting Temperatures gives Temperatures, with its Max method. */
+	temperatures := Temperatures{25.5, 18, 20}
+	slices.Sort(temperatures)
+	fmt.Fprintln(w, temperatures, slices.Clone(temperatures).Max()) // Output: [18°C 20°C 25.5°C] 25.5°C
+}
+
+func getMaps(w io.Writer) {
+	/*
+	 * The maps package works on a map of any key and value type.
+	 * maps.Keys and maps.Values return iterators (iter.Seq), in the random order of the map;
+	 * slices.Sorted collects an iterator into a sorted slice, and slices.Collect keeps the order. */
+	population := map[string]int{"Jakarta": 10_679_951, "Bandung": 2_444_160, "Medan": 2_435_252}
+	fmt.Fprintln(w, slices.Sorted(maps.Keys(population))) // Output: [Bandung Jakarta Medan]
+
+	fmt.Fprintln(w, Sum(slices.Collect(maps.Values(population))...)) // Output: 15559363
+
+	/*
+	 * maps.Clone copies a map, maps.Equal compares two of them (comparable values),
+	 * and maps.DeleteFunc removes the entries a function selects. */
+	small := maps.Clone(population)
+	maps.DeleteFunc(small, func(city string, people int) bool { return people > 5_000_000 })
+	fmt.Fprintln(w, small, maps.Equal(small, population)) // Output: map[Bandung:2444160 Medan:2435252] false
+
+	/*
+	 * Generic helpers combine with them. SortedPairs returns the entries of any map with ordered keys,
+	 * sorted by key, as a slice of Pair. */
+	fmt.Fprintln(w, SortedPairs(small))                                    // Output: [Bandung=2444160 Medan=2435252]
+	fmt.Fprintln(w, SortedPairs(map[int]bool{3: true, 1: false, 2: true})) // Output: [1=false 2=true 3=true]
+}
+
+// SortedPairs returns the entries of m as Pairs sorted by key.
+func SortedPairs[K cmp.Ordered, V any](m map[K]V) []Pair[K, V] {
+	pairs := make([]Pair[K, V], 0, len(m))
+	for _, key := range slices.Sorted(maps.Keys(m)) {
+		pairs = append(pairs, Pair[K, V]{key, m[key]})
+	}
+	return pairs
+}
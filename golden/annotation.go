@@ -0,0 +1,232 @@
+/*
+ * The golden package checks the "Output:" annotations written next to the lessons
+ * against what the lessons really print.
+ *
+ * Two styles of annotation are understood:
+ * 1. A line comment at the end of a statement:
+ *    fmt.Println(x) // Output: 10
+ * 2. A block comment right before a statement,
+ *    where everything after the "Output:" line is the expected output:
+ *    / *
+ *     * Output:
+ *     * 0 J
+ *     * 1 a * /
+ *    for i, c := range city { ... }
+ *
+ * Comments cannot hold trailing spaces (gofmt removes them),
+ * so trailing spaces and tabs at the end of every line are ignored when comparing. */
+package golden
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const OUTPUT_PREFIX = "Output:"
+
+/*
+ * An Annotation is one expected output written in the source code.
+ * Start and End are the byte offsets of the annotated statement in its file. */
+type Annotation struct {
+	ID    int
+	File  string
+	Line  int
+	Want  string
+	Start int
+	End   int
+}
+
+/*
+ * ParseModule finds every annotation in the Go files under root.
+ * Test files, hidden directories and testdata directories are skipped,
+ * and so are nested modules (directories with their own go.mod, e.g., the exercise workspace),
+ * which are not part of the module, just as for the go tool.
+ * The annotations are sorted by file and line, and their IDs follow that order. */
+func ParseModule(root string) ([]*Annotation, error) {
+	var annotations []*Annotation
+
+	err := filepath.WalkDir(root, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			name := d.Name()
+			if path != root && (strings.HasPrefix(name, ".") || name == "testdata") {
+				return filepath.SkipDir
+			}
+			if _, err := os.Stat(filepath.Join(path, "go.mod")); path != root && err == nil {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+
+		found, err := ParseFile(path)
+		if err != nil {
+			return err
+		}
+
+		annotations = append(annotations, found...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(annotations, func(i, j int) bool {
+		if annotations[i].File != annotations[j].File {
+			return annotations[i].File < annotations[j].File
+		}
+		return annotations[i].Line < annotations[j].Line
+	})
+
+	for i, a := range annotations {
+		a.ID = i
+	}
+
+	return annotations, nil
+}
+
+// ParseFile finds the annotations of a single Go file.
+func ParseFile(path string) ([]*Annotation, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, nil, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	// Line comments are found by the line they end on, block comments by the line after them.
+	trailing := map[int]string{}
+	leading := map[int]string{}
+
+	for _, group := range file.Comments {
+		for _, c := range group.List {
+			if !strings.HasPrefix(c.Text, "//") {
+				continue
+			}
+
+			// Only the single space after "Output:" is dropped, so padded output (e.g., %5d) keeps its padding.
+			text := strings.TrimPrefix(strings.TrimPrefix(c.Text, "//"), " ")
+			if strings.HasPrefix(text, OUTPUT_PREFIX) {
+				trailing[fset.Position(c.Pos()).Line] = normalize(strings.TrimPrefix(strings.TrimPrefix(text, OUTPUT_PREFIX), " "))
+			}
+		}
+
+		last := group.List[len(group.List)-1]
+		if want, ok := blockOutput(last.Text); ok {
+			leading[fset.Position(last.End()).Line+1] = want
+		}
+	}
+
+	if len(trailing) == 0 && len(leading) == 0 {
+		return nil, nil
+	}
+
+	byStmt := map[ast.Stmt]*Annotation{}
+	var annotations []*Annotation
+
+	annotate := func(stmt ast.Stmt, line int, want string) {
+		a, ok := byStmt[stmt]
+		if !ok {
+			a = &Annotation{
+				File:  path,
+				Line:  line,
+				Start: fset.Position(stmt.Pos()).Offset,
+				End:   fset.Position(stmt.End()).Offset,
+			}
+			byStmt[stmt] = a
+			annotations = append(annotations, a)
+		}
+		a.Want = want
+	}
+
+	/*
+	 * Every statement of every block is a candidate.
+	 * ast.Inspect visits outer statements first,
+	 * so when several statements end on the same line the innermost one wins. */
+	endsOn := map[int]ast.Stmt{}
+
+	ast.Inspect(file, func(n ast.Node) bool {
+		var list []ast.Stmt
+		switch n := n.(type) {
+		case *ast.BlockStmt:
+			list = n.List
+		case *ast.CaseClause:
+			list = n.Body
+		case *ast.CommClause:
+			list = n.Body
+		}
+
+		for _, stmt := range list {
+			endsOn[fset.Position(stmt.End()).Line] = stmt
+
+			line := fset.Position(stmt.Pos()).Line
+			if want, ok := leading[line]; ok {
+				annotate(stmt, line, want)
+			}
+		}
+
+		return true
+	})
+
+	for line, want := range trailing {
+		if stmt, ok := endsOn[line]; ok {
+			annotate(stmt, line, want)
+		}
+	}
+
+	return annotations, nil
+}
+
+/*
+ * blockOutput returns the expected output of a /* ... * / comment
+ * that contains an "Output:" line, without the leading " * " of every line. */
+func blockOutput(text string) (string, bool) {
+	if !strings.HasPrefix(text, "/*") {
+		return "", false
+	}
+
+	text = strings.TrimSuffix(strings.TrimPrefix(text, "/*"), "*/")
+
+	var lines []string
+	found := false
+
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimLeft(line, " \t")
+		line = strings.TrimPrefix(line, "*")
+		line = strings.TrimPrefix(line, " ")
+
+		if found {
+			lines = append(lines, line)
+		} else if strings.TrimSpace(line) == OUTPUT_PREFIX {
+			found = true
+		}
+	}
+
+	if !found {
+		return "", false
+	}
+
+	return normalize(strings.Join(lines, "\n")), true
+}
+
+/*
+ * normalize removes what comments cannot represent:
+ * trailing spaces and tabs on every line, and trailing empty lines. */
+func normalize(s string) string {
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t")
+	}
+
+	return strings.TrimRight(strings.Join(lines, "\n"), "\n")
+}
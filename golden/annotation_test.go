@@ -0,0 +1,35 @@
+package golden
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// A nested module (e.g., the exercise workspace) can hold code that does not compile, and must not be parsed.
+func TestParseModuleSkipsNestedModules(t *testing.T) {
+	root := t.TempDir()
+	files := map[string]string{
+		"go.mod":                     "module tutorial\n",
+		"lesson.go":                  "package lesson\n\nfunc f() {\n\tprintln(1) // Output: 1\n}\n",
+		"workspace/go.mod":           "module workspace\n",
+		"workspace/fix/this/main.go": "package main\n\nvar 1stPlace = 1\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(root, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	annotations, err := ParseModule(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(annotations) != 1 || annotations[0].Want != "1" {
+		t.Errorf("got %d annotations, want the one of lesson.go", len(annotations))
+	}
+}
@@ -0,0 +1,177 @@
+package golden
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/format"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const EXAMPLES_FILE = "example_test.go"
+
+/*
+ * An ExampleLesson is a lesson that is a plain exported function of a package,
+ * which is all a Go Example function can call:
+ * 1. LessonID  : ID in the lesson registry (e.g., data_types/booleans)
+ * 2. ImportPath: Import path of the package (e.g., github.com/fajarstrtn/golang-tutorial/data_types)
+ * 3. Func      : Name of the exported function (e.g., GenerateBooleans) */
+type ExampleLesson struct {
+	LessonID   string
+	ImportPath string
+	Func       string
+}
+
+/*
+ * An Example is the generated ExampleXxx function of one lesson.
+ * Output is empty when the output of the lesson cannot be checked,
+ * and Reason then says why; the example is still compiled, but not run. */
+type Example struct {
+	Lesson ExampleLesson
+	Output string
+	Reason string
+}
+
+/*
+ * GenerateExamples runs every lesson and returns the source of one example_test.go
+ * per package directory (relative to root), keyed by that directory.
+ *
+ * The // Output: block of each example is built from the annotations:
+ * every line the lesson prints must come from an annotated statement
+ * whose annotation matches the real output.
+ * If that is not the case, the example is written without an output block.
+ *
+ * Examples run in deterministic mode and send the log output to stdout as well,
+ * so log timestamps and memory addresses are checked like the rest of the output. */
+func GenerateExamples(root string, lessons []ExampleLesson) (map[string][]byte, error) {
+	modulePath, err := ModulePath(root)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := Build(root)
+	if err != nil {
+		return nil, err
+	}
+	defer p.Close()
+
+	byDir := map[string][]Example{}
+
+	for _, l := range lessons {
+		if l.ImportPath != modulePath && !strings.HasPrefix(l.ImportPath, modulePath+"/") {
+			return nil, fmt.Errorf("lesson %s: package %s is outside module %s", l.LessonID, l.ImportPath, modulePath)
+		}
+
+		example, err := p.example(l)
+		if err != nil {
+			return nil, err
+		}
+
+		dir := filepath.FromSlash(strings.TrimPrefix(strings.TrimPrefix(l.ImportPath, modulePath), "/"))
+		byDir[dir] = append(byDir[dir], example)
+	}
+
+	files := map[string][]byte{}
+	for dir, examples := range byDir {
+		src, err := examplesFile(modulePath, examples)
+		if err != nil {
+			return nil, err
+		}
+		files[dir] = src
+	}
+
+	return files, nil
+}
+
+func (p *Program) example(l ExampleLesson) (Example, error) {
+	example := Example{Lesson: l}
+
+	executions, parts, err := p.run([]string{"run", "--deterministic", l.LessonID})
+	if err != nil {
+		return example, err
+	}
+
+	for _, e := range executions {
+		if e.Got != e.Annotation.Want {
+			example.Reason = fmt.Sprintf("the output at %s does not match its annotation", e.Annotation.Position(p.Root))
+			return example, nil
+		}
+	}
+
+	var output strings.Builder
+	for _, part := range parts {
+		if part.Annotation == nil {
+			line, _, _ := strings.Cut(strings.TrimSpace(part.Text), "\n")
+			example.Reason = fmt.Sprintf("%q is printed by a statement without an Output annotation", line)
+			return example, nil
+		}
+
+		// The annotation has no trailing newlines, so they are taken from what was really printed.
+		output.WriteString(part.Annotation.Want)
+		output.WriteString(part.Text[len(strings.TrimRight(part.Text, "\n")):])
+	}
+
+	example.Output = output.String()
+	return example, nil
+}
+
+func examplesFile(modulePath string, examples []Example) ([]byte, error) {
+	sort.Slice(examples, func(i, j int) bool {
+		return examples[i].Lesson.Func < examples[j].Lesson.Func
+	})
+
+	importPath := examples[0].Lesson.ImportPath
+	pkg := path.Base(importPath)
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by \"go run . examples\"; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "package %s_test\n\n", pkg)
+	fmt.Fprintf(&b, "import (\n\t\"os\"\n\n\t%q\n\t%q\n)\n", importPath, modulePath+"/lesson")
+
+	for _, e := range examples {
+		fmt.Fprintf(&b, "\n// Example%s runs the %s lesson.\n", e.Lesson.Func, e.Lesson.LessonID)
+		if e.Output == "" && e.Reason != "" {
+			fmt.Fprintf(&b, "// It has no output block, because %s.\n", e.Reason)
+		}
+
+		fmt.Fprintf(&b, "func Example%s() {\n", e.Lesson.Func)
+		fmt.Fprintf(&b, "\t%s.%s(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))\n", pkg, e.Lesson.Func)
+
+		if e.Output != "" {
+			fmt.Fprintf(&b, "\t// Output:\n")
+			for _, line := range strings.Split(strings.TrimRight(e.Output, "\n"), "\n") {
+				fmt.Fprintf(&b, "\t// %s\n", line)
+			}
+		}
+
+		fmt.Fprintf(&b, "}\n")
+	}
+
+	return format.Source(b.Bytes())
+}
+
+// ModulePath reads the module path from the go.mod file in root.
+func ModulePath(root string) (string, error) {
+	f, err := os.Open(filepath.Join(root, "go.mod"))
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if fields := strings.Fields(scanner.Text()); len(fields) == 2 && fields[0] == "module" {
+			return strings.Trim(fields[1], `"`), nil
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+
+	return "", fmt.Errorf("no module line in %s", filepath.Join(root, "go.mod"))
+}
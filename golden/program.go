@@ -0,0 +1,290 @@
+package golden
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/fajarstrtn/golang-tutorial/progress"
+)
+
+/*
+ * Markers are printed with the builtin println (which writes to stderr)
+ * right before and right after every annotated statement.
+ * The program runs with stdout and stderr sent to the same pipe,
+ * so the markers and the real output arrive in the order they were written.
+ *
+ * The marker bytes are ASCII control characters that no lesson prints. */
+const (
+	MARKER_START = "\x1egolden:"
+	MARKER_END   = "\x1f\n"
+)
+
+/*
+ * A Program is a copy of the tutorial binary built with every annotated statement
+ * surrounded by markers. The source tree is never modified:
+ * the instrumented files are handed to the go tool with -overlay. */
+type Program struct {
+	Root        string
+	Annotations []*Annotation
+
+	dir    string
+	binary string
+}
+
+/*
+ * An Execution is one run of an annotated statement and what it really printed.
+ * A statement inside a loop has one execution per iteration. */
+type Execution struct {
+	Annotation *Annotation
+	Got        string
+}
+
+/*
+ * Build instruments the module found at root and compiles its main package.
+ * The caller must call Close to remove the temporary files. */
+func Build(root string) (*Program, error) {
+	annotations, err := ParseModule(root)
+	if err != nil {
+		return nil, err
+	}
+
+	dir, err := os.MkdirTemp("", "golden")
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Program{
+		Root:        root,
+		Annotations: annotations,
+		dir:         dir,
+		binary:      filepath.Join(dir, "tutorial"),
+	}
+
+	if err := p.build(); err != nil {
+		p.Close()
+		return nil, err
+	}
+
+	return p, nil
+}
+
+func (p *Program) build() error {
+	byFile := map[string][]*Annotation{}
+	for _, a := range p.Annotations {
+		byFile[a.File] = append(byFile[a.File], a)
+	}
+
+	overlay := map[string]map[string]string{"Replace": {}}
+
+	for file, annotations := range byFile {
+		src, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+
+		instrumented := filepath.Join(p.dir, fmt.Sprintf("%d_%s", len(overlay["Replace"]), filepath.Base(file)))
+		if err := os.WriteFile(instrumented, instrument(src, annotations), 0o644); err != nil {
+			return err
+		}
+
+		abs, err := filepath.Abs(file)
+		if err != nil {
+			return err
+		}
+		overlay["Replace"][abs] = instrumented
+	}
+
+	overlayJSON, err := json.Marshal(overlay)
+	if err != nil {
+		return err
+	}
+
+	overlayFile := filepath.Join(p.dir, "overlay.json")
+	if err := os.WriteFile(overlayFile, overlayJSON, 0o644); err != nil {
+		return err
+	}
+
+	cmd := exec.Command("go", "build", "-overlay", overlayFile, "-o", p.binary, ".")
+	cmd.Dir = p.Root
+
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("building the instrumented program: %v\n%s", err, out)
+	}
+
+	return nil
+}
+
+/*
+ * instrument inserts the markers around the annotated statements of one file.
+ * The markers are written on the same lines as the statements,
+ * so line numbers in compiler errors still point at the original file. */
+func instrument(src []byte, annotations []*Annotation) []byte {
+	type insertion struct {
+		offset int
+		text   string
+	}
+
+	var insertions []insertion
+	for _, a := range annotations {
+		insertions = append(insertions,
+			insertion{a.Start, fmt.Sprintf("println(%q);", marker('B', a.ID))},
+			insertion{a.End, fmt.Sprintf(";println(%q)", marker('E', a.ID))},
+		)
+	}
+
+	// Insert from the end of the file, so earlier offsets stay valid.
+	sort.SliceStable(insertions, func(i, j int) bool {
+		return insertions[i].offset > insertions[j].offset
+	})
+
+	out := append([]byte(nil), src...)
+	for _, ins := range insertions {
+		out = append(out[:ins.offset], append([]byte(ins.text), out[ins.offset:]...)...)
+	}
+
+	return out
+}
+
+// The builtin println adds a newline, which is where MARKER_END gets its "\n" from.
+func marker(kind byte, id int) string {
+	return MARKER_START + string(kind) + strconv.Itoa(id) + strings.TrimSuffix(MARKER_END, "\n")
+}
+
+/*
+ * A Part is a piece of the raw program output, in the order it was printed.
+ * Annotation is the outermost annotated statement that printed it,
+ * or nil when the output came from a statement without annotation. */
+type Part struct {
+	Annotation *Annotation
+	Text       string
+}
+
+/*
+ * Run runs the instrumented program with the given arguments
+ * (e.g., run format/verbs) and returns every annotated statement it executed.
+ * The output of a statement does include the output of annotated statements nested in it,
+ * only their markers are removed. */
+func (p *Program) Run(args ...string) ([]Execution, error) {
+	executions, _, err := p.run(args)
+	return executions, err
+}
+
+/*
+ * Transcript runs the instrumented program like Run,
+ * but returns the whole output split by the outermost statement that printed it. */
+func (p *Program) Transcript(args ...string) ([]Part, error) {
+	_, parts, err := p.run(args)
+	return parts, err
+}
+
+func (p *Program) run(args []string) ([]Execution, []Part, error) {
+	var out bytes.Buffer
+
+	cmd := exec.Command(p.binary, args...)
+	cmd.Dir = p.Root
+
+	// Checking the annotations is not the learner reading the lessons, so no progress is recorded.
+	cmd.Env = append(os.Environ(), progress.PROGRESS_FILE_ENV+"="+progress.PROGRESS_OFF)
+	cmd.Stdout = &out
+	cmd.Stderr = &out
+
+	if err := cmd.Run(); err != nil {
+		return nil, nil, fmt.Errorf("running %s: %v\n%s", strings.Join(args, " "), err, out.String())
+	}
+
+	return p.split(out.String())
+}
+
+func (p *Program) split(output string) ([]Execution, []Part, error) {
+	type open struct {
+		id  int
+		got strings.Builder
+	}
+
+	var (
+		stack      []*open
+		executions []Execution
+		parts      []Part
+	)
+
+	for output != "" {
+		i := strings.Index(output, MARKER_START)
+		if i < 0 {
+			i = len(output)
+		}
+
+		for _, o := range stack {
+			o.got.WriteString(output[:i])
+		}
+
+		if len(stack) == 0 && i > 0 {
+			parts = append(parts, Part{Text: output[:i]})
+		}
+
+		if i == len(output) {
+			break
+		}
+
+		output = output[i+len(MARKER_START):]
+		end := strings.Index(output, MARKER_END)
+		if end < 1 {
+			return nil, nil, fmt.Errorf("truncated golden marker in program output")
+		}
+
+		kind, id := output[0], output[1:end]
+		output = output[end+len(MARKER_END):]
+
+		n, err := strconv.Atoi(id)
+		if err != nil || n < 0 || n >= len(p.Annotations) {
+			return nil, nil, fmt.Errorf("bad golden marker %q in program output", id)
+		}
+
+		switch kind {
+		case 'B':
+			stack = append(stack, &open{id: n})
+		case 'E':
+			/*
+			 * A nested statement that panicked (and was recovered) never prints its end marker.
+			 * Its execution is dropped, since what it printed is incomplete. */
+			for len(stack) > 0 && stack[len(stack)-1].id != n {
+				stack = stack[:len(stack)-1]
+			}
+
+			if len(stack) == 0 {
+				return nil, nil, fmt.Errorf("unbalanced golden marker for %s", p.Annotations[n].Position(p.Root))
+			}
+
+			top := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			executions = append(executions, Execution{Annotation: p.Annotations[n], Got: normalize(top.got.String())})
+
+			if len(stack) == 0 {
+				parts = append(parts, Part{Annotation: p.Annotations[n], Text: top.got.String()})
+			}
+		}
+	}
+
+	return executions, parts, nil
+}
+
+// Close removes the instrumented files and the binary.
+func (p *Program) Close() error {
+	return os.RemoveAll(p.dir)
+}
+
+// Position returns the file:line of the annotation, relative to root when possible.
+func (a *Annotation) Position(root string) string {
+	file := a.File
+	if rel, err := filepath.Rel(root, file); err == nil {
+		file = rel
+	}
+
+	return fmt.Sprintf("%s:%d", filepath.ToSlash(file), a.Line)
+}
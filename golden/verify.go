@@ -0,0 +1,99 @@
+package golden
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+/*
+ * A Mismatch is an annotation whose statement printed something else.
+ * Only the first wrong execution of a statement is kept,
+ * so a wrong annotation inside a loop is reported once. */
+type Mismatch struct {
+	Annotation *Annotation
+	Lesson     string
+	Got        string
+}
+
+/*
+ * A Report is the result of Verify:
+ * 1. Checked   : Number of annotated statement executions that were compared
+ * 2. Mismatches: Annotations that differ from the real output
+ * 3. Unreached : Annotations that none of the selected lessons executed */
+type Report struct {
+	Root       string
+	Checked    int
+	Mismatches []Mismatch
+	Unreached  []*Annotation
+}
+
+/*
+ * Verify builds the instrumented tutorial found at root,
+ * runs every given lesson on its own and compares the output of every annotated statement.
+ * Lessons run in deterministic mode, so the annotations can show log timestamps and addresses. */
+func Verify(root string, lessonIDs []string) (*Report, error) {
+	p, err := Build(root)
+	if err != nil {
+		return nil, err
+	}
+	defer p.Close()
+
+	report := &Report{Root: root}
+	reached := map[int]bool{}
+
+	for _, id := range lessonIDs {
+		executions, err := p.Run("run", "--deterministic", id)
+		if err != nil {
+			return nil, err
+		}
+
+		reported := map[int]bool{}
+		for _, e := range executions {
+			reached[e.Annotation.ID] = true
+			report.Checked++
+
+			if e.Got != e.Annotation.Want && !reported[e.Annotation.ID] {
+				reported[e.Annotation.ID] = true
+				report.Mismatches = append(report.Mismatches, Mismatch{Annotation: e.Annotation, Lesson: id, Got: e.Got})
+			}
+		}
+	}
+
+	for _, a := range p.Annotations {
+		if !reached[a.ID] {
+			report.Unreached = append(report.Unreached, a)
+		}
+	}
+
+	return report, nil
+}
+
+// OK reports whether every executed annotation matched.
+func (r *Report) OK() bool {
+	return len(r.Mismatches) == 0
+}
+
+/*
+ * Write prints the report in the file:line: message format understood by editors:
+ * data_types/string.go:37: lesson data_types/strings
+ *     want: (0)
+ *     got : (0)  */
+func (r *Report) Write(w io.Writer) {
+	for _, m := range r.Mismatches {
+		fmt.Fprintf(w, "%s: lesson %s\n", m.Annotation.Position(r.Root), m.Lesson)
+		fmt.Fprintf(w, "    want: %s\n", indent(m.Annotation.Want))
+		fmt.Fprintf(w, "    got : %s\n", indent(m.Got))
+	}
+
+	for _, a := range r.Unreached {
+		fmt.Fprintf(w, "%s: not run by any selected lesson\n", a.Position(r.Root))
+	}
+
+	fmt.Fprintf(w, "%d outputs checked, %d mismatches, %d annotations not run\n", r.Checked, len(r.Mismatches), len(r.Unreached))
+}
+
+// indent lines up the continuation lines of a multi-line output under the first one.
+func indent(s string) string {
+	return strings.ReplaceAll(s, "\n", "\n          ")
+}
@@ -1,8 +1,12 @@
 package identifier
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/fajarstrtn/golang-tutorial/lesson"
+)
 
 // Try to call an exported variable from exported_variable.go file.
-func CallExportedVariable() {
-	fmt.Printf("%s called from main function\n", ExportedVariable) // Output: This is an exported variable called from main function
+func CallExportedVariable(env *lesson.Env) {
+	fmt.Fprintf(env.Out, "%s called from main function\n", ExportedVariable) // Output: This is an exported variable called from main function
 }
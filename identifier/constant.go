@@ -1,6 +1,10 @@
 package identifier
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/fajarstrtn/golang-tutorial/lesson"
+)
 
 // The value of constants must be assigned when you declare it.
 const PI float32 = 3.14
@@ -31,11 +35,11 @@ const (
  * 1. Typed constants (declared with a defined type)
  * 2. Untyped constants (declared without a type
  * and the type of the constant is inferred from the value) */
-func GenerateConstants() {
-	fmt.Println(PI)               // Output: 3.14
-	fmt.Println(SHAPE)            // Output: Circle
-	fmt.Println(COLOR)            // Output: Red
-	fmt.Println(BORDER_TYPE)      // Output: Thick
-	fmt.Println(BORDER_COLOR)     // Output: Black
-	fmt.Println(BORDER_THICKNESS) // Output: 2.5
+func GenerateConstants(env *lesson.Env) {
+	fmt.Fprintln(env.Out, PI)               // Output: 3.14
+	fmt.Fprintln(env.Out, SHAPE)            // Output: Circle
+	fmt.Fprintln(env.Out, COLOR)            // Output: Red
+	fmt.Fprintln(env.Out, BORDER_TYPE)      // Output: Thick
+	fmt.Fprintln(env.Out, BORDER_COLOR)     // Output: Black
+	fmt.Fprintln(env.Out, BORDER_THICKNESS) // Output: 2.5
 }
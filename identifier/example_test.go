@@ -0,0 +1,302 @@
+// Code generated by "go run . examples"; DO NOT EDIT.
+
+package identifier_test
+
+import (
+	"os"
+
+	"github.com/fajarstrtn/golang-tutorial/identifier"
+	"github.com/fajarstrtn/golang-tutorial/lesson"
+)
+
+// ExampleCallExportedVariable runs the identifier/exported-variable lesson.
+func ExampleCallExportedVariable() {
+	identifier.CallExportedVariable(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// This is an exported variable called from main function
+}
+
+// ExampleGenerateConstants runs the identifier/constants lesson.
+func ExampleGenerateConstants() {
+	identifier.GenerateConstants(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// 3.14
+	// Circle
+	// Red
+	// Thick
+	// Black
+	// 2.5
+}
+
+// ExampleGenerateIdentifiers runs the identifier/identifiers lesson.
+func ExampleGenerateIdentifiers() {
+	identifier.GenerateIdentifiers(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// Emerson Santiago
+	// Emerson
+	// Lillie Moon
+	// Moon
+	// Davis
+	// Pearl Davis
+	// This is an exported variable
+}
+
+// ExampleGenerateKeywords runs the identifier/keywords lesson.
+func ExampleGenerateKeywords() {
+	identifier.GenerateKeywords(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// 25 keywords
+	// break, case, chan, const, continue, default, defer, else, fallthrough, for, func, go, goto, if, import, interface, map, package, range, return, select, struct, switch, type, var
+	// Declaration: const, func, import, package, type, var
+	// Composite type: chan, interface, map, struct
+	// Control flow: break, case, continue, default, else, fallthrough, for, goto, if, range, return, select, switch
+	// Function call: defer, go
+	// break (Control flow): Leaves the innermost (or labelled) for, switch or select immediately.
+	// func f() {
+	// 	for {
+	// 		break
+	// 	}
+	// }
+	//
+	// case (Control flow): Starts one branch of a switch or select.
+	// func f(x int) string {
+	// 	switch x {
+	// 	case 1:
+	// 		return "one"
+	// 	}
+	// 	return "many"
+	// }
+	//
+	// chan (Composite type): Declares a channel type, used to send values between goroutines.
+	// var results = make(chan int, 1)
+	//
+	// const (Declaration): Declares constants, values fixed at compile time.
+	// const Pi = 3.14
+	//
+	// continue (Control flow): Skips the rest of the current iteration of a for loop.
+	// func f() {
+	// 	for i := 0; i < 3; i++ {
+	// 		if i == 1 {
+	// 			continue
+	// 		}
+	// 		println(i)
+	// 	}
+	// }
+	//
+	// default (Control flow): Marks the branch of a switch or select taken when no case matches.
+	// func f(x int) string {
+	// 	switch x {
+	// 	case 1:
+	// 		return "one"
+	// 	default:
+	// 		return "many"
+	// 	}
+	// }
+	//
+	// defer (Function call): Delays a function call until the surrounding function returns.
+	// func f() {
+	// 	defer println("done")
+	// 	println("working")
+	// }
+	//
+	// else (Control flow): Gives an if statement a branch for when its condition is false.
+	// func f(age int) string {
+	// 	if age >= 18 {
+	// 		return "adult"
+	// 	} else {
+	// 		return "minor"
+	// 	}
+	// }
+	//
+	// fallthrough (Control flow): Continues into the next case of a switch without checking it.
+	// func f(x int) {
+	// 	switch x {
+	// 	case 1:
+	// 		println("one")
+	// 		fallthrough
+	// 	case 2:
+	// 		println("one or two")
+	// 	}
+	// }
+	//
+	// for (Control flow): Go's only loop; also covers while and infinite loops.
+	// func f() {
+	// 	for i := 0; i < 3; i++ {
+	// 		println(i)
+	// 	}
+	// }
+	//
+	// func (Declaration): Declares a function, a method or a function literal.
+	// func add(a, b int) int {
+	// 	return a + b
+	// }
+	//
+	// go (Function call): Runs a function call in a new goroutine.
+	// func f() {
+	// 	go println("in the background")
+	// }
+	//
+	// goto (Control flow): Jumps to a label in the same function.
+	// func f() {
+	// 	i := 0
+	// loop:
+	// 	if i < 3 {
+	// 		i++
+	// 		goto loop
+	// 	}
+	// }
+	//
+	// if (Control flow): Runs a block only when a condition is true.
+	// func f(n int) bool {
+	// 	if n < 0 {
+	// 		return false
+	// 	}
+	// 	return true
+	// }
+	//
+	// import (Declaration): Makes another package available in the file.
+	// package main
+	//
+	// import "fmt"
+	//
+	// func main() {
+	// 	fmt.Println("Hello")
+	// }
+	//
+	// interface (Composite type): Declares a set of methods (or types) a type must have.
+	// type Shape interface {
+	// 	Area() float64
+	// }
+	//
+	// map (Composite type): Declares a map type, a hash table from keys to values.
+	// var ages = map[string]int{"Ayu": 20}
+	//
+	// package (Declaration): Names the package a file belongs to; every file starts with it.
+	// package greeting
+	//
+	// func Hello() string {
+	// 	return "Hello"
+	// }
+	//
+	// range (Control flow): Makes a for loop iterate over a string, slice, array, map, channel, integer or function.
+	// func f() {
+	// 	for i, r := range "Go" {
+	// 		println(i, r)
+	// 	}
+	// }
+	//
+	// return (Control flow): Ends a function, optionally giving back its results.
+	// func double(n int) int {
+	// 	return n * 2
+	// }
+	//
+	// select (Control flow): Waits on several channel operations and runs the first one ready.
+	// func f(c chan int) {
+	// 	select {
+	// 	case v := <-c:
+	// 		println(v)
+	// 	default:
+	// 		println("nothing ready")
+	// 	}
+	// }
+	//
+	// struct (Composite type): Declares a struct type, a group of named fields.
+	// type User struct {
+	// 	Name string
+	// 	Age  int
+	// }
+	//
+	// switch (Control flow): Picks one of several branches by value or by type.
+	// func f(day string) bool {
+	// 	switch day {
+	// 	case "Saturday", "Sunday":
+	// 		return true
+	// 	}
+	// 	return false
+	// }
+	//
+	// type (Declaration): Declares a new named type or a type alias.
+	// type Celsius float64
+	//
+	// var (Declaration): Declares variables, optionally with a type and an initial value.
+	// var count int
+}
+
+// ExampleGenerateVariablesUsingShortVarDec runs the identifier/short-var-dec lesson.
+func ExampleGenerateVariablesUsingShortVarDec() {
+	identifier.GenerateVariablesUsingShortVarDec(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// Full Name: Makayla Daugherty (string)
+	// Nick Name: Makkie (string)
+	// Age      : 18 (int)
+	// Address  : Flat 24, 9260 Windsor Road, Leisure Complex, London, West Yorkshire, LU7 3BD, United Kingdom (string)
+	// Is Male  : false (bool)
+	// Full Name: Jerry Edwards (string)
+	// Nick Name: Jerry (string)
+	// Age      : 17 (int)
+	// Address  : Flat 25, 9260 Windsor Road, Leisure Complex, London, West Yorkshire, LU7 3BD, United Kingdom (string)
+	// Is Male  : true (bool)
+	// Hello, Jerry Edwards!
+	// Have a nice day!
+	// Joey Greer
+	// Eliza Clayton
+	// Jane Doe
+}
+
+// ExampleGenerateVariablesUsingVar runs the identifier/var lesson.
+func ExampleGenerateVariablesUsingVar() {
+	identifier.GenerateVariablesUsingVar(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// Full Name: Turner Johnston (string)
+	// Nick Name: Turner (string)
+	// Age      : 17 (int8)
+	// Address  : Flat 23, 829 Victoria Road, Trading Estate, Nottingham, Northern Ireland, CF8 9QC, United Kingdom (string)
+	// Is Male  : true (bool)
+	// Full Name: Catherine Flores (string)
+	// Nick Name: Cathie (string)
+	// Age      : 16 (int)
+	// Address  : Flat 4, 7730 Manor Road, Education Campus, London, Northern Ireland, PJ5 7QE, United Kingdom (string)
+	// Is Male  : false (bool)
+	// Full Name:  (string)
+	// Nick Name:  (string)
+	// Age      : 0 (int)
+	// Address  :  (string)
+	// Is Male  : false (bool)
+	// Full Name: Dennis James (string)
+	// Nick Name: Dennis (string)
+	// Age      : 17 (int)
+	// Address  : Flat 1, 1925 Windsor Road, Market Square, Glasgow, Merseyside, JL5 1BF, United Kingdom (string)
+	// Is Male  : true (bool)
+	// Full Name: Annabella Marsh (string)
+	// Nick Name: Anne (string)
+	// Age      : 16 (int)
+	// Address  : Flat 2, 1926 Windsor Road, Market Square, Glasgow, Merseyside, JL5 1BF, United Kingdom (string)
+	// Is Male  : false (bool)
+	// Hello, Annabella Marsh!
+	// Have a nice day!
+	// Full Name: Jessica Solis (string)
+	// Nick Name: Jessica (string)
+	// Age      : 17 (int8)
+	// Address  : Flat 50, 6529 Church Street, Housing Estate, Edinburgh, West Midlands, RD3 4MV, United Kingdom (string)
+	// Is Male  : false (bool)
+	// Full Name: Alex Wong (string)
+	// Nick Name: Alex (string)
+	// Age      : 17 (int)
+	// Address  : Flat 1, 6530 Church Street, Housing Estate, Edinburgh, West Midlands, RD3 4MV, United Kingdom (string)
+	// Is Male  : true (bool)
+	// Full Name: Ronin Wolf (string)
+	// Nick Name: Ronin (string)
+	// Age      : 16 (int)
+	// Address  : Flat 3, 6530 Church Street, Housing Estate, Edinburgh, West Midlands, RD3 4MV, United Kingdom (string)
+	// Is Male  : true (bool)
+	// Full Name: Ruby Friedman (string)
+	// Nick Name: Ruby (string)
+	// Age      : 17 (int)
+	// Address  : Flat 4, 6531 Church Street, Housing Estate, Edinburgh, West Midlands, RD3 4MV, United Kingdom (string)
+	// Is Male  : false (bool)
+	// Full Name: Sarah Blair (string)
+	// Nick Name: Sarah (string)
+	// Age      : 17 (int)
+	// Address  : Flat 40, 7771 Victoria Street, Shopping Centre, Manchester, Wales, HG3 8ZT, United Kingdom (string)
+	// Is Male  : false (bool)
+}
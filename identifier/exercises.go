@@ -0,0 +1,126 @@
+package identifier
+
+import "github.com/fajarstrtn/golang-tutorial/exercise"
+
+func init() {
+	exercise.Register(exercise.Exercise{
+		ID:     "identifier/identifiers/fix-the-names",
+		Lesson: "identifier/identifiers",
+		Title:  "Fix the invalid identifiers",
+		Prompt: "The starter does not compile because of its variable names. Rename the variables into valid camelCase names so it prints Gold, then Silver.",
+		Starter: `package main
+
+import "fmt"
+
+func main() {
+	var 1stPlace string = "Gold"
+	var second-place string = "Silver"
+
+	fmt.Println(1stPlace)
+	fmt.Println(second-place)
+}
+`,
+		Checks: []exercise.Check{
+			exercise.OutputIs("Gold\nSilver"),
+		},
+	})
+
+	exercise.Register(exercise.Exercise{
+		ID:     "identifier/keywords/not-a-keyword",
+		Lesson: "identifier/keywords",
+		Title:  "Rename a variable that uses a keyword",
+		Prompt: "The starter uses the keyword type as a variable name. Rename the variable so the program compiles and prints admin.",
+		Starter: `package main
+
+import "fmt"
+
+func main() {
+	var type string = "admin"
+	fmt.Println(type)
+}
+`,
+		Checks: []exercise.Check{
+			exercise.OutputIs("admin"),
+		},
+	})
+
+	exercise.Register(exercise.Exercise{
+		ID:     "identifier/var/three-variables",
+		Lesson: "identifier/var",
+		Title:  "Declare three variables with var",
+		Prompt: "Declare three variables with the var keyword (a name, an age and whether the person is a student), one of them with an inferred type, and print them with fmt.Println(name, age, isStudent) so the output is: Ayu 20 true",
+		Starter: `package main
+
+import "fmt"
+
+func main() {
+	// Declare name, age and isStudent here.
+
+	fmt.Println("TODO")
+}
+`,
+		Checks: []exercise.Check{
+			exercise.VarDecls(3, 1),
+			exercise.OutputIs("Ayu 20 true"),
+		},
+	})
+
+	exercise.Register(exercise.Exercise{
+		ID:     "identifier/short-var-dec/swap",
+		Lesson: "identifier/short-var-dec",
+		Title:  "Declare two variables at once with :=",
+		Prompt: "Declare first and second in a single := statement with the values \"left\" and \"right\", then swap them with one assignment (first, second = ...) and print them with fmt.Println(first, second). The output must be: right left",
+		Starter: `package main
+
+import "fmt"
+
+func main() {
+	fmt.Println("TODO")
+}
+`,
+		Checks: []exercise.Check{
+			exercise.ShortVarDecls(2),
+			exercise.OutputIs("right left"),
+		},
+	})
+
+	exercise.Register(exercise.Exercise{
+		ID:     "identifier/constants/circle",
+		Lesson: "identifier/constants",
+		Title:  "Compute with constants",
+		Prompt: "Declare the constants PI (3.14) and RADIUS (10) in one const block and print the area of the circle with fmt.Println(PI * RADIUS * RADIUS). The output must be: 314",
+		Starter: `package main
+
+import "fmt"
+
+func main() {
+	fmt.Println("TODO")
+}
+`,
+		Checks: []exercise.Check{
+			exercise.ConstDecls(2),
+			exercise.OutputIs("314"),
+		},
+	})
+
+	exercise.Register(exercise.Exercise{
+		ID:     "identifier/exported-variable/export-it",
+		Lesson: "identifier/exported-variable",
+		Title:  "Declare an exported variable",
+		Prompt: "Declare a package-level variable with an exported name holding \"Welcome!\" and print it from main.",
+		Starter: `package main
+
+import "fmt"
+
+var welcomeMessage string = "Welcome!"
+
+func main() {
+	fmt.Println(welcomeMessage)
+}
+`,
+		Checks: []exercise.Check{
+			exercise.ExportedPackageVar(),
+			exercise.OutputIs("Welcome!"),
+		},
+	})
+}
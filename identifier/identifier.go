@@ -1,6 +1,10 @@
 package identifier
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/fajarstrtn/golang-tutorial/lesson"
+)
 
 /*
  * Identifiers are the user-defined names of the program components.
@@ -33,6 +37,9 @@ import "fmt"
  * but it is advisable to use an optimum length of 4–15 letters only
  * 7. Cannot contain spaces
  *
+ * ValidateIdentifier checks a name against these rules
+ * (try it with: go run . identifier full_name).
+ *
  * Variable names with more than one word can be difficult to read.
  * There are several techniques you can use to make them more readable:
  * 1. Camel Case                     : Each word, except the first, starts with a capital letter (e.g., fullName, graduatedSince).
@@ -44,7 +51,7 @@ import "fmt"
  * and the first letter of subsequent words is uppercase
  * (e.g., userID, parseRequest) for most identifiers,
  * including private (unexported) variables, functions, and methods. */
-func GenerateIdentifiers() {
+func GenerateIdentifiers(env *lesson.Env) {
 	var name string = "Emerson Santiago"
 	var _nickName string = "Emerson"
 	var Name string = "Lillie Moon"
@@ -52,11 +59,11 @@ func GenerateIdentifiers() {
 	var name2 string = "Davis"
 	var full_name string = "Pearl Davis"
 
-	fmt.Println(name)             // Output: Emerson Santiago
-	fmt.Println(_nickName)        // Output: Emerson
-	fmt.Println(Name)             // Output: Lillie Moon
-	fmt.Println(nickName)         // Output: Moon
-	fmt.Println(name2)            // Output: Davis
-	fmt.Println(full_name)        // Output: Pearl Davis
-	fmt.Println(ExportedVariable) // Output: This is an exported variable
+	fmt.Fprintln(env.Out, name)             // Output: Emerson Santiago
+	fmt.Fprintln(env.Out, _nickName)        // Output: Emerson
+	fmt.Fprintln(env.Out, Name)             // Output: Lillie Moon
+	fmt.Fprintln(env.Out, nickName)         // Output: Moon
+	fmt.Fprintln(env.Out, name2)            // Output: Davis
+	fmt.Fprintln(env.Out, full_name)        // Output: Pearl Davis
+	fmt.Fprintln(env.Out, ExportedVariable) // Output: This is an exported variable
 }
@@ -1,6 +1,11 @@
 package identifier
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+
+	"github.com/fajarstrtn/golang-tutorial/lesson"
+)
 
 /*
  * Keywords or Reserved Words are the words in a language that are used for
@@ -9,9 +14,222 @@ import "fmt"
  * These words are therefore not allowed to use as an identifier.
  * Doing this will result in a compile-time error.
  *
- * There are total 25 keywords present in the Go. */
-func GenerateKeywords() {
-	var keywords string = "break, case, chan, const, continue, default, defer, fallthrough, for, func, go, goto, if, import, interface, map, package, range, return, select, struct, switch, type, var"
+ * There are total 25 keywords present in the Go.
+ * The list below is not typed by hand: Keywords() reads it from the go/token package,
+ * the same package the Go tools use, so it can never miss one (see keyword_catalogue.go). */
+func GenerateKeywords(env *lesson.Env) {
+	keywords := Keywords()
+
+	names := make([]string, len(keywords))
+	for i, k := range keywords {
+		names[i] = k.Name
+	}
+
+	fmt.Fprintf(env.Out, "%d keywords\n", len(keywords)) // Output: 25 keywords
+	fmt.Fprintln(env.Out, strings.Join(names, ", "))     // Output: break, case, chan, const, continue, default, defer, else, fallthrough, for, func, go, goto, if, import, interface, map, package, range, return, select, struct, switch, type, var
+
+	/*
+	 * The keywords fall into four groups:
+	 * 1. Declaration   : They introduce a new name (a package, a constant, a type, ...)
+	 * 2. Composite type: They build a type out of other types
+	 * 3. Control flow  : They decide which statement runs next
+	 * 4. Function call : They change how and when a function call runs
+	 *
+	 * The control_flow lessons run an example of every control flow and function call keyword,
+	 * and the concurrency lessons put chan, go and select to work.
+	 *
+	 * Output:
+	 * Declaration: const, func, import, package, type, var
+	 * Composite type: chan, interface, map, struct
+	 * Control flow: break, case, continue, default, else, fallthrough, for, goto, if, range, return, select, switch
+	 * Function call: defer, go */
+	for _, category := range KEYWORD_CATEGORIES {
+		var names []string
+		for _, k := range KeywordsIn(category) {
+			names = append(names, k.Name)
+		}
+
+		fmt.Fprintf(env.Out, "%s: %s\n", category, strings.Join(names, ", "))
+	}
 
-	fmt.Println(keywords) // Output: break, case, chan, const, continue, default, defer, fallthrough, for, func, go, goto, if, import, interface, map, package, range, return, select, struct, switch, type, var
+	/*
+	 * Every keyword also comes with a one-line explanation and a tiny example.
+	 *
+	 * Output:
+	 * break (Control flow): Leaves the innermost (or labelled) for, switch or select immediately.
+	 * func f() {
+	 * 	for {
+	 * 		break
+	 * 	}
+	 * }
+	 *
+	 * case (Control flow): Starts one branch of a switch or select.
+	 * func f(x int) string {
+	 * 	switch x {
+	 * 	case 1:
+	 * 		return "one"
+	 * 	}
+	 * 	return "many"
+	 * }
+	 *
+	 * chan (Composite type): Declares a channel type, used to send values between goroutines.
+	 * var results = make(chan int, 1)
+	 *
+	 * const (Declaration): Declares constants, values fixed at compile time.
+	 * const Pi = 3.14
+	 *
+	 * continue (Control flow): Skips the rest of the current iteration of a for loop.
+	 * func f() {
+	 * 	for i := 0; i < 3; i++ {
+	 * 		if i == 1 {
+	 * 			continue
+	 * 		}
+	 * 		println(i)
+	 * 	}
+	 * }
+	 *
+	 * default (Control flow): Marks the branch of a switch or select taken when no case matches.
+	 * func f(x int) string {
+	 * 	switch x {
+	 * 	case 1:
+	 * 		return "one"
+	 * 	default:
+	 * 		return "many"
+	 * 	}
+	 * }
+	 *
+	 * defer (Function call): Delays a function call until the surrounding function returns.
+	 * func f() {
+	 * 	defer println("done")
+	 * 	println("working")
+	 * }
+	 *
+	 * else (Control flow): Gives an if statement a branch for when its condition is false.
+	 * func f(age int) string {
+	 * 	if age >= 18 {
+	 * 		return "adult"
+	 * 	} else {
+	 * 		return "minor"
+	 * 	}
+	 * }
+	 *
+	 * fallthrough (Control flow): Continues into the next case of a switch without checking it.
+	 * func f(x int) {
+	 * 	switch x {
+	 * 	case 1:
+	 * 		println("one")
+	 * 		fallthrough
+	 * 	case 2:
+	 * 		println("one or two")
+	 * 	}
+	 * }
+	 *
+	 * for (Control flow): Go's only loop; also covers while and infinite loops.
+	 * func f() {
+	 * 	for i := 0; i < 3; i++ {
+	 * 		println(i)
+	 * 	}
+	 * }
+	 *
+	 * func (Declaration): Declares a function, a method or a function literal.
+	 * func add(a, b int) int {
+	 * 	return a + b
+	 * }
+	 *
+	 * go (Function call): Runs a function call in a new goroutine.
+	 * func f() {
+	 * 	go println("in the background")
+	 * }
+	 *
+	 * goto (Control flow): Jumps to a label in the same function.
+	 * func f() {
+	 * 	i := 0
+	 * loop:
+	 * 	if i < 3 {
+	 * 		i++
+	 * 		goto loop
+	 * 	}
+	 * }
+	 *
+	 * if (Control flow): Runs a block only when a condition is true.
+	 * func f(n int) bool {
+	 * 	if n < 0 {
+	 * 		return false
+	 * 	}
+	 * 	return true
+	 * }
+	 *
+	 * import (Declaration): Makes another package available in the file.
+	 * package main
+	 *
+	 * import "fmt"
+	 *
+	 * func main() {
+	 * 	fmt.Println("Hello")
+	 * }
+	 *
+	 * interface (Composite type): Declares a set of methods (or types) a type must have.
+	 * type Shape interface {
+	 * 	Area() float64
+	 * }
+	 *
+	 * map (Composite type): Declares a map type, a hash table from keys to values.
+	 * var ages = map[string]int{"Ayu": 20}
+	 *
+	 * package (Declaration): Names the package a file belongs to; every file starts with it.
+	 * package greeting
+	 *
+	 * func Hello() string {
+	 * 	return "Hello"
+	 * }
+	 *
+	 * range (Control flow): Makes a for loop iterate over a string, slice, array, map, channel, integer or function.
+	 * func f() {
+	 * 	for i, r := range "Go" {
+	 * 		println(i, r)
+	 * 	}
+	 * }
+	 *
+	 * return (Control flow): Ends a function, optionally giving back its results.
+	 * func double(n int) int {
+	 * 	return n * 2
+	 * }
+	 *
+	 * select (Control flow): Waits on several channel operations and runs the first one ready.
+	 * func f(c chan int) {
+	 * 	select {
+	 * 	case v := <-c:
+	 * 		println(v)
+	 * 	default:
+	 * 		println("nothing ready")
+	 * 	}
+	 * }
+	 *
+	 * struct (Composite type): Declares a struct type, a group of named fields.
+	 * type User struct {
+	 * 	Name string
+	 * 	Age  int
+	 * }
+	 *
+	 * switch (Control flow): Picks one of several branches by value or by type.
+	 * func f(day string) bool {
+	 * 	switch day {
+	 * 	case "Saturday", "Sunday":
+	 * 		return true
+	 * 	}
+	 * 	return false
+	 * }
+	 *
+	 * type (Declaration): Declares a new named type or a type alias.
+	 * type Celsius float64
+	 *
+	 * var (Declaration): Declares variables, optionally with a type and an initial value.
+	 * var count int */
+	for i, k := range keywords {
+		if i > 0 {
+			fmt.Fprintln(env.Out)
+		}
+		fmt.Fprintf(env.Out, "%s (%s): %s\n", k.Name, k.Category, k.Explanation)
+		fmt.Fprintln(env.Out, k.Example)
+	}
 }
@@ -0,0 +1,202 @@
+package identifier
+
+import (
+	"go/token"
+	"sort"
+)
+
+/*
+ * A KeywordCategory groups the keywords by what they are used for. */
+type KeywordCategory string
+
+const (
+	DECLARATION    KeywordCategory = "Declaration"
+	COMPOSITE_TYPE KeywordCategory = "Composite type"
+	CONTROL_FLOW   KeywordCategory = "Control flow"
+	FUNCTION_CALL  KeywordCategory = "Function call"
+)
+
+// The categories in the order the lesson shows them.
+var KEYWORD_CATEGORIES = []KeywordCategory{DECLARATION, COMPOSITE_TYPE, CONTROL_FLOW, FUNCTION_CALL}
+
+/*
+ * A Keyword is one reserved word of Go:
+ * 1. Name       : The keyword itself (e.g., fallthrough)
+ * 2. Category   : What the keyword is used for
+ * 3. Explanation: One line about what it does
+ * 4. Example    : A tiny compilable piece of Go code using it.
+ *    It is a list of top-level declarations of a package without imports,
+ *    except the examples of package and import which are whole files. */
+type Keyword struct {
+	Name        string
+	Category    KeywordCategory
+	Explanation string
+	Example     string
+}
+
+/*
+ * Keywords returns every keyword of the Go language sorted by name.
+ *
+ * The list of names comes from the go/token package, so it is always the one of the compiler.
+ * The details come from keywordDetails; a keyword missing there is still returned,
+ * only with empty details (the tests of this package fail in that case). */
+func Keywords() []Keyword {
+	var keywords []Keyword
+
+	// go/token numbers the keywords in one block, from BREAK to VAR (between its keyword_beg and keyword_end).
+	for tok := token.BREAK; tok <= token.VAR; tok++ {
+		if !tok.IsKeyword() {
+			continue
+		}
+
+		k := keywordDetails[tok.String()]
+		k.Name = tok.String()
+		keywords = append(keywords, k)
+	}
+
+	sort.Slice(keywords, func(i, j int) bool {
+		return keywords[i].Name < keywords[j].Name
+	})
+
+	return keywords
+}
+
+// KeywordsIn returns the keywords of one category sorted by name.
+func KeywordsIn(category KeywordCategory) []Keyword {
+	var keywords []Keyword
+	for _, k := range Keywords() {
+		if k.Category == category {
+			keywords = append(keywords, k)
+		}
+	}
+
+	return keywords
+}
+
+var keywordDetails = map[string]Keyword{
+	"break": {
+		Category:    CONTROL_FLOW,
+		Explanation: "Leaves the innermost (or labelled) for, switch or select immediately.",
+		Example:     "func f() {\n\tfor {\n\t\tbreak\n\t}\n}",
+	},
+	"case": {
+		Category:    CONTROL_FLOW,
+		Explanation: "Starts one branch of a switch or select.",
+		Example:     "func f(x int) string {\n\tswitch x {\n\tcase 1:\n\t\treturn \"one\"\n\t}\n\treturn \"many\"\n}",
+	},
+	"chan": {
+		Category:    COMPOSITE_TYPE,
+		Explanation: "Declares a channel type, used to send values between goroutines.",
+		Example:     "var results = make(chan int, 1)",
+	},
+	"const": {
+		Category:    DECLARATION,
+		Explanation: "Declares constants, values fixed at compile time.",
+		Example:     "const Pi = 3.14",
+	},
+	"continue": {
+		Category:    CONTROL_FLOW,
+		Explanation: "Skips the rest of the current iteration of a for loop.",
+		Example:     "func f() {\n\tfor i := 0; i < 3; i++ {\n\t\tif i == 1 {\n\t\t\tcontinue\n\t\t}\n\t\tprintln(i)\n\t}\n}",
+	},
+	"default": {
+		Category:    CONTROL_FLOW,
+		Explanation: "Marks the branch of a switch or select taken when no case matches.",
+		Example:     "func f(x int) string {\n\tswitch x {\n\tcase 1:\n\t\treturn \"one\"\n\tdefault:\n\t\treturn \"many\"\n\t}\n}",
+	},
+	"defer": {
+		Category:    FUNCTION_CALL,
+		Explanation: "Delays a function call until the surrounding function returns.",
+		Example:     "func f() {\n\tdefer println(\"done\")\n\tprintln(\"working\")\n}",
+	},
+	"else": {
+		Category:    CONTROL_FLOW,
+		Explanation: "Gives an if statement a branch for when its condition is false.",
+		Example:     "func f(age int) string {\n\tif age >= 18 {\n\t\treturn \"adult\"\n\t} else {\n\t\treturn \"minor\"\n\t}\n}",
+	},
+	"fallthrough": {
+		Category:    CONTROL_FLOW,
+		Explanation: "Continues into the next case of a switch without checking it.",
+		Example:     "func f(x int) {\n\tswitch x {\n\tcase 1:\n\t\tprintln(\"one\")\n\t\tfallthrough\n\tcase 2:\n\t\tprintln(\"one or two\")\n\t}\n}",
+	},
+	"for": {
+		Category:    CONTROL_FLOW,
+		Explanation: "Go's only loop; also covers while and infinite loops.",
+		Example:     "func f() {\n\tfor i := 0; i < 3; i++ {\n\t\tprintln(i)\n\t}\n}",
+	},
+	"func": {
+		Category:    DECLARATION,
+		Explanation: "Declares a function, a method or a function literal.",
+		Example:     "func add(a, b int) int {\n\treturn a + b\n}",
+	},
+	"go": {
+		Category:    FUNCTION_CALL,
+		Explanation: "Runs a function call in a new goroutine.",
+		Example:     "func f() {\n\tgo println(\"in the background\")\n}",
+	},
+	"goto": {
+		Category:    CONTROL_FLOW,
+		Explanation: "Jumps to a label in the same function.",
+		Example:     "func f() {\n\ti := 0\nloop:\n\tif i < 3 {\n\t\ti++\n\t\tgoto loop\n\t}\n}",
+	},
+	"if": {
+		Category:    CONTROL_FLOW,
+		Explanation: "Runs a block only when a condition is true.",
+		Example:     "func f(n int) bool {\n\tif n < 0 {\n\t\treturn false\n\t}\n\treturn true\n}",
+	},
+	"import": {
+		Category:    DECLARATION,
+		Explanation: "Makes another package available in the file.",
+		Example:     "package main\n\nimport \"fmt\"\n\nfunc main() {\n\tfmt.Println(\"Hello\")\n}",
+	},
+	"interface": {
+		Category:    COMPOSITE_TYPE,
+		Explanation: "Declares a set of methods (or types) a type must have.",
+		Example:     "type Shape interface {\n\tArea() float64\n}",
+	},
+	"map": {
+		Category:    COMPOSITE_TYPE,
+		Explanation: "Declares a map type, a hash table from keys to values.",
+		Example:     "var ages = map[string]int{\"Ayu\": 20}",
+	},
+	"package": {
+		Category:    DECLARATION,
+		Explanation: "Names the package a file belongs to; every file starts with it.",
+		Example:     "package greeting\n\nfunc Hello() string {\n\treturn \"Hello\"\n}",
+	},
+	"range": {
+		Category:    CONTROL_FLOW,
+		Explanation: "Makes a for loop iterate over a string, slice, array, map, channel, integer or function.",
+		Example:     "func f() {\n\tfor i, r := range \"Go\" {\n\t\tprintln(i, r)\n\t}\n}",
+	},
+	"return": {
+		Category:    CONTROL_FLOW,
+		Explanation: "Ends a function, optionally giving back its results.",
+		Example:     "func double(n int) int {\n\treturn n * 2\n}",
+	},
+	"select": {
+		Category:    CONTROL_FLOW,
+		Explanation: "Waits on several channel operations and runs the first one ready.",
+		Example:     "func f(c chan int) {\n\tselect {\n\tcase v := <-c:\n\t\tprintln(v)\n\tdefault:\n\t\tprintln(\"nothing ready\")\n\t}\n}",
+	},
+	"struct": {
+		Category:    COMPOSITE_TYPE,
+		Explanation: "Declares a struct type, a group of named fields.",
+		Example:     "type User struct {\n\tName string\n\tAge  int\n}",
+	},
+	"switch": {
+		Category:    CONTROL_FLOW,
+		Explanation: "Picks one of several branches by value or by type.",
+		Example:     "func f(day string) bool {\n\tswitch day {\n\tcase \"Saturday\", \"Sunday\":\n\t\treturn true\n\t}\n\treturn false\n}",
+	},
+	"type": {
+		Category:    DECLARATION,
+		Explanation: "Declares a new named type or a type alias.",
+		Example:     "type Celsius float64",
+	},
+	"var": {
+		Category:    DECLARATION,
+		Explanation: "Declares variables, optionally with a type and an initial value.",
+		Example:     "var count int",
+	},
+}
@@ -0,0 +1,106 @@
+package identifier
+
+import (
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/scanner"
+	"go/token"
+	"go/types"
+	"strings"
+	"testing"
+)
+
+// TestKeywordsMatchGoToken fails when the catalogue drifts from the keywords of the language.
+func TestKeywordsMatchGoToken(t *testing.T) {
+	keywords := Keywords()
+	if len(keywords) != 25 {
+		t.Errorf("Keywords() returned %d keywords, the lesson teaches 25", len(keywords))
+	}
+
+	for _, k := range keywords {
+		if !token.IsKeyword(k.Name) || !token.Lookup(k.Name).IsKeyword() {
+			t.Errorf("%q is not a keyword according to go/token", k.Name)
+		}
+		if _, ok := keywordDetails[k.Name]; !ok {
+			t.Errorf("keyword %q has no entry in keywordDetails", k.Name)
+		}
+	}
+
+	for name, k := range keywordDetails {
+		if !token.IsKeyword(name) {
+			t.Errorf("keywordDetails has %q, which is not a keyword", name)
+		}
+		if k.Category == "" || k.Explanation == "" || k.Example == "" {
+			t.Errorf("keyword %q is missing its category, explanation or example", name)
+		}
+	}
+
+	var total int
+	for _, category := range KEYWORD_CATEGORIES {
+		total += len(KeywordsIn(category))
+	}
+	if total != len(keywords) {
+		t.Errorf("KEYWORD_CATEGORIES cover %d of %d keywords", total, len(keywords))
+	}
+}
+
+// TestKeywordExamplesCompile type-checks every example and makes sure it uses its keyword.
+func TestKeywordExamplesCompile(t *testing.T) {
+	for _, k := range Keywords() {
+		t.Run(k.Name, func(t *testing.T) {
+			src := k.Example
+			if !strings.HasPrefix(src, "package ") {
+				src = "package example\n\n" + src
+			}
+
+			fset := token.NewFileSet()
+			file, err := parser.ParseFile(fset, k.Name+".go", src, 0)
+			if err != nil {
+				t.Fatalf("example does not parse: %v\n%s", err, src)
+			}
+
+			conf := types.Config{Importer: importer.Default()}
+			if _, err := conf.Check("example", fset, []*ast.File{file}, nil); err != nil {
+				t.Fatalf("example does not compile: %v\n%s", err, src)
+			}
+
+			if !usesKeyword(src, k.Name) {
+				t.Errorf("example does not use the keyword %q:\n%s", k.Name, src)
+			}
+		})
+	}
+}
+
+// usesKeyword scans src and reports whether keyword appears as a token, not just inside a string.
+func usesKeyword(src, keyword string) bool {
+	fset := token.NewFileSet()
+	file := fset.AddFile("example.go", -1, len(src))
+
+	var s scanner.Scanner
+	s.Init(file, []byte(src), nil, 0)
+	for {
+		_, tok, _ := s.Scan()
+		if tok == token.EOF {
+			return false
+		}
+		if tok.IsKeyword() && tok.String() == keyword {
+			return true
+		}
+	}
+}
+
+// Every token that go/token calls a keyword must lie between BREAK and VAR, where Keywords looks for them.
+// ILLEGAL is the first token of go/token and TILDE the last one.
+func TestKeywordsCoverEveryKeywordToken(t *testing.T) {
+	var count int
+	for tok := token.ILLEGAL; tok <= token.TILDE; tok++ {
+		if tok.IsKeyword() {
+			count++
+		}
+	}
+
+	if count != 25 || len(Keywords()) != count {
+		t.Errorf("go/token has %d keyword tokens and Keywords() returns %d, want 25 for both", count, len(Keywords()))
+	}
+}
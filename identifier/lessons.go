@@ -0,0 +1,12 @@
+package identifier
+
+import "github.com/fajarstrtn/golang-tutorial/lesson"
+
+func init() {
+	lesson.Register(lesson.Lesson{ID: "identifier/identifiers", Title: "Naming rules for identifiers", Topic: "identifier", Order: 300, Run: GenerateIdentifiers})
+	lesson.Register(lesson.Lesson{ID: "identifier/keywords", Title: "Keywords (reserved words)", Topic: "identifier", Order: 310, Run: GenerateKeywords})
+	lesson.Register(lesson.Lesson{ID: "identifier/var", Title: "Variables declared with var", Topic: "identifier", Order: 320, Run: GenerateVariablesUsingVar})
+	lesson.Register(lesson.Lesson{ID: "identifier/short-var-dec", Title: "Short variable declaration (:=)", Topic: "identifier", Order: 330, Run: GenerateVariablesUsingShortVarDec})
+	lesson.Register(lesson.Lesson{ID: "identifier/constants", Title: "Constants", Topic: "identifier", Order: 340, Run: GenerateConstants})
+	lesson.Register(lesson.Lesson{ID: "identifier/exported-variable", Title: "Exported identifiers", Topic: "identifier", Order: 350, Run: CallExportedVariable})
+}
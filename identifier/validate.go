@@ -0,0 +1,208 @@
+package identifier
+
+import (
+	"fmt"
+	"go/token"
+	"go/types"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+/*
+ * A Convention is the way the words of an identifier are joined,
+ * as described in GenerateIdentifiers. */
+type Convention string
+
+const (
+	CAMEL_CASE           Convention = "camelCase"
+	PASCAL_CASE          Convention = "PascalCase"
+	SNAKE_CASE           Convention = "snake_case"
+	SCREAMING_SNAKE_CASE Convention = "SCREAMING_SNAKE_CASE"
+	LOWER_CASE           Convention = "lowercase"
+	UPPER_CASE           Convention = "UPPERCASE"
+	BLANK                Convention = "blank identifier"
+	UNCASED              Convention = "uncased"
+	NO_CONVENTION        Convention = "none"
+)
+
+// Length above which an identifier gets a warning (rule 6 in GenerateIdentifiers).
+const MAX_ADVISED_LENGTH = 15
+
+/*
+ * An IdentifierReport is the result of ValidateIdentifier:
+ * 1. Valid     : The name can be declared in Go (it follows the Go specification)
+ * 2. Problems  : Why the name is not valid, one message per broken rule
+ * 3. Exported  : The name would be visible from other packages
+ * 4. Convention: How the words of the name are joined
+ * 5. Warnings  : Legal but discouraged things (e.g., snake_case, shadowing len) */
+type IdentifierReport struct {
+	Name       string
+	Valid      bool
+	Problems   []string
+	Exported   bool
+	Convention Convention
+	Warnings   []string
+}
+
+/*
+ * ValidateIdentifier checks a name against the rules listed in GenerateIdentifiers.
+ *
+ * Go defines an identifier as a letter followed by letters and digits,
+ * where a letter is any Unicode letter or the underscore,
+ * and a digit is any Unicode decimal digit. Keywords can never be identifiers.
+ * An identifier is exported when its first character is an uppercase letter. */
+func ValidateIdentifier(name string) IdentifierReport {
+	report := IdentifierReport{Name: name}
+
+	if name == "" {
+		report.Problems = append(report.Problems, "An identifier cannot be empty.")
+		return report
+	}
+
+	// A literal U+FFFD (the replacement character) is valid UTF-8: only bytes that do not decode are rejected here.
+	if !utf8.ValidString(name) {
+		report.Problems = append(report.Problems, "It is not valid UTF-8.")
+		return report
+	}
+
+	first, _ := utf8.DecodeRuneInString(name)
+	switch {
+	case unicode.IsDigit(first):
+		report.Problems = append(report.Problems, fmt.Sprintf("Rule 3: it starts with the digit %q; identifiers should not start with a digit.", first))
+	case !isLetter(first):
+		report.Problems = append(report.Problems, fmt.Sprintf("Rule 1: it starts with %q; identifiers must begin with a letter or an underscore.", first))
+	}
+
+	if strings.ContainsFunc(name, unicode.IsSpace) {
+		report.Problems = append(report.Problems, "Rule 7: it contains spaces; identifiers cannot contain spaces.")
+	}
+
+	var invalid []string
+	for _, r := range name {
+		if !isLetter(r) && !unicode.IsDigit(r) && !unicode.IsSpace(r) {
+			invalid = append(invalid, fmt.Sprintf("%q", r))
+		}
+	}
+	if len(invalid) > 0 {
+		report.Problems = append(report.Problems, fmt.Sprintf("Rule 2: it contains %s; identifiers may only contain letters, digits and the underscore.", strings.Join(invalid, ", ")))
+	}
+
+	if token.IsKeyword(name) {
+		report.Problems = append(report.Problems, fmt.Sprintf("Rule 5: %s is a keyword and cannot be used as an identifier.", name))
+	}
+
+	report.Valid = len(report.Problems) == 0
+	if !report.Valid {
+		return report
+	}
+
+	report.Exported = token.IsExported(name)
+	report.Convention = namingConvention(name)
+	report.Warnings = identifierWarnings(name, report.Convention)
+
+	return report
+}
+
+func isLetter(r rune) bool {
+	return r == '_' || unicode.IsLetter(r)
+}
+
+// namingConvention tells which convention a valid identifier follows.
+func namingConvention(name string) Convention {
+	if name == "_" {
+		return BLANK
+	}
+
+	// A leading underscore (e.g., _nickName) does not change the convention of the rest.
+	words := strings.TrimLeft(name, "_")
+	if words == "" {
+		return NO_CONVENTION
+	}
+
+	hasUpper := strings.ContainsFunc(words, unicode.IsUpper)
+	hasLower := strings.ContainsFunc(words, unicode.IsLower)
+	first, _ := utf8.DecodeRuneInString(words)
+
+	if strings.Contains(words, "_") {
+		switch {
+		case hasUpper && !hasLower:
+			return SCREAMING_SNAKE_CASE
+		case !hasUpper:
+			return SNAKE_CASE
+		}
+		return NO_CONVENTION
+	}
+
+	switch {
+	case !hasUpper && !hasLower:
+		return UNCASED
+	case unicode.IsLower(first) && hasUpper:
+		return CAMEL_CASE
+	case unicode.IsLower(first):
+		return LOWER_CASE
+	case !hasLower && utf8.RuneCountInString(words) > 1:
+		return UPPER_CASE
+	case unicode.IsUpper(first):
+		return PASCAL_CASE
+	}
+
+	return NO_CONVENTION
+}
+
+func identifierWarnings(name string, convention Convention) []string {
+	var warnings []string
+	underscoresOnly := strings.Trim(name, "_") == ""
+
+	switch convention {
+	case SNAKE_CASE:
+		warnings = append(warnings, fmt.Sprintf("snake_case is uncommon in Go; use %s, or %s to export it.", toCamelCase(name, false), toCamelCase(name, true)))
+	case SCREAMING_SNAKE_CASE:
+		warnings = append(warnings, fmt.Sprintf("SCREAMING_SNAKE_CASE is used for constants in this tutorial, but Go style guides prefer %s.", toCamelCase(name, true)))
+	case BLANK:
+		warnings = append(warnings, "_ is the blank identifier: values assigned to it are thrown away and it can never be read.")
+	case NO_CONVENTION:
+		if underscoresOnly {
+			warnings = append(warnings, "It has only underscores: unlike _, it is an ordinary name that can be read, but it says nothing about its value.")
+		} else {
+			warnings = append(warnings, "It mixes underscores with upper and lower case letters; pick camelCase or PascalCase.")
+		}
+	case UNCASED:
+		warnings = append(warnings, "Its letters have no upper case (e.g., Japanese), so it can never be exported.")
+	}
+
+	if strings.HasPrefix(name, "_") && !underscoresOnly {
+		warnings = append(warnings, "A leading underscore has no special meaning in Go; it does not make the name private.")
+	}
+
+	if isPredeclared(name) {
+		warnings = append(warnings, fmt.Sprintf("%s is predeclared in Go; declaring it shadows the built-in %s.", name, name))
+	}
+
+	if n := utf8.RuneCountInString(name); n > MAX_ADVISED_LENGTH {
+		warnings = append(warnings, fmt.Sprintf("It is %d characters long; names of at most %d characters are easier to read.", n, MAX_ADVISED_LENGTH))
+	}
+
+	return warnings
+}
+
+// toCamelCase joins the words of a snake_case name (e.g., full_name becomes fullName or FullName).
+func toCamelCase(name string, exported bool) string {
+	var b strings.Builder
+
+	for i, word := range strings.FieldsFunc(strings.ToLower(name), func(r rune) bool { return r == '_' }) {
+		r, size := utf8.DecodeRuneInString(word)
+		if i > 0 || exported {
+			r = unicode.ToUpper(r)
+		}
+		b.WriteRune(r)
+		b.WriteString(word[size:])
+	}
+
+	return b.String()
+}
+
+// isPredeclared reports whether name is declared in the universe block (e.g., int, len, nil or true).
+func isPredeclared(name string) bool {
+	return types.Universe.Lookup(name) != nil
+}
@@ -0,0 +1,82 @@
+package identifier
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateIdentifier(t *testing.T) {
+	tests := []struct {
+		name       string
+		valid      bool
+		exported   bool
+		convention Convention
+		// A problem when the name is not valid, a warning otherwise; empty when there should be none.
+		message string
+	}{
+		{name: "userName", valid: true, convention: CAMEL_CASE},
+		{name: "UserName", valid: true, exported: true, convention: PASCAL_CASE},
+		{name: "MAX_SIZE", valid: true, exported: true, convention: SCREAMING_SNAKE_CASE, message: "Go style guides prefer MaxSize"},
+		{name: "full_name", valid: true, convention: SNAKE_CASE, message: "use fullName, or FullName"},
+
+		// Keywords can never be identifiers.
+		{name: "func", message: "Rule 5: func is a keyword"},
+		{name: "type", message: "Rule 5: type is a keyword"},
+
+		// Predeclared names are not keywords: they can be declared, but shadow the built-in.
+		{name: "len", valid: true, convention: LOWER_CASE, message: "len is predeclared"},
+		{name: "string", valid: true, convention: LOWER_CASE, message: "string is predeclared"},
+		{name: "nil", valid: true, convention: LOWER_CASE, message: "nil is predeclared"},
+
+		{name: "1stPlace", message: "Rule 3: it starts with the digit '1'"},
+		{name: "second-place", message: "Rule 2: it contains '-'"},
+		{name: "first name", message: "Rule 7: it contains spaces"},
+		{name: "", message: "cannot be empty"},
+
+		// Any Unicode letter is a letter, and any Unicode decimal digit a digit.
+		{name: "Ünïcode", valid: true, exported: true, convention: PASCAL_CASE},
+		{name: "π", valid: true, convention: LOWER_CASE},
+		{name: "x٣", valid: true, convention: LOWER_CASE},
+		{name: "名前", valid: true, convention: UNCASED, message: "can never be exported"},
+
+		{name: "_", valid: true, convention: BLANK, message: "blank identifier"},
+		{name: "_count", valid: true, convention: LOWER_CASE, message: "does not make the name private"},
+		{name: "__", valid: true, convention: NO_CONVENTION, message: "It has only underscores"},
+		{name: "Full_name", valid: true, exported: true, convention: NO_CONVENTION, message: "It mixes underscores"},
+
+		// Bytes that do not decode are not UTF-8, but U+FFFD itself is: it is only not a letter.
+		{name: "a\xffb", message: "It is not valid UTF-8."},
+		{name: "a�", message: "Rule 2: it contains '�'"},
+	}
+
+	for _, tt := range tests {
+		report := ValidateIdentifier(tt.name)
+
+		if report.Valid != tt.valid || report.Exported != tt.exported || report.Convention != tt.convention {
+			t.Errorf("ValidateIdentifier(%q) = valid %v, exported %v, convention %q; want %v, %v, %q",
+				tt.name, report.Valid, report.Exported, report.Convention, tt.valid, tt.exported, tt.convention)
+		}
+
+		messages := report.Warnings
+		if !tt.valid {
+			messages = report.Problems
+		}
+		joined := strings.Join(messages, "\n")
+		switch {
+		case tt.message == "" && len(messages) > 0:
+			t.Errorf("ValidateIdentifier(%q) reported %q, want nothing", tt.name, joined)
+		case !strings.Contains(joined, tt.message):
+			t.Errorf("ValidateIdentifier(%q) reported %q, want a message containing %q", tt.name, joined, tt.message)
+		}
+	}
+}
+
+// A name made only of underscores has no letters, so no warning may talk about letters or a leading underscore.
+func TestUnderscoresOnlyWarnings(t *testing.T) {
+	for _, name := range []string{"__", "___"} {
+		warnings := strings.Join(ValidateIdentifier(name).Warnings, "\n")
+		if strings.Contains(warnings, "letters") || strings.Contains(warnings, "leading underscore") {
+			t.Errorf("ValidateIdentifier(%q) warned %q", name, warnings)
+		}
+	}
+}
@@ -1,6 +1,10 @@
 package identifier
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/fajarstrtn/golang-tutorial/lesson"
+)
 
 /*
  * For constants, once variables declared and initialized, it cannot be changed.
@@ -46,7 +50,7 @@ const (
  * 3. Loop variables
  *
  * Go community rule of thumb: "Use := unless you need var". */
-func GenerateVariablesUsingVar() {
+func GenerateVariablesUsingVar(env *lesson.Env) {
 	/*
 	 * The var declaration of variables are used for those local variables
 	 * which need an explicit type that differs from the initializer expression,
@@ -69,11 +73,11 @@ func GenerateVariablesUsingVar() {
 	var address1 string = "Flat 23, 829 Victoria Road, Trading Estate, Nottingham, Northern Ireland, CF8 9QC, United Kingdom"
 	var isMale1 bool = true
 
-	fmt.Printf(FULLNAME_TEMPLATE, fullName1, fullName1) // Output: Full Name: Turner Johnston (string)
-	fmt.Printf(NICKNAME_TEMPLATE, nickName1, nickName1) // Output: Nick Name: Turner (string)
-	fmt.Printf(AGE_TEMPLATE, age1, age1)                // Output: Age      : 17 (int8)
-	fmt.Printf(ADDRESS_TEMPLATE, address1, address1)    // Output: Address  : Flat 23, 829 Victoria Road, Trading Estate, Nottingham, Northern Ireland, CF8 9QC, United Kingdom (string)
-	fmt.Printf(ISMALE_TEMPLATE, isMale1, isMale1)       // Output: Is Male  : true (bool)
+	fmt.Fprintf(env.Out, FULLNAME_TEMPLATE, fullName1, fullName1) // Output: Full Name: Turner Johnston (string)
+	fmt.Fprintf(env.Out, NICKNAME_TEMPLATE, nickName1, nickName1) // Output: Nick Name: Turner (string)
+	fmt.Fprintf(env.Out, AGE_TEMPLATE, age1, age1)                // Output: Age      : 17 (int8)
+	fmt.Fprintf(env.Out, ADDRESS_TEMPLATE, address1, address1)    // Output: Address  : Flat 23, 829 Victoria Road, Trading Estate, Nottingham, Northern Ireland, CF8 9QC, United Kingdom (string)
+	fmt.Fprintf(env.Out, ISMALE_TEMPLATE, isMale1, isMale1)       // Output: Is Male  : true (bool)
 
 	// Variables declared and initialized without the explicit type.
 	var fullName2 = "Catherine Flores"
@@ -82,11 +86,11 @@ func GenerateVariablesUsingVar() {
 	var address2 = "Flat 4, 7730 Manor Road, Education Campus, London, Northern Ireland, PJ5 7QE, United Kingdom"
 	var isMale2 = false
 
-	fmt.Printf(FULLNAME_TEMPLATE, fullName2, fullName2) // Output: Full Name: Catherine Flores (string)
-	fmt.Printf(NICKNAME_TEMPLATE, nickName2, nickName2) // Output: Nick Name: Cathie (string)
-	fmt.Printf(AGE_TEMPLATE, age2, age2)                // Output: Age      : 16 (int)
-	fmt.Printf(ADDRESS_TEMPLATE, address2, address2)    // Output: Address  : Flat 4, 7730 Manor Road, Education Campus, London, Northern Ireland, PJ5 7QE, United Kingdom (string)
-	fmt.Printf(ISMALE_TEMPLATE, isMale2, isMale2)       // Output: Is Male  : false (bool)
+	fmt.Fprintf(env.Out, FULLNAME_TEMPLATE, fullName2, fullName2) // Output: Full Name: Catherine Flores (string)
+	fmt.Fprintf(env.Out, NICKNAME_TEMPLATE, nickName2, nickName2) // Output: Nick Name: Cathie (string)
+	fmt.Fprintf(env.Out, AGE_TEMPLATE, age2, age2)                // Output: Age      : 16 (int)
+	fmt.Fprintf(env.Out, ADDRESS_TEMPLATE, address2, address2)    // Output: Address  : Flat 4, 7730 Manor Road, Education Campus, London, Northern Ireland, PJ5 7QE, United Kingdom (string)
+	fmt.Fprintf(env.Out, ISMALE_TEMPLATE, isMale2, isMale2)       // Output: Is Male  : false (bool)
 
 	/*
 	 * If the expression is removed, then the variable holds zero-value for the type
@@ -99,7 +103,7 @@ func GenerateVariablesUsingVar() {
 	 * 2. float64 (0.0)
 	 * 3. string (empty string)
 	 * 4. bool (false)
-	 * 5. pointer, slice, map, chan (nil)
+	 * 5. pointer, slice, map, chan (nil, see the pointer_types/nil lesson for using a nil pointer)
 	 *
 	 * Variables declared and initialized without expression.
 	 * No undefined like JavaScript. Go hates surprises. */
@@ -109,11 +113,11 @@ func GenerateVariablesUsingVar() {
 	var address3 string
 	var isMale3 bool
 
-	fmt.Printf(FULLNAME_TEMPLATE, fullName3, fullName3) // Output: Full Name:  (string)
-	fmt.Printf(NICKNAME_TEMPLATE, nickName3, nickName3) // Output: Nick Name:  (string)
-	fmt.Printf(AGE_TEMPLATE, age3, age3)                // Output: Age      : 0 (int)
-	fmt.Printf(ADDRESS_TEMPLATE, address3, address3)    // Output: Address  :  (string)
-	fmt.Printf(ISMALE_TEMPLATE, isMale3, isMale3)       // Output: Is Male  : false (bool)
+	fmt.Fprintf(env.Out, FULLNAME_TEMPLATE, fullName3, fullName3) // Output: Full Name:  (string)
+	fmt.Fprintf(env.Out, NICKNAME_TEMPLATE, nickName3, nickName3) // Output: Nick Name:  (string)
+	fmt.Fprintf(env.Out, AGE_TEMPLATE, age3, age3)                // Output: Age      : 0 (int)
+	fmt.Fprintf(env.Out, ADDRESS_TEMPLATE, address3, address3)    // Output: Address  :  (string)
+	fmt.Fprintf(env.Out, ISMALE_TEMPLATE, isMale3, isMale3)       // Output: Is Male  : false (bool)
 
 	/*
 	 * If you use type, then you are allowed to declare multiple variables
@@ -124,25 +128,25 @@ func GenerateVariablesUsingVar() {
 	// If the type keyword is not specified, you can declare different types of variables on the same line.
 	var age4, isMale4 = 17, true
 
-	fmt.Printf(FULLNAME_TEMPLATE, fullName4, fullName4) // Output: Full Name: Dennis James (string)
-	fmt.Printf(NICKNAME_TEMPLATE, nickName4, nickName4) // Output: Nick Name: Dennis (string)
-	fmt.Printf(AGE_TEMPLATE, age4, age4)                // Output: Age      : 17 (int8)
-	fmt.Printf(ADDRESS_TEMPLATE, address4, address4)    // Output: Address  : Flat 1, 1925 Windsor Road, Market Square, Glasgow, Merseyside, JL5 1BF, United Kingdom (string)
-	fmt.Printf(ISMALE_TEMPLATE, isMale4, isMale4)       // Output: Is Male  : true (bool)
+	fmt.Fprintf(env.Out, FULLNAME_TEMPLATE, fullName4, fullName4) // Output: Full Name: Dennis James (string)
+	fmt.Fprintf(env.Out, NICKNAME_TEMPLATE, nickName4, nickName4) // Output: Nick Name: Dennis (string)
+	fmt.Fprintf(env.Out, AGE_TEMPLATE, age4, age4)                // Output: Age      : 17 (int)
+	fmt.Fprintf(env.Out, ADDRESS_TEMPLATE, address4, address4)    // Output: Address  : Flat 1, 1925 Windsor Road, Market Square, Glasgow, Merseyside, JL5 1BF, United Kingdom (string)
+	fmt.Fprintf(env.Out, ISMALE_TEMPLATE, isMale4, isMale4)       // Output: Is Male  : true (bool)
 
 	// The type of variables is determined by the initialized values.
 	var fullName5, nickName5, age5, address5, isMale5 = "Annabella Marsh", "Anne", 16, "Flat 2, 1926 Windsor Road, Market Square, Glasgow, Merseyside, JL5 1BF, United Kingdom", false
 
-	fmt.Printf(FULLNAME_TEMPLATE, fullName5, fullName5) // Output: Full Name: Annabella Marsh (string)
-	fmt.Printf(NICKNAME_TEMPLATE, nickName5, nickName5) // Output: Nick Name: Anne (string)
-	fmt.Printf(AGE_TEMPLATE, age5, age5)                // Output: Age      : 16 (int)
-	fmt.Printf(ADDRESS_TEMPLATE, address5, address5)    // Output: Address  : Flat 2, 1926 Windsor Road, Market Square, Glasgow, Merseyside, JL5 1BF, United Kingdom (string)
-	fmt.Printf(ISMALE_TEMPLATE, isMale5, isMale5)       // Output: Is Male  : false (bool)
+	fmt.Fprintf(env.Out, FULLNAME_TEMPLATE, fullName5, fullName5) // Output: Full Name: Annabella Marsh (string)
+	fmt.Fprintf(env.Out, NICKNAME_TEMPLATE, nickName5, nickName5) // Output: Nick Name: Anne (string)
+	fmt.Fprintf(env.Out, AGE_TEMPLATE, age5, age5)                // Output: Age      : 16 (int)
+	fmt.Fprintf(env.Out, ADDRESS_TEMPLATE, address5, address5)    // Output: Address  : Flat 2, 1926 Windsor Road, Market Square, Glasgow, Merseyside, JL5 1BF, United Kingdom (string)
+	fmt.Fprintf(env.Out, ISMALE_TEMPLATE, isMale5, isMale5)       // Output: Is Male  : false (bool)
 
 	// You are allowed to initialize a set of variables by the calling function that returns multiple values.
 	var message1, message2 string = greet(fullName5)
-	fmt.Println(message1) // Output: Hello, Annabella Marsh!
-	fmt.Println(message2) // Output: Have a nice day!
+	fmt.Fprintln(env.Out, message1) // Output: Hello, Annabella Marsh!
+	fmt.Fprintln(env.Out, message2) // Output: Have a nice day!
 
 	var (
 		fullName6 string = "Jessica Solis"
@@ -152,11 +156,11 @@ func GenerateVariablesUsingVar() {
 		isMale6   bool   = false
 	)
 
-	fmt.Printf(FULLNAME_TEMPLATE, fullName6, fullName6) // Output: Full Name: Jessica Solis (string)
-	fmt.Printf(NICKNAME_TEMPLATE, nickName6, nickName6) // Output: Nick Name: Jessica (string)
-	fmt.Printf(AGE_TEMPLATE, age6, age6)                // Output: Age      : 17 (int8)
-	fmt.Printf(ADDRESS_TEMPLATE, address6, address6)    // Output: Address  : Flat 50, 6529 Church Street, Housing Estate, Edinburgh, West Midlands, RD3 4MV, United Kingdom (string)
-	fmt.Printf(ISMALE_TEMPLATE, isMale6, isMale6)       // Output: Is Male  : false (bool)
+	fmt.Fprintf(env.Out, FULLNAME_TEMPLATE, fullName6, fullName6) // Output: Full Name: Jessica Solis (string)
+	fmt.Fprintf(env.Out, NICKNAME_TEMPLATE, nickName6, nickName6) // Output: Nick Name: Jessica (string)
+	fmt.Fprintf(env.Out, AGE_TEMPLATE, age6, age6)                // Output: Age      : 17 (int8)
+	fmt.Fprintf(env.Out, ADDRESS_TEMPLATE, address6, address6)    // Output: Address  : Flat 50, 6529 Church Street, Housing Estate, Edinburgh, West Midlands, RD3 4MV, United Kingdom (string)
+	fmt.Fprintf(env.Out, ISMALE_TEMPLATE, isMale6, isMale6)       // Output: Is Male  : false (bool)
 
 	var (
 		fullName7 = "Alex Wong"
@@ -166,11 +170,11 @@ func GenerateVariablesUsingVar() {
 		isMale7   = true
 	)
 
-	fmt.Printf(FULLNAME_TEMPLATE, fullName7, fullName7) // Output: Full Name: Alex Wong (string)
-	fmt.Printf(NICKNAME_TEMPLATE, nickName7, nickName7) // Output: Nick Name: Alex (string)
-	fmt.Printf(AGE_TEMPLATE, age7, age7)                // Output: Age      : 17 (int)
-	fmt.Printf(ADDRESS_TEMPLATE, address7, address7)    // Output: Address  : Flat 1, 6530 Church Street, Housing Estate, Edinburgh, West Midlands, RD3 4MV, United Kingdom (string)
-	fmt.Printf(ISMALE_TEMPLATE, isMale7, isMale7)       // Output: Is Male  : true (bool)
+	fmt.Fprintf(env.Out, FULLNAME_TEMPLATE, fullName7, fullName7) // Output: Full Name: Alex Wong (string)
+	fmt.Fprintf(env.Out, NICKNAME_TEMPLATE, nickName7, nickName7) // Output: Nick Name: Alex (string)
+	fmt.Fprintf(env.Out, AGE_TEMPLATE, age7, age7)                // Output: Age      : 17 (int)
+	fmt.Fprintf(env.Out, ADDRESS_TEMPLATE, address7, address7)    // Output: Address  : Flat 1, 6530 Church Street, Housing Estate, Edinburgh, West Midlands, RD3 4MV, United Kingdom (string)
+	fmt.Fprintf(env.Out, ISMALE_TEMPLATE, isMale7, isMale7)       // Output: Is Male  : true (bool)
 
 	/*
 	 * If the value of a variable is known from the start,
@@ -184,11 +188,11 @@ func GenerateVariablesUsingVar() {
 		isMale8          = true                                                                                            // Type is inferred.
 	)
 
-	fmt.Printf(FULLNAME_TEMPLATE, fullName8, fullName8) // Output: Full Name: Ronin Wolf (string)
-	fmt.Printf(NICKNAME_TEMPLATE, nickName8, nickName8) // Output: Nick Name: Ronin (string)
-	fmt.Printf(AGE_TEMPLATE, age8, age8)                // Output: Age      : 16 (int)
-	fmt.Printf(ADDRESS_TEMPLATE, address8, address8)    // Output: Address  : Flat 3, 6530 Church Street, Housing Estate, Edinburgh, West Midlands, RD3 4MV, United Kingdom (string)
-	fmt.Printf(ISMALE_TEMPLATE, isMale8, isMale8)       // Output: Is Male  : true (bool)
+	fmt.Fprintf(env.Out, FULLNAME_TEMPLATE, fullName8, fullName8) // Output: Full Name: Ronin Wolf (string)
+	fmt.Fprintf(env.Out, NICKNAME_TEMPLATE, nickName8, nickName8) // Output: Nick Name: Ronin (string)
+	fmt.Fprintf(env.Out, AGE_TEMPLATE, age8, age8)                // Output: Age      : 16 (int)
+	fmt.Fprintf(env.Out, ADDRESS_TEMPLATE, address8, address8)    // Output: Address  : Flat 3, 6530 Church Street, Housing Estate, Edinburgh, West Midlands, RD3 4MV, United Kingdom (string)
+	fmt.Fprintf(env.Out, ISMALE_TEMPLATE, isMale8, isMale8)       // Output: Is Male  : true (bool)
 
 	/*
 	 * It is possible to assign a value to a variable after it is declared.
@@ -207,11 +211,11 @@ func GenerateVariablesUsingVar() {
 	address9 = "Flat 4, 6531 Church Street, Housing Estate, Edinburgh, West Midlands, RD3 4MV, United Kingdom"
 	isMale9 = false
 
-	fmt.Printf(FULLNAME_TEMPLATE, fullName9, fullName9) // Output: Full Name: Ruby Friedman (string)
-	fmt.Printf(NICKNAME_TEMPLATE, nickName9, nickName9) // Output: Nick Name: Ruby (string)
-	fmt.Printf(AGE_TEMPLATE, age9, age9)                // Output: Age      : 17 (int)
-	fmt.Printf(ADDRESS_TEMPLATE, address9, address9)    // Output: Address  : Flat 4, 6531 Church Street, Housing Estate, Edinburgh, West Midlands, RD3 4MV, United Kingdom (string)
-	fmt.Printf(ISMALE_TEMPLATE, isMale9, isMale9)       // Output: Is Male  : false (bool)
+	fmt.Fprintf(env.Out, FULLNAME_TEMPLATE, fullName9, fullName9) // Output: Full Name: Ruby Friedman (string)
+	fmt.Fprintf(env.Out, NICKNAME_TEMPLATE, nickName9, nickName9) // Output: Nick Name: Ruby (string)
+	fmt.Fprintf(env.Out, AGE_TEMPLATE, age9, age9)                // Output: Age      : 17 (int)
+	fmt.Fprintf(env.Out, ADDRESS_TEMPLATE, address9, address9)    // Output: Address  : Flat 4, 6531 Church Street, Housing Estate, Edinburgh, West Midlands, RD3 4MV, United Kingdom (string)
+	fmt.Fprintf(env.Out, ISMALE_TEMPLATE, isMale9, isMale9)       // Output: Is Male  : false (bool)
 
 	// Multiple variable declarations can also be grouped together into a block for greater readability.
 	var (
@@ -224,14 +228,14 @@ func GenerateVariablesUsingVar() {
 
 	address10 = "Flat 40, 7771 Victoria Street, Shopping Centre, Manchester, Wales, HG3 8ZT, United Kingdom"
 
-	fmt.Printf(FULLNAME_TEMPLATE, fullName10, fullName10) // Output: Full Name: Sarah Blair (string)
-	fmt.Printf(NICKNAME_TEMPLATE, nickName10, nickName10) // Output: Nick Name: Sarah (string)
-	fmt.Printf(AGE_TEMPLATE, age10, age10)                // Output: Age      : 17 (int)
-	fmt.Printf(ADDRESS_TEMPLATE, address10, address10)    // Output: Address  : Flat 40, 7771 Victoria Street, Shopping Centre, Manchester, Wales, HG3 8ZT, United Kingdom (string)
-	fmt.Printf(ISMALE_TEMPLATE, isMale10, isMale10)       // Output: Is Male  : false (bool)
+	fmt.Fprintf(env.Out, FULLNAME_TEMPLATE, fullName10, fullName10) // Output: Full Name: Sarah Blair (string)
+	fmt.Fprintf(env.Out, NICKNAME_TEMPLATE, nickName10, nickName10) // Output: Nick Name: Sarah (string)
+	fmt.Fprintf(env.Out, AGE_TEMPLATE, age10, age10)                // Output: Age      : 17 (int)
+	fmt.Fprintf(env.Out, ADDRESS_TEMPLATE, address10, address10)    // Output: Address  : Flat 40, 7771 Victoria Street, Shopping Centre, Manchester, Wales, HG3 8ZT, United Kingdom (string)
+	fmt.Fprintf(env.Out, ISMALE_TEMPLATE, isMale10, isMale10)       // Output: Is Male  : false (bool)
 }
 
-func GenerateVariablesUsingShortVarDec() {
+func GenerateVariablesUsingShortVarDec(env *lesson.Env) {
 	/*
 	 * Most of the local variables are declared and initialized (at the same time)
 	 * by using short variable declarations due to their brevity and flexibility.
@@ -249,11 +253,11 @@ func GenerateVariablesUsingShortVarDec() {
 	address1 := "Flat 24, 9260 Windsor Road, Leisure Complex, London, West Yorkshire, LU7 3BD, United Kingdom"
 	isMale1 := false
 
-	fmt.Printf(FULLNAME_TEMPLATE, fullName1, fullName1) // Output: Full Name: Makayla Daugherty (string)
-	fmt.Printf(NICKNAME_TEMPLATE, nickName1, nickName1) // Output: Nick Name: Makkie (string)
-	fmt.Printf(AGE_TEMPLATE, age1, age1)                // Output: Age      : 18 (int)
-	fmt.Printf(ADDRESS_TEMPLATE, address1, address1)    // Output: Address  : Flat 24, 9260 Windsor Road, Leisure Complex, London, West Yorkshire, LU7 3BD, United Kingdom (string)
-	fmt.Printf(ISMALE_TEMPLATE, isMale1, isMale1)       // Output: Is Male  : false (bool)
+	fmt.Fprintf(env.Out, FULLNAME_TEMPLATE, fullName1, fullName1) // Output: Full Name: Makayla Daugherty (string)
+	fmt.Fprintf(env.Out, NICKNAME_TEMPLATE, nickName1, nickName1) // Output: Nick Name: Makkie (string)
+	fmt.Fprintf(env.Out, AGE_TEMPLATE, age1, age1)                // Output: Age      : 18 (int)
+	fmt.Fprintf(env.Out, ADDRESS_TEMPLATE, address1, address1)    // Output: Address  : Flat 24, 9260 Windsor Road, Leisure Complex, London, West Yorkshire, LU7 3BD, United Kingdom (string)
+	fmt.Fprintf(env.Out, ISMALE_TEMPLATE, isMale1, isMale1)       // Output: Is Male  : false (bool)
 
 	/*
 	 * Using short variable declaration you are allowed to declare multiple variables
@@ -264,19 +268,19 @@ func GenerateVariablesUsingShortVarDec() {
 	// Using short variable declaration you are allowed to declare multiple variables in the single declaration.
 	age2, isMale2 := 17, true
 
-	fmt.Printf(FULLNAME_TEMPLATE, fullName2, fullName2) // Output: Full Name: Jerry Edwards (string)
-	fmt.Printf(NICKNAME_TEMPLATE, nickName2, nickName2) // Output: Nick Name: Jerry (string)
-	fmt.Printf(AGE_TEMPLATE, age2, age2)                // Output: Age      : 17 (int)
-	fmt.Printf(ADDRESS_TEMPLATE, address2, address2)    // Output: Address  : Flat 25, 9260 Windsor Road, Leisure Complex, London, West Yorkshire, LU7 3BD, United Kingdom (string)
-	fmt.Printf(ISMALE_TEMPLATE, isMale2, isMale2)       // Output: Is Male  : true (bool)
+	fmt.Fprintf(env.Out, FULLNAME_TEMPLATE, fullName2, fullName2) // Output: Full Name: Jerry Edwards (string)
+	fmt.Fprintf(env.Out, NICKNAME_TEMPLATE, nickName2, nickName2) // Output: Nick Name: Jerry (string)
+	fmt.Fprintf(env.Out, AGE_TEMPLATE, age2, age2)                // Output: Age      : 17 (int)
+	fmt.Fprintf(env.Out, ADDRESS_TEMPLATE, address2, address2)    // Output: Address  : Flat 25, 9260 Windsor Road, Leisure Complex, London, West Yorkshire, LU7 3BD, United Kingdom (string)
+	fmt.Fprintf(env.Out, ISMALE_TEMPLATE, isMale2, isMale2)       // Output: Is Male  : true (bool)
 
 	/*
 	 * In a short variable declaration,
 	 * you are allowed to initialize a set of variables
 	 * by the calling function that returns multiple values. */
 	message1, message2 := greet(fullName2)
-	fmt.Println(message1) // Output: Hello, Jerry Edwards!
-	fmt.Println(message2) // Output: Have a nice day!
+	fmt.Fprintln(env.Out, message1) // Output: Hello, Jerry Edwards!
+	fmt.Fprintln(env.Out, message2) // Output: Have a nice day!
 
 	/*
 	 * A short variable declaration acts like an assignment only when for
@@ -310,11 +314,12 @@ func GenerateVariablesUsingShortVarDec() {
 	 * 4. Outside functions: var (yes), := (no)
 	 * 5. Inside functions: var (yes), := (yes)
 	 * 6. Idiomatic for local: var (no), := (yes) */
-	fmt.Println(alias1) // Output: Joey Greer
-	fmt.Println(alias2) // Output: Eliza Clayton
-	fmt.Println(alias3) // Output: Jane Doe
+	fmt.Fprintln(env.Out, alias1) // Output: Joey Greer
+	fmt.Fprintln(env.Out, alias2) // Output: Eliza Clayton
+	fmt.Fprintln(env.Out, alias3) // Output: Jane Doe
 }
 
+// greet returns two values; the functions/results lesson shows multiple and named results.
 func greet(fullName string) (string, string) {
 	message1, message2 := "Hello, "+fullName+"!", "Have a nice day!"
 	return message1, message2
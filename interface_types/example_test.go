@@ -0,0 +1,76 @@
+// Code generated by "go run . examples"; DO NOT EDIT.
+
+package interface_types_test
+
+import (
+	"os"
+
+	"github.com/fajarstrtn/golang-tutorial/interface_types"
+	"github.com/fajarstrtn/golang-tutorial/lesson"
+)
+
+// ExampleGenerateFormatting runs the interface_types/formatting lesson.
+func ExampleGenerateFormatting() {
+	interface_types.GenerateFormatting(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// #ff8000
+	// #ff8000 #ff8000 "#ff8000"
+	// {255 128 0}
+	// [#ff8000 #000000]
+	// {Primary:#ff8000 secondary:{R:255 G:128 B:0}}
+	// {1250} $12.50
+	// interface_types.Color{R: 0xff, G: 0x80, B: 0x00}
+	// []interface_types.Color{interface_types.Color{R: 0xff, G: 0x80, B: 0x00}}
+	// struct { R uint8; G uint8; B uint8 }{R:0x0, G:0x0, B:0x0}
+	// 21.5°C
+	// 21.5°C (70.7°F)
+	// interface_types.Temperature(21.5)
+	// 70.7°F
+	// 21.500|    21.5|2.150000e+01
+	// [    21.5°C][21.5°C    ]
+	// %!d(interface_types.Temperature=21.5)
+}
+
+// ExampleGenerateInterfaces runs the interface_types/interfaces lesson.
+func ExampleGenerateInterfaces() {
+	interface_types.GenerateInterfaces(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// interface_types.Rectangle area 12.00 perimeter 14.00
+	// interface_types.Circle area 3.14 perimeter 6.28
+	// 15.14
+	// 42 hello 3.5 true {2} <nil>
+	// int
+	// string
+	// float64
+	// bool
+	// interface_types.Circle
+	// <nil>
+	// 2
+	// {0 0} false
+	// false
+	// interface conversion: interface_types.Shape is interface_types.Circle, not interface_types.Rectangle
+	// int 42, doubled 84
+	// string "hello" of 5 bytes
+	// shape with area 12.00
+	// number 3.5 of type float64
+	// nil
+	// other []int
+	// written, then read
+	// true false
+}
+
+// ExampleGenerateNilInterfaces runs the interface_types/nil lesson.
+func ExampleGenerateNilInterfaces() {
+	interface_types.GenerateNilInterfaces(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// true
+	// <nil> <nil>
+	// runtime error: invalid memory address or nil pointer dereference
+	// true false
+	// <nil> *interface_types.Circle
+	// runtime error: invalid memory address or nil pointer dereference
+	// true false true
+	// true
+	// *interface_types.ValidationError
+	// false age is invalid
+}
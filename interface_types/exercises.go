@@ -0,0 +1,94 @@
+package interface_types
+
+import "github.com/fajarstrtn/golang-tutorial/exercise"
+
+func init() {
+	exercise.Register(exercise.Exercise{
+		ID:     "interface_types/interfaces/type-switch",
+		Lesson: "interface_types/interfaces",
+		Title:  "Handle each type with a type switch",
+		Prompt: "Write transform with a type switch: double an int, upper-case a string (strings.ToUpper), return \"nil\" for nil and \"other\" for anything else. The output must be:\n42\nGO\nother\nnil",
+		Starter: `package main
+
+import "fmt"
+
+func transform(v any) any {
+	return v
+}
+
+func main() {
+	fmt.Println(transform(21))
+	fmt.Println(transform("go"))
+	fmt.Println(transform(2.5))
+	fmt.Println(transform(nil))
+}
+`,
+		Checks: []exercise.Check{
+			exercise.Calls("strings.ToUpper", 1),
+			exercise.OutputIs("42\nGO\nother\nnil"),
+		},
+	})
+
+	exercise.Register(exercise.Exercise{
+		ID:     "interface_types/nil/typed-nil-error",
+		Lesson: "interface_types/nil",
+		Title:  "Return a nil error, not a nil pointer",
+		Prompt: "validate returns its *AgeError variable, so err != nil even for a valid age. Make it return nil itself when the age is valid. The output must be:\nok\nage -1 is invalid",
+		Starter: `package main
+
+import "fmt"
+
+type AgeError struct {
+	Age int
+}
+
+func (e *AgeError) Error() string {
+	return fmt.Sprintf("age %d is invalid", e.Age)
+}
+
+func validate(age int) error {
+	var err *AgeError
+	if age < 0 {
+		err = &AgeError{Age: age}
+	}
+	return err
+}
+
+func main() {
+	for _, age := range []int{20, -1} {
+		if err := validate(age); err != nil {
+			fmt.Println(err)
+		} else {
+			fmt.Println("ok")
+		}
+	}
+}
+`,
+		Checks: []exercise.Check{
+			exercise.OutputIs("ok\nage -1 is invalid"),
+		},
+	})
+
+	exercise.Register(exercise.Exercise{
+		ID:     "interface_types/formatting/stringer",
+		Lesson: "interface_types/formatting",
+		Title:  "Print a color with fmt.Stringer",
+		Prompt: "Give Color a String method that returns the color as #rrggbb (use fmt.Sprintf with %02x), so Println prints it that way. The output must be: #ff8000 #00ff00",
+		Starter: `package main
+
+import "fmt"
+
+type Color struct {
+	R, G, B uint8
+}
+
+func main() {
+	fmt.Println(Color{255, 128, 0}, Color{0, 255, 0})
+}
+`,
+		Checks: []exercise.Check{
+			exercise.Calls("fmt.Sprintf", 1),
+			exercise.OutputIs("#ff8000 #00ff00"),
+		},
+	})
+}
@@ -0,0 +1,134 @@
+package interface_types
+
+import (
+	"fmt"
+	"io"
+)
+
+// A Color is a color of the RGB model, printed the way CSS writes it.
+type Color struct {
+	R, G, B uint8
+}
+
+// String makes a Color a fmt.Stringer: %v and %s print it as #rrggbb.
+func (c Color) String() string {
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
+
+// GoString makes a Color a fmt.GoStringer: %#v prints it as Go code with hexadecimal values.
+func (c Color) GoString() string {
+	return fmt.Sprintf("interface_types.Color{R: 0x%02x, G: 0x%02x, B: 0x%02x}", c.R, c.G, c.B)
+}
+
+// A Money is an amount in cents; only a *Money is a fmt.Stringer.
+type Money struct {
+	Cents int64
+}
+
+// String has a pointer receiver, so fmt uses it for a *Money, not for a Money.
+func (m *Money) String() string {
+	return fmt.Sprintf("$%d.%02d", m.Cents/100, m.Cents%100)
+}
+
+// A Temperature is in degrees Celsius. It implements fmt.Formatter, so it decides what every verb prints.
+type Temperature float64
+
+// Fahrenheit converts the temperature to degrees Fahrenheit.
+func (t Temperature) Fahrenheit() float64 {
+	return float64(t)*9/5 + 32
+}
+
+/*
+ * Format is called for every verb. f gives the flags (f.Flag), the width and the precision,
+ * and is the writer to print to:
+ * 1. %v, %s : 21.5°C; %+v adds the Fahrenheit value, %#v prints Go syntax
+ * 2. %F     : The temperature in Fahrenheit, a verb fmt itself does not have for floats
+ * 3. %f, %e, %g: The number, with its flags, width and precision (fmt.FormatString rebuilds the directive)
+ *
+ * A Formatter replaces all of fmt's work, including the width: %10v pads only because Format does it. */
+func (t Temperature) Format(f fmt.State, verb rune) {
+	var s string
+	switch verb {
+	case 'v', 's':
+		switch {
+		case f.Flag('#'):
+			s = fmt.Sprintf("interface_types.Temperature(%g)", float64(t))
+		case f.Flag('+'):
+			s = fmt.Sprintf("%g°C (%g°F)", float64(t), t.Fahrenheit())
+		default:
+			s = fmt.Sprintf("%g°C", float64(t))
+		}
+	case 'F':
+		s = fmt.Sprintf("%g°F", t.Fahrenheit())
+	case 'f', 'e', 'g':
+		fmt.Fprintf(f, fmt.FormatString(f, verb), float64(t))
+		return
+	default:
+		fmt.Fprintf(f, "%%!%c(interface_types.Temperature=%g)", verb, float64(t))
+		return
+	}
+
+	width, ok := f.Width()
+	switch {
+	case ok && f.Flag('-'):
+		fmt.Fprintf(f, "%-*s", width, s)
+	case ok:
+		fmt.Fprintf(f, "%*s", width, s)
+	default:
+		fmt.Fprint(f, s)
+	}
+}
+
+// A Palette has an exported and an unexported Color field.
+type Palette struct {
+	Primary   Color
+	secondary Color
+}
+
+func getStringer(w io.Writer) {
+	/*
+	 * fmt.Stringer is the interface { String() string }.
+	 * %v and %s (and Println) use String; verbs that do not apply to strings, like %d, do not. */
+	orange := Color{255, 128, 0}
+	fmt.Fprintln(w, orange)                              // Output: #ff8000
+	fmt.Fprintf(w, "%v %s %q\n", orange, orange, orange) // Output: #ff8000 #ff8000 "#ff8000"
+	fmt.Fprintf(w, "%d\n", orange)                       // Output: {255 128 0}
+
+	// String is also used for the elements of slices and maps, and for exported struct fields.
+	fmt.Fprintln(w, []Color{orange, {}})                                 // Output: [#ff8000 #000000]
+	fmt.Fprintf(w, "%+v\n", Palette{Primary: orange, secondary: orange}) // Output: {Primary:#ff8000 secondary:{R:255 G:128 B:0}}
+
+	/*
+	 * fmt cannot call methods on unexported fields, as seen above,
+	 * nor on a value whose String has a pointer receiver: only a *Money is a Stringer.
+	 *
+	 * Inside String, printing the receiver itself with %v or %s calls String again, forever;
+	 * convert it to a type without the method, or print its fields, instead (go vet reports this mistake). */
+	price := Money{1250}
+	fmt.Fprintln(w, price, &price) // Output: {1250} $12.50
+}
+
+func getGoStringer(w io.Writer) {
+	/*
+	 * fmt.GoStringer is the interface { GoString() string }.
+	 * Only %#v uses GoString; it should print the value as Go code.
+	 * Without it, %#v prints the struct with decimal fields (see the format/verbs lesson). */
+	orange := Color{255, 128, 0}
+	fmt.Fprintf(w, "%#v\n", orange)                    // Output: interface_types.Color{R: 0xff, G: 0x80, B: 0x00}
+	fmt.Fprintf(w, "%#v\n", []Color{orange})           // Output: []interface_types.Color{interface_types.Color{R: 0xff, G: 0x80, B: 0x00}}
+	fmt.Fprintf(w, "%#v\n", struct{ R, G, B uint8 }{}) // Output: struct { R uint8; G uint8; B uint8 }{R:0x0, G:0x0, B:0x0}
+}
+
+func getFormatter(w io.Writer) {
+	/*
+	 * fmt.Formatter is the interface { Format(f fmt.State, verb rune) }.
+	 * It takes priority over String and GoString, and is called for every verb. */
+	t := Temperature(21.5)
+	fmt.Fprintln(w, t)                         // Output: 21.5°C
+	fmt.Fprintf(w, "%+v\n", t)                 // Output: 21.5°C (70.7°F)
+	fmt.Fprintf(w, "%#v\n", t)                 // Output: interface_types.Temperature(21.5)
+	fmt.Fprintf(w, "%F\n", t)                  // Output: 70.7°F
+	fmt.Fprintf(w, "%.3f|%8.1f|%e\n", t, t, t) // Output: 21.500|    21.5|2.150000e+01
+	fmt.Fprintf(w, "[%10v][%-10v]\n", t, t)    // Output: [    21.5°C][21.5°C    ]
+	fmt.Fprintf(w, "%d\n", t)                  // Output: %!d(interface_types.Temperature=21.5)
+}
@@ -0,0 +1,196 @@
+package interface_types
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+)
+
+// A Shape is anything with an area and a perimeter.
+type Shape interface {
+	Area() float64
+	Perimeter() float64
+}
+
+// A Rectangle is a Shape: it has both methods.
+type Rectangle struct {
+	Width, Height float64
+}
+
+// Area returns the width times the height.
+func (r Rectangle) Area() float64 {
+	return r.Width * r.Height
+}
+
+// Perimeter returns the length of the four sides.
+func (r Rectangle) Perimeter() float64 {
+	return 2 * (r.Width + r.Height)
+}
+
+// A Circle is a Shape too.
+type Circle struct {
+	Radius float64
+}
+
+// Area returns π r².
+func (c Circle) Area() float64 {
+	return math.Pi * c.Radius * c.Radius
+}
+
+// Perimeter returns 2 π r.
+func (c Circle) Perimeter() float64 {
+	return 2 * math.Pi * c.Radius
+}
+
+/*
+ * These declarations check at compile time that the types satisfy Shape:
+ * if a method is missing or has the wrong signature, the package does not compile. */
+var (
+	_ Shape = Rectangle{}
+	_ Shape = Circle{}
+)
+
+func getImplicitSatisfaction(w io.Writer) {
+	/*
+	 * Rectangle and Circle never mention Shape, yet both can be stored in a Shape,
+	 * and code written for Shape works with both (and with any later type that has the methods). */
+	shapes := []Shape{Rectangle{3, 4}, Circle{1}}
+
+	/*
+	 * Output:
+	 * interface_types.Rectangle area 12.00 perimeter 14.00
+	 * interface_types.Circle area 3.14 perimeter 6.28 */
+	for _, s := range shapes {
+		fmt.Fprintf(w, "%T area %.2f perimeter %.2f\n", s, s.Area(), s.Perimeter())
+	}
+
+	fmt.Fprintf(w, "%.2f\n", totalArea(shapes...)) // Output: 15.14
+}
+
+// totalArea only knows about the Shape interface, not about the types behind it.
+func totalArea(shapes ...Shape) float64 {
+	total := 0.0
+	for _, s := range shapes {
+		total += s.Area()
+	}
+	return total
+}
+
+func getEmptyInterface(w io.Writer) {
+	/*
+	 * The empty interface, interface{} (or its alias any), has no methods,
+	 * so every value satisfies it. fmt.Println takes ...any, which is why it prints anything.
+	 * A value stored in an any keeps its own type. */
+	values := []any{42, "hello", 3.5, true, Circle{2}, nil}
+	fmt.Fprintln(w, values...) // Output: 42 hello 3.5 true {2} <nil>
+
+	/*
+	 * Output:
+	 * int
+	 * string
+	 * float64
+	 * bool
+	 * interface_types.Circle
+	 * <nil> */
+	for _, v := range values {
+		fmt.Fprintf(w, "%T\n", v)
+	}
+
+	/*
+	 * An any says nothing about its value, so nothing can be done with it without a type assertion
+	 * (e.g., values[0] + 1 does not compile). Prefer a smaller interface, or generics, when possible. */
+}
+
+func getTypeAssertions(w io.Writer) {
+	/*
+	 * A type assertion x.(T) takes the value of type T back out of an interface:
+	 * 1. v := x.(T)    : Panics when the dynamic type is not T
+	 * 2. v, ok := x.(T): ok is false (and v the zero value) when it is not
+	 *
+	 * T can also be an interface: the assertion then checks that the value has its methods. */
+	var s Shape = Circle{2}
+
+	c := s.(Circle)
+	fmt.Fprintln(w, c.Radius) // Output: 2
+
+	r, ok := s.(Rectangle)
+	fmt.Fprintln(w, r, ok) // Output: {0 0} false
+
+	_, isStringer := s.(fmt.Stringer)
+	fmt.Fprintln(w, isStringer) // Output: false
+
+	panicked := recovered(func() { _ = s.(Rectangle) })
+	fmt.Fprintln(w, panicked) // Output: interface conversion: interface_types.Shape is interface_types.Circle, not interface_types.Rectangle
+}
+
+func getTypeSwitches(w io.Writer) {
+	/*
+	 * A type switch compares the dynamic type with several types.
+	 * In each case, v has the type of that case; in a case with more than one type, and in default,
+	 * v keeps the interface type.
+	 *
+	 * Output:
+	 * int 42, doubled 84
+	 * string "hello" of 5 bytes
+	 * shape with area 12.00
+	 * number 3.5 of type float64
+	 * nil
+	 * other []int */
+	for _, v := range []any{42, "hello", Rectangle{3, 4}, 3.5, nil, []int{1}} {
+		fmt.Fprintln(w, describe(v))
+	}
+}
+
+// describe prints a value depending on its dynamic type.
+func describe(x any) string {
+	switch v := x.(type) {
+	case int:
+		return fmt.Sprintf("int %d, doubled %d", v, v*2)
+	case string:
+		return fmt.Sprintf("string %q of %d bytes", v, len(v))
+	case Shape:
+		return fmt.Sprintf("shape with area %.2f", v.Area())
+	case float32, float64:
+		return fmt.Sprintf("number %v of type %T", v, v)
+	case nil:
+		return "nil"
+	default:
+		return fmt.Sprintf("other %T", v)
+	}
+}
+
+func getEmbeddedInterfaces(w io.Writer) {
+	/*
+	 * An interface can embed other interfaces: its method set is the union of theirs.
+	 * The io package builds its interfaces this way:
+	 * 1. io.Reader    : Read(p []byte) (n int, err error)
+	 * 2. io.Writer    : Write(p []byte) (n int, err error)
+	 * 3. io.ReadWriter: interface { Reader; Writer }
+	 *
+	 * Small interfaces (one or two methods) are the Go style: they are easy to satisfy,
+	 * and larger ones are made by embedding them. */
+	var rw io.ReadWriter = &bytes.Buffer{}
+	fmt.Fprint(rw, "written, then read")
+
+	// Any io.ReadWriter is an io.Reader too, so it can be passed where a Reader is expected.
+	data, _ := io.ReadAll(io.Reader(rw))
+	fmt.Fprintln(w, string(data)) // Output: written, then read
+
+	// A *strings.Reader is a Reader but not a Writer.
+	var source any = strings.NewReader("text")
+	_, isReader := source.(io.Reader)
+	_, isReadWriter := source.(io.ReadWriter)
+	fmt.Fprintln(w, isReader, isReadWriter) // Output: true false
+}
+
+// recovered runs f and returns what it panicked with, or nil.
+func recovered(f func()) (r any) {
+	defer func() {
+		r = recover()
+	}()
+
+	f()
+	return nil
+}
@@ -0,0 +1,52 @@
+/*
+ * An interface type is a set of methods. A value of any type that has those methods
+ * can be stored in a variable of the interface type: there is no implements keyword.
+ *
+ * An interface value is a pair of a dynamic type and a dynamic value:
+ * 1. var s Shape                : (nil, nil), the nil interface
+ * 2. s = Circle{1}              : (Circle, {1})
+ * 3. s = (*Circle)(nil)         : (*Circle, nil), which is not a nil interface
+ *
+ * This package covers satisfaction, any, type assertions and switches, the nil interface pitfalls,
+ * and the fmt interfaces (Stringer, GoStringer and Formatter) that change what the format verbs print. */
+package interface_types
+
+import "github.com/fajarstrtn/golang-tutorial/lesson"
+
+/*
+ * A type satisfies an interface implicitly, by having its methods.
+ * This lesson looks at:
+ * 1. Implicit satisfaction, and checking it at compile time
+ * 2. The empty interface, any
+ * 3. Type assertions and type switches
+ * 4. Interfaces embedding other interfaces */
+func GenerateInterfaces(env *lesson.Env) {
+	getImplicitSatisfaction(env.Out)
+	getEmptyInterface(env.Out)
+	getTypeAssertions(env.Out)
+	getTypeSwitches(env.Out)
+	getEmbeddedInterfaces(env.Out)
+}
+
+/*
+ * An interface is nil only when both its type and its value are nil.
+ * A nil pointer stored in an interface makes a non-nil interface,
+ * which is the source of the best known Go pitfall: an error that is not nil but holds nothing. */
+func GenerateNilInterfaces(env *lesson.Env) {
+	getNilInterface(env.Out)
+	getNilPointerInInterface(env.Out)
+	getNilError(env.Out)
+}
+
+/*
+ * The fmt package checks whether a value implements one of its interfaces before printing it:
+ * 1. fmt.Stringer  : String() string, used by %v and %s (and %q, %x, %X)
+ * 2. fmt.GoStringer: GoString() string, used by %#v
+ * 3. fmt.Formatter : Format(f fmt.State, verb rune), used by every verb, with full control
+ *
+ * So the output of the format/verbs lesson can be customized for any type. */
+func GenerateFormatting(env *lesson.Env) {
+	getStringer(env.Out)
+	getGoStringer(env.Out)
+	getFormatter(env.Out)
+}
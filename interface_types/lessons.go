@@ -0,0 +1,9 @@
+package interface_types
+
+import "github.com/fajarstrtn/golang-tutorial/lesson"
+
+func init() {
+	lesson.Register(lesson.Lesson{ID: "interface_types/interfaces", Title: "Interfaces, any, type assertions and type switches", Topic: "interface_types", Order: 800, Run: GenerateInterfaces})
+	lesson.Register(lesson.Lesson{ID: "interface_types/nil", Title: "nil interfaces and nil pointers in interfaces", Topic: "interface_types", Order: 810, Run: GenerateNilInterfaces})
+	lesson.Register(lesson.Lesson{ID: "interface_types/formatting", Title: "fmt.Stringer, fmt.GoStringer and fmt.Formatter", Topic: "interface_types", Order: 820, Run: GenerateFormatting})
+}
@@ -0,0 +1,92 @@
+package interface_types
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+)
+
+// A ValidationError reports a field with an invalid value.
+type ValidationError struct {
+	Field string
+}
+
+// Error makes a *ValidationError an error.
+func (e *ValidationError) Error() string {
+	return e.Field + " is invalid"
+}
+
+func getNilInterface(w io.Writer) {
+	/*
+	 * An interface variable that was never set is nil: it has no type and no value.
+	 * Calling a method on it panics, because there is no method to call. */
+	var s Shape
+	fmt.Fprintln(w, s == nil)       // Output: true
+	fmt.Fprintf(w, "%v %T\n", s, s) // Output: <nil> <nil>
+
+	panicked := recovered(func() { s.Area() })
+	fmt.Fprintln(w, panicked) // Output: runtime error: invalid memory address or nil pointer dereference
+}
+
+func getNilPointerInInterface(w io.Writer) {
+	/*
+	 * Storing a nil pointer gives the interface a type: it is (*Circle, nil), which is not nil.
+	 * == nil is true only for the nil interface itself. */
+	var c *Circle
+	var s Shape = c
+	fmt.Fprintln(w, c == nil, s == nil) // Output: true false
+	fmt.Fprintf(w, "%v %T\n", s, s)     // Output: <nil> *interface_types.Circle
+
+	// The methods of Circle have value receivers, so calling one through a nil *Circle panics.
+	panicked := recovered(func() { s.Area() })
+	fmt.Fprintln(w, panicked) // Output: runtime error: invalid memory address or nil pointer dereference
+
+	// reflect can tell whether the value inside a non-nil interface is a nil pointer.
+	fmt.Fprintln(w, isNilValue(s), isNilValue(Circle{1}), isNilValue(nil)) // Output: true false true
+}
+
+// isNilValue reports whether v is the nil interface or holds a nil pointer, map, slice, channel or function.
+func isNilValue(v any) bool {
+	if v == nil {
+		return true
+	}
+
+	value := reflect.ValueOf(v)
+	switch value.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Slice, reflect.Chan, reflect.Func, reflect.Interface:
+		return value.IsNil()
+	}
+	return false
+}
+
+func getNilError(w io.Writer) {
+	/*
+	 * error is an interface, so the pitfall shows up most with errors:
+	 * validateBroken returns its *ValidationError variable, which is nil when the age is valid,
+	 * but the caller gets an error holding (*ValidationError, nil), and err != nil is true. */
+	err := validateBroken(20)
+	fmt.Fprintln(w, err != nil) // Output: true
+	fmt.Fprintf(w, "%T\n", err) // Output: *interface_types.ValidationError
+
+	// Returning nil itself (not a nil pointer) when there is no error fixes it.
+	fmt.Fprintln(w, validate(20) != nil, validate(-1)) // Output: false age is invalid
+
+	// So functions should declare error as their result type, never a concrete error type.
+}
+
+// validateBroken has the pitfall: it always returns a non-nil error.
+func validateBroken(age int) error {
+	var err *ValidationError
+	if age < 0 {
+		err = &ValidationError{Field: "age"}
+	}
+	return err
+}
+
+// validate returns nil itself when the age is valid.
+func validate(age int) error {
+	if age < 0 {
+		return &ValidationError{Field: "age"}
+	}
+	return nil
+}
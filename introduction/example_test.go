@@ -0,0 +1,17 @@
+// Code generated by "go run . examples"; DO NOT EDIT.
+
+package introduction_test
+
+import (
+	"os"
+
+	"github.com/fajarstrtn/golang-tutorial/introduction"
+	"github.com/fajarstrtn/golang-tutorial/lesson"
+)
+
+// ExampleGreet runs the introduction/greet lesson.
+func ExampleGreet() {
+	introduction.Greet(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// Hello World
+}
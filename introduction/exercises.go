@@ -0,0 +1,23 @@
+package introduction
+
+import "github.com/fajarstrtn/golang-tutorial/exercise"
+
+func init() {
+	exercise.Register(exercise.Exercise{
+		ID:     "introduction/greet/hello-gopher",
+		Lesson: "introduction/greet",
+		Title:  "Say hello to the gophers",
+		Prompt: "Change the program so it prints exactly: Hello, Gopher!",
+		Starter: `package main
+
+import "fmt"
+
+func main() {
+	fmt.Println("Hello World")
+}
+`,
+		Checks: []exercise.Check{
+			exercise.OutputIs("Hello, Gopher!"),
+		},
+	})
+}
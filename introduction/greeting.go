@@ -3,9 +3,17 @@ package introduction
 /*
  * This imports the standard formatting package of Go.
  * The fmt package gives you printing, formatting strings, and reading input. */
-import "fmt"
+import (
+	"fmt"
 
-func Greet() {
+	"github.com/fajarstrtn/golang-tutorial/lesson"
+)
+
+/*
+ * Every lesson receives an env, which says where its output goes.
+ * fmt.Fprintln(env.Out, ...) works like fmt.Println(...),
+ * but writes to env.Out (stdout when you run the tutorial) instead of always to stdout. */
+func Greet(env *lesson.Env) {
 	// The fmt package is the package you imported.
-	fmt.Println("Hello World") // Output: Hello World
+	fmt.Fprintln(env.Out, "Hello World") // Output: Hello World
 }
@@ -0,0 +1,7 @@
+package introduction
+
+import "github.com/fajarstrtn/golang-tutorial/lesson"
+
+func init() {
+	lesson.Register(lesson.Lesson{ID: "introduction/greet", Title: "Hello World", Topic: "introduction", Order: 100, Run: Greet})
+}
@@ -0,0 +1,108 @@
+package lesson
+
+import (
+	"io"
+	"log"
+	"os"
+	"time"
+)
+
+/*
+ * Some output changes on every run or on every machine:
+ * 1. The timestamp the log package puts in front of every message
+ * 2. Memory addresses printed from pointers and uintptr values
+ *
+ * In deterministic mode, the timestamp always comes from DETERMINISTIC_TIME
+ * and addresses are replaced with placeholders counting up from PLACEHOLDER_ADDR,
+ * so the output can be compared against the "// Output:" comments and between machines. */
+const PLACEHOLDER_ADDR uintptr = 0xc00000a0c8
+
+var DETERMINISTIC_TIME = time.Date(2026, time.February, 10, 16, 30, 31, 0, time.UTC)
+
+/*
+ * An Env is everything a lesson is allowed to write to:
+ * 1. Out  : Normal output, what fmt.Print* would write to stdout
+ * 2. Log  : Logger for the log lessons, what the global log package would write to stderr
+ * 3. Races: Run the deliberate data races of the concurrency/races lesson (set by run --race only)
+ *
+ * Lessons never write to os.Stdout or os.Stderr directly,
+ * so their output can be captured, tested, or shown in another UI. */
+type Env struct {
+	Out   io.Writer
+	Log   *log.Logger
+	Races bool
+
+	deterministic bool
+	addrs         map[uintptr]uintptr
+}
+
+// NewEnv returns an Env writing normal output to out and log output to logOut.
+func NewEnv(out, logOut io.Writer) *Env {
+	return &Env{
+		Out: out,
+		Log: log.New(logOut, "", log.LstdFlags),
+	}
+}
+
+/*
+ * NewDeterministicEnv returns an Env like NewEnv,
+ * but whose output is the same on every run (see DETERMINISTIC_TIME and PLACEHOLDER_ADDR). */
+func NewDeterministicEnv(out, logOut io.Writer) *Env {
+	clock := func() time.Time { return DETERMINISTIC_TIME }
+
+	return &Env{
+		Out:           out,
+		Log:           log.New(&clockWriter{out: logOut, now: clock}, "", 0),
+		deterministic: true,
+		addrs:         map[uintptr]uintptr{},
+	}
+}
+
+// Stdio returns an Env writing to os.Stdout and os.Stderr, like fmt.Println and log.Println do.
+func Stdio() *Env {
+	return NewEnv(os.Stdout, os.Stderr)
+}
+
+// Deterministic reports whether the Env was created by NewDeterministicEnv.
+func (e *Env) Deterministic() bool {
+	return e.deterministic
+}
+
+/*
+ * Addr returns the address to print for addr.
+ * Outside deterministic mode it is addr itself.
+ * In deterministic mode, the first address seen becomes PLACEHOLDER_ADDR,
+ * the second one PLACEHOLDER_ADDR + 8, and so on; the same address always gets the same placeholder.
+ *
+ * The result is only meant to be printed, never converted back to a pointer. */
+func (e *Env) Addr(addr uintptr) uintptr {
+	if !e.deterministic {
+		return addr
+	}
+
+	placeholder, ok := e.addrs[addr]
+	if !ok {
+		placeholder = PLACEHOLDER_ADDR + uintptr(8*len(e.addrs))
+		e.addrs[addr] = placeholder
+	}
+
+	return placeholder
+}
+
+/*
+ * A clockWriter puts a timestamp in front of every log message,
+ * in the same format as log.LstdFlags, but taken from its own clock.
+ * The log package calls Write once per message, so one Write is one line. */
+type clockWriter struct {
+	out io.Writer
+	now func() time.Time
+}
+
+func (c *clockWriter) Write(p []byte) (int, error) {
+	prefix := c.now().Format("2006/01/02 15:04:05 ")
+	if _, err := io.WriteString(c.out, prefix); err != nil {
+		return 0, err
+	}
+
+	return c.out.Write(p)
+}
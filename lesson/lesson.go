@@ -0,0 +1,143 @@
+/*
+ * The lesson package keeps a registry of every runnable lesson in the tutorial.
+ *
+ * Each lesson package registers its own lessons from an init() function,
+ * so main only has to walk the registry instead of calling every lesson by hand.
+ * Adding a lesson to an existing package never requires touching main.go. */
+package lesson
+
+import (
+	"fmt"
+	"path"
+	"sort"
+)
+
+/*
+ * A Lesson describes one runnable piece of the tutorial:
+ * 1. ID   : Unique identifier in the form topic/name (e.g., data_types/numbers)
+ * 2. Title: Human readable description shown by tools
+ * 3. Topic: Name of the package the lesson belongs to (e.g., format)
+ * 4. Order: Position of the lesson in the whole tutorial, lower runs first
+ * 5. Run  : Function that runs the lesson, writing everything it prints to the given Env */
+type Lesson struct {
+	ID    string
+	Title string
+	Topic string
+	Order int
+	Run   func(env *Env)
+}
+
+var registry = map[string]Lesson{}
+
+/*
+ * Register adds a lesson to the registry.
+ *
+ * Every lesson package calls it from the init() function of its lessons.go,
+ * so main can run the lessons without calling each function by hand
+ * (exercises are registered the same way from exercises.go, see exercise.Register).
+ * A broken registration (empty ID, missing function, or duplicate ID) panics at startup. */
+func Register(l Lesson) {
+	if l.ID == "" {
+		panic("lesson: Register called with an empty ID")
+	}
+
+	if l.Run == nil {
+		panic(fmt.Sprintf("lesson: Register called with a nil Run for %q", l.ID))
+	}
+
+	if _, ok := registry[l.ID]; ok {
+		panic(fmt.Sprintf("lesson: Register called twice for %q", l.ID))
+	}
+
+	registry[l.ID] = l
+}
+
+// All returns every registered lesson sorted by Order, then by ID.
+func All() []Lesson {
+	lessons := make([]Lesson, 0, len(registry))
+	for _, l := range registry {
+		lessons = append(lessons, l)
+	}
+
+	sort.Slice(lessons, func(i, j int) bool {
+		if lessons[i].Order != lessons[j].Order {
+			return lessons[i].Order < lessons[j].Order
+		}
+		return lessons[i].ID < lessons[j].ID
+	})
+
+	return lessons
+}
+
+// Lookup returns the lesson registered with the given ID.
+func Lookup(id string) (Lesson, bool) {
+	l, ok := registry[id]
+	return l, ok
+}
+
+// ByTopic returns the lessons of one topic in the same order as All.
+func ByTopic(topic string) []Lesson {
+	var lessons []Lesson
+	for _, l := range All() {
+		if l.Topic == topic {
+			lessons = append(lessons, l)
+		}
+	}
+
+	return lessons
+}
+
+/*
+ * Match returns the lessons whose ID matches any of the patterns,
+ * in the same order as All and without duplicates.
+ *
+ * A pattern is either an exact ID (e.g., format/verbs)
+ * or a glob understood by path.Match (e.g., data_types/*).
+ * A pattern that matches nothing is reported as an unknown lesson. */
+func Match(patterns ...string) ([]Lesson, error) {
+	selected := map[string]bool{}
+
+	for _, pattern := range patterns {
+		found := false
+
+		for id := range registry {
+			ok, err := path.Match(pattern, id)
+			if err != nil {
+				return nil, fmt.Errorf("bad lesson pattern %q: %w", pattern, err)
+			}
+
+			if ok {
+				selected[id] = true
+				found = true
+			}
+		}
+
+		if !found {
+			return nil, fmt.Errorf("unknown lesson %q", pattern)
+		}
+	}
+
+	var lessons []Lesson
+	for _, l := range All() {
+		if selected[l.ID] {
+			lessons = append(lessons, l)
+		}
+	}
+
+	return lessons, nil
+}
+
+// Topics returns the topic names in the order their first lesson appears.
+func Topics() []string {
+	var topics []string
+	seen := map[string]bool{}
+
+	for _, l := range All() {
+		if !seen[l.Topic] {
+			seen[l.Topic] = true
+			topics = append(topics, l.Topic)
+		}
+	}
+
+	return topics
+}
@@ -0,0 +1,6 @@
+//go:build !race
+
+package lesson
+
+// RACE_DETECTOR reports whether the tutorial was built with -race; see race.go.
+const RACE_DETECTOR = false
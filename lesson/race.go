@@ -0,0 +1,9 @@
+//go:build race
+
+package lesson
+
+/*
+ * RACE_DETECTOR reports whether the tutorial was built with -race (e.g., go run -race .).
+ * run --race uses it to know whether it has to build such a copy of the tutorial first;
+ * the deliberate data races themselves only run when Env.Races is set. */
+const RACE_DETECTOR = true
@@ -19,33 +19,41 @@
  * 3. File must be named something.go */
 package main
 
+// Regenerate the example_test.go files of every lesson package with "go generate".
+//go:generate go run . examples
+
 import (
-	"github.com/fajarstrtn/golang-tutorial/comment"
-	"github.com/fajarstrtn/golang-tutorial/data_types"
-	"github.com/fajarstrtn/golang-tutorial/format"
-	"github.com/fajarstrtn/golang-tutorial/identifier"
-	"github.com/fajarstrtn/golang-tutorial/introduction"
+	"os"
+
+	"github.com/fajarstrtn/golang-tutorial/cli"
+
+	/*
+	 * Lesson packages are imported only for their side effect:
+	 * each one registers its lessons in the lesson registry from init().
+	 * To add a new lesson package, add it to this list. */
+	_ "github.com/fajarstrtn/golang-tutorial/comment"
+	_ "github.com/fajarstrtn/golang-tutorial/composite_types"
+	_ "github.com/fajarstrtn/golang-tutorial/concurrency"
+	_ "github.com/fajarstrtn/golang-tutorial/control_flow"
+	_ "github.com/fajarstrtn/golang-tutorial/data_types"
+	_ "github.com/fajarstrtn/golang-tutorial/error_handling"
+	_ "github.com/fajarstrtn/golang-tutorial/format"
+	_ "github.com/fajarstrtn/golang-tutorial/functions"
+	_ "github.com/fajarstrtn/golang-tutorial/generics"
+	_ "github.com/fajarstrtn/golang-tutorial/identifier"
+	_ "github.com/fajarstrtn/golang-tutorial/interface_types"
+	_ "github.com/fajarstrtn/golang-tutorial/introduction"
+	_ "github.com/fajarstrtn/golang-tutorial/pointer_types"
+	_ "github.com/fajarstrtn/golang-tutorial/struct_types"
 )
 
 /*
  * When you run a program, Go automatically starts executing main function.
- * No main function means nothing runs. */
+ * No main function means nothing runs.
+ *
+ * The command line decides which lessons run (see the cli package),
+ * and its exit code becomes the exit code of the program.
+ * Lessons run sorted by their Order, then by ID (see lesson.All), not in the order they were registered. */
 func main() {
-	introduction.Greet()
-	comment.ReadSingleLineComment()
-	comment.ReadMultiLineComment()
-	identifier.GenerateIdentifiers()
-	identifier.GenerateKeywords()
-	identifier.GenerateVariablesUsingVar()
-	identifier.GenerateVariablesUsingShortVarDec()
-	identifier.GenerateConstants()
-	identifier.CallExportedVariable()
-	format.PrintSomething()
-	format.PrintSomethingWithNewLine()
-	format.PrintSomethingWithFormattingVerbs()
-	format.PrintSomethingWithSprintf()
-	format.PrintSomethingWithLog()
-	data_types.GenerateNumbers()
-	data_types.GenerateStrings()
-	data_types.GenerateBooleans()
+	os.Exit(cli.Run(os.Args[1:], os.Stdout, os.Stderr))
 }
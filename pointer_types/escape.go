@@ -0,0 +1,174 @@
+package pointer_types
+
+import (
+	"fmt"
+	"io"
+	"runtime"
+	"strings"
+)
+
+/*
+ * The functions below are small on purpose: each one shows one decision of the escape analysis.
+ * They are marked go:noinline, so the compiler reports on them as written
+ * and not as inlined into their callers. */
+
+// kept is a package variable: anything stored in it outlives every function call.
+var kept *int
+
+// sumOnStack uses an array and a pointer to it, but nothing outlives the call.
+//
+//go:noinline
+func sumOnStack() int {
+	numbers := [4]int{1, 2, 3, 4}
+	p := &numbers
+	total := 0
+	for _, n := range p {
+		total += n
+	}
+	return total
+}
+
+// newUser returns the address of a local variable, so the variable must outlive the call.
+//
+//go:noinline
+func newUser(name string) *User {
+	u := User{Name: name}
+	return &u
+}
+
+// read only reads through the pointer: the caller's variable can stay on its stack.
+//
+//go:noinline
+func read(p *int) int {
+	return *p
+}
+
+// keep stores the pointer in a package variable: the pointed-to variable must live on the heap.
+//
+//go:noinline
+func keep(p *int) {
+	kept = p
+}
+
+// fixedBuffer makes a slice of a size known at compile time, used only inside the function.
+//
+//go:noinline
+func fixedBuffer() int {
+	buffer := make([]byte, 64)
+	buffer[0] = 1
+	return len(buffer)
+}
+
+// makeBuffer returns the slice it makes, so its backing array must outlive the call.
+//
+//go:noinline
+func makeBuffer(n int) []byte {
+	return make([]byte, n)
+}
+
+// boxed stores an int in an interface, which needs a pointer to a copy of it.
+//
+//go:noinline
+func boxed(n int) any {
+	return n
+}
+
+/*
+ * An EscapeDecision is what go build -gcflags=-m reports for one function of this file,
+ * without the file positions. A function with no Messages keeps everything on the stack. */
+type EscapeDecision struct {
+	Function string
+	Messages []string
+}
+
+/*
+ * ESCAPE_ANALYSIS is the output of:
+ *
+ *	go build -gcflags=-m ./pointer_types 2>&1 | grep escape.go
+ *
+ * captured function by function. TestEscapeAnalysisIsCurrent runs the compiler again,
+ * so it fails when a change to this file (or to the compiler) changes a decision. */
+var ESCAPE_ANALYSIS = []EscapeDecision{
+	{Function: "sumOnStack"},
+	{Function: "newUser", Messages: []string{"leaking param: name", "moved to heap: u"}},
+	{Function: "read", Messages: []string{"p does not escape"}},
+	{Function: "keep", Messages: []string{"leaking param: p"}},
+	{Function: "fixedBuffer", Messages: []string{"make([]byte, 64) does not escape"}},
+	{Function: "makeBuffer", Messages: []string{"make([]byte, n) escapes to heap"}},
+	{Function: "boxed", Messages: []string{"n escapes to heap"}},
+}
+
+// The results of the calls measured by allocsPerCall are stored here, so they are really used.
+var (
+	sinkInt   int
+	sinkUser  *User
+	sinkBytes []byte
+	sinkAny   any
+)
+
+/*
+ * allocsPerCall returns the average number of heap allocations of one call to f,
+ * from the allocation counter of the runtime (like testing.AllocsPerRun does). */
+func allocsPerCall(f func()) uint64 {
+	const RUNS = 100
+
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(1))
+	f()
+
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	for range RUNS {
+		f()
+	}
+	runtime.ReadMemStats(&after)
+
+	return (after.Mallocs - before.Mallocs) / RUNS
+}
+
+func getEscapeAnalysis(w io.Writer) {
+	/*
+	 * Local variables normally live on the stack of their function, which is free:
+	 * the stack frame is dropped when the function returns.
+	 * A variable that may be used after its function returns (through a pointer) must live on the heap,
+	 * where the garbage collector frees it later. Go decides this at compile time: the escape analysis.
+	 *
+	 * So returning &u from a function is safe in Go (unlike in C): u is moved to the heap.
+	 *
+	 * go build -gcflags=-m prints the decisions (-m=2 prints the reasons too):
+	 * 1. moved to heap: x      : The variable x lives on the heap
+	 * 2. x escapes to heap     : The value x (e.g., a make or a conversion to an interface) is allocated on the heap
+	 * 3. x does not escape     : x can stay on the stack
+	 * 4. leaking param: p      : The pointer in parameter p is kept after the call (returned or stored),
+	 *                            so what the caller passes must be on the heap
+	 *
+	 * Output:
+	 * sumOnStack   0 allocs  (everything stays on the stack)
+	 * newUser      1 allocs  leaking param: name; moved to heap: u
+	 * read         0 allocs  p does not escape
+	 * keep         1 allocs  leaking param: p
+	 * fixedBuffer  0 allocs  make([]byte, 64) does not escape
+	 * makeBuffer   1 allocs  make([]byte, n) escapes to heap
+	 * boxed        1 allocs  n escapes to heap */
+	for _, decision := range ESCAPE_ANALYSIS {
+		messages := strings.Join(decision.Messages, "; ")
+		if messages == "" {
+			messages = "(everything stays on the stack)"
+		}
+		fmt.Fprintf(w, "%-12s %d allocs  %s\n", decision.Function, allocsPerCall(escapeCalls[decision.Function]), messages)
+	}
+
+	/*
+	 * The allocation counted for keep is not made by keep itself: its caller moves x to the heap
+	 * before passing &x, because of the leaking param. The same caller calling read keeps x on its stack. */
+}
+
+// escapeCalls calls every function of ESCAPE_ANALYSIS once, with a result that is used.
+var escapeCalls = map[string]func(){
+	"sumOnStack":  func() { sinkInt = sumOnStack() },
+	"newUser":     func() { sinkUser = newUser("Ana") },
+	"read":        func() { x := 1; sinkInt = read(&x) },
+	"keep":        func() { x := 1; keep(&x) },
+	"fixedBuffer": func() { sinkInt = fixedBuffer() },
+	"makeBuffer":  func() { sinkBytes = makeBuffer(16) },
+	"boxed":       func() { sinkAny = boxed(1000) },
+}
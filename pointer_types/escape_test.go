@@ -0,0 +1,54 @@
+package pointer_types
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os/exec"
+	"regexp"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+// ESCAPE_ANALYSIS must still be what the compiler reports for escape.go.
+func TestEscapeAnalysisIsCurrent(t *testing.T) {
+	out, err := exec.Command("go", "build", "-gcflags=-m", ".").CombinedOutput()
+	if err != nil {
+		t.Fatalf("go build -gcflags=-m: %v\n%s", err, out)
+	}
+
+	// The line ranges of the functions of escape.go, to know which function each message is about.
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "escape.go", nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	functionAt := func(line int) string {
+		for _, decl := range file.Decls {
+			if fn, ok := decl.(*ast.FuncDecl); ok && fset.Position(fn.Pos()).Line <= line && line <= fset.Position(fn.End()).Line {
+				return fn.Name.Name
+			}
+		}
+		return ""
+	}
+
+	got := map[string][]string{}
+	message := regexp.MustCompile(`(?m)^\./escape\.go:(\d+):\d+: (.*)$`)
+	for _, m := range message.FindAllStringSubmatch(string(out), -1) {
+		line, _ := strconv.Atoi(m[1])
+		if function := functionAt(line); function != "" {
+			got[function] = append(got[function], m[2])
+		}
+	}
+
+	for _, decision := range ESCAPE_ANALYSIS {
+		if !slices.Equal(got[decision.Function], decision.Messages) {
+			t.Errorf("%s: the compiler reports %q, ESCAPE_ANALYSIS has %q", decision.Function, got[decision.Function], decision.Messages)
+		}
+		if _, ok := escapeCalls[decision.Function]; !ok {
+			t.Errorf("%s has no call in escapeCalls", decision.Function)
+		}
+	}
+}
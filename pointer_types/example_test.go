@@ -0,0 +1,61 @@
+// Code generated by "go run . examples"; DO NOT EDIT.
+
+package pointer_types_test
+
+import (
+	"os"
+
+	"github.com/fajarstrtn/golang-tutorial/lesson"
+	"github.com/fajarstrtn/golang-tutorial/pointer_types"
+)
+
+// ExampleGenerateEscapeAnalysis runs the pointer_types/escape lesson.
+func ExampleGenerateEscapeAnalysis() {
+	pointer_types.GenerateEscapeAnalysis(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// sumOnStack   0 allocs  (everything stays on the stack)
+	// newUser      1 allocs  leaking param: name; moved to heap: u
+	// read         0 allocs  p does not escape
+	// keep         1 allocs  leaking param: p
+	// fixedBuffer  0 allocs  make([]byte, 64) does not escape
+	// makeBuffer   1 allocs  make([]byte, n) escapes to heap
+	// boxed        1 allocs  n escapes to heap
+}
+
+// ExampleGenerateNilPointers runs the pointer_types/nil lesson.
+func ExampleGenerateNilPointers() {
+	pointer_types.GenerateNilPointers(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// runtime error: invalid memory address or nil pointer dereference
+	// true
+	// p is nil
+	// runtime error: invalid memory address or nil pointer dereference
+	// runtime error: invalid memory address or nil pointer dereference
+	// no user budi
+	// guest
+	// runtime error: invalid memory address or nil pointer dereference
+}
+
+// ExampleGeneratePointers runs the pointer_types/pointers lesson.
+func ExampleGeneratePointers() {
+	pointer_types.GeneratePointers(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// *int
+	// 42
+	// 100
+	// true true
+	// true <nil>
+	// 0
+	// 5
+	// &{} &{Ana}
+	// 1 2
+	// 2 1
+	// Budi Budi
+	// Dewi Eka
+	// pp                  p                   x
+	// +--------------+    +--------------+    +----+
+	// | 0xc00000a0d0 |--->| 0xc00000a0c8 |--->| 42 |
+	// +--------------+    +--------------+    +----+
+	// 0xc00000a0d8        0xc00000a0d0        0xc00000a0c8
+	// 7 8
+}
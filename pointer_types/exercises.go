@@ -0,0 +1,95 @@
+package pointer_types
+
+import "github.com/fajarstrtn/golang-tutorial/exercise"
+
+func init() {
+	exercise.Register(exercise.Exercise{
+		ID:     "pointer_types/pointers/swap",
+		Lesson: "pointer_types/pointers",
+		Title:  "Swap two variables through pointers",
+		Prompt: "swap receives copies, so a and b do not change. Make swap take two *int and call it with &a and &b, so the program prints: 2 1",
+		Starter: `package main
+
+import "fmt"
+
+func swap(a, b int) {
+	a, b = b, a
+}
+
+func main() {
+	a, b := 1, 2
+	swap(a, b)
+	fmt.Println(a, b)
+}
+`,
+		Checks: []exercise.Check{
+			exercise.Calls("swap", 1),
+			exercise.OutputIs("2 1"),
+		},
+	})
+
+	exercise.Register(exercise.Exercise{
+		ID:     "pointer_types/escape/return-a-value",
+		Lesson: "pointer_types/escape",
+		Title:  "Keep a struct on the stack",
+		Prompt: "newPoint returns the address of a local variable, so p is moved to the heap and every call allocates. Make it return a Point instead of a *Point (and keep the go:noinline comment), so the program prints: 0 allocs 3",
+		Starter: `package main
+
+import (
+	"fmt"
+	"testing"
+)
+
+type Point struct {
+	X, Y int
+}
+
+//go:noinline
+func newPoint(x, y int) *Point {
+	p := Point{x, y}
+	return &p
+}
+
+func main() {
+	sum := 0
+	allocs := testing.AllocsPerRun(100, func() {
+		p := newPoint(1, 2)
+		sum = p.X + p.Y
+	})
+	fmt.Println(allocs, "allocs", sum)
+}
+`,
+		Checks: []exercise.Check{
+			exercise.Calls("newPoint", 1),
+			exercise.OutputIs("0 allocs 3"),
+		},
+	})
+
+	exercise.Register(exercise.Exercise{
+		ID:     "pointer_types/nil/nil-receiver",
+		Lesson: "pointer_types/nil",
+		Title:  "Handle a nil receiver",
+		Prompt: "users[\"budi\"] is a nil *User, so DisplayName panics with a nil pointer dereference. Make DisplayName return \"guest\" when its receiver is nil. The output must be:\nAna\nguest",
+		Starter: `package main
+
+import "fmt"
+
+type User struct {
+	Name string
+}
+
+func (u *User) DisplayName() string {
+	return u.Name
+}
+
+func main() {
+	users := map[string]*User{"ana": {Name: "Ana"}}
+	fmt.Println(users["ana"].DisplayName())
+	fmt.Println(users["budi"].DisplayName())
+}
+`,
+		Checks: []exercise.Check{
+			exercise.OutputIs("Ana\nguest"),
+		},
+	})
+}
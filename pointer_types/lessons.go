@@ -0,0 +1,9 @@
+package pointer_types
+
+import "github.com/fajarstrtn/golang-tutorial/lesson"
+
+func init() {
+	lesson.Register(lesson.Lesson{ID: "pointer_types/pointers", Title: "Pointers: & and *, new, and what points where", Topic: "pointer_types", Order: 900, Run: GeneratePointers})
+	lesson.Register(lesson.Lesson{ID: "pointer_types/escape", Title: "Escape analysis: stack or heap", Topic: "pointer_types", Order: 910, Run: GenerateEscapeAnalysis})
+	lesson.Register(lesson.Lesson{ID: "pointer_types/nil", Title: "nil pointer dereference", Topic: "pointer_types", Order: 920, Run: GenerateNilPointers})
+}
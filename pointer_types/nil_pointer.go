@@ -0,0 +1,84 @@
+package pointer_types
+
+import (
+	"fmt"
+	"io"
+	"runtime"
+)
+
+// DisplayName is safe to call on a nil *User: it checks the receiver before using it.
+func (u *User) DisplayName() string {
+	if u == nil {
+		return "guest"
+	}
+	return u.Name
+}
+
+func getNilDereference(w io.Writer) {
+	/*
+	 * A nil pointer holds the address 0. Reading or writing through it panics:
+	 * the operating system does not allow access to the first page of memory,
+	 * and the Go runtime turns the fault into a panic with a runtime.Error.
+	 *
+	 * Without recover, the program stops and prints:
+	 *
+	 *	panic: runtime error: invalid memory address or nil pointer dereference
+	 *	[signal SIGSEGV: segmentation violation code=0x1 addr=0x0 pc=0x...]
+	 *
+	 * addr is the address that was accessed: 0 for *p, or the offset of a field for p.Field. */
+	var p *int
+	panicked := recovered(func() { fmt.Fprintln(w, *p) })
+	fmt.Fprintln(w, panicked) // Output: runtime error: invalid memory address or nil pointer dereference
+
+	_, isRuntimeError := panicked.(runtime.Error)
+	fmt.Fprintln(w, isRuntimeError) // Output: true
+
+	// The fix is to check for nil before dereferencing, or to make sure the pointer is never nil.
+	if p != nil {
+		fmt.Fprintln(w, *p)
+	} else {
+		fmt.Fprintln(w, "p is nil") // Output: p is nil
+	}
+}
+
+func getNilStructPointer(w io.Writer) {
+	// Reading a field through a nil struct pointer is a dereference too: u.Name is (*u).Name.
+	var u *User
+	panicked := recovered(func() { fmt.Fprintln(w, u.Name) })
+	fmt.Fprintln(w, panicked) // Output: runtime error: invalid memory address or nil pointer dereference
+
+	/*
+	 * A common source of nil pointers is a map of pointers: a missing key gives the zero value, nil.
+	 * The comma ok form tells the missing key apart before the pointer is used. */
+	users := map[string]*User{"ana": {Name: "Ana"}}
+	panicked = recovered(func() { fmt.Fprintln(w, users["budi"].Name) })
+	fmt.Fprintln(w, panicked) // Output: runtime error: invalid memory address or nil pointer dereference
+
+	if user, ok := users["budi"]; ok {
+		fmt.Fprintln(w, user.Name)
+	} else {
+		fmt.Fprintln(w, "no user budi") // Output: no user budi
+	}
+}
+
+func getNilReceiver(w io.Writer) {
+	/*
+	 * Calling a method with a pointer receiver on a nil pointer does not panic by itself:
+	 * the method receives nil, and only panics if it dereferences it.
+	 * DisplayName checks for nil first; Rename does not. */
+	var u *User
+	fmt.Fprintln(w, u.DisplayName()) // Output: guest
+
+	panicked := recovered(func() { u.Rename("Ana") })
+	fmt.Fprintln(w, panicked) // Output: runtime error: invalid memory address or nil pointer dereference
+}
+
+// recovered runs f and returns what it panicked with, or nil.
+func recovered(f func()) (r any) {
+	defer func() {
+		r = recover()
+	}()
+
+	f()
+	return nil
+}
@@ -0,0 +1,179 @@
+package pointer_types
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"unsafe"
+
+	"github.com/fajarstrtn/golang-tutorial/lesson"
+)
+
+// A User has a name; the nil lesson calls its methods through nil pointers.
+type User struct {
+	Name string
+}
+
+// Rename has a pointer receiver, so it changes the User it is called on.
+func (u *User) Rename(name string) {
+	u.Name = name
+}
+
+func getAddressAndDereference(w io.Writer) {
+	/*
+	 * &x is the address of x. A pointer to an int has the type *int.
+	 * %p prints the address itself, which changes on every run (the diagram below shows addresses). */
+	x := 42
+	p := &x
+	fmt.Fprintf(w, "%T\n", p) // Output: *int
+
+	// *p reads and writes x through the pointer.
+	fmt.Fprintln(w, *p) // Output: 42
+
+	*p = 100
+	fmt.Fprintln(w, x) // Output: 100
+
+	// Two pointers are equal when they point to the same variable.
+	q := &x
+	fmt.Fprintln(w, p == q, *p == *q) // Output: true true
+
+	// A pointer that was never set is nil.
+	var empty *int
+	fmt.Fprintln(w, empty == nil, empty) // Output: true <nil>
+}
+
+func getNew(w io.Writer) {
+	/*
+	 * new(T) creates a variable of type T with its zero value and returns its address.
+	 * It is the same as declaring a variable and taking its address, without a name. */
+	n := new(int)
+	fmt.Fprintln(w, *n) // Output: 0
+
+	*n += 5
+	fmt.Fprintln(w, *n) // Output: 5
+
+	// For structs, &User{} is more common than new(User): it can set fields too.
+	a, b := new(User), &User{Name: "Ana"}
+	fmt.Fprintln(w, a, b) // Output: &{} &{Ana}
+}
+
+func getPointerParameters(w io.Writer) {
+	/*
+	 * Arguments are copied into the parameters, so a function cannot change the caller's variables,
+	 * unless it receives their addresses. */
+	a, b := 1, 2
+	swapCopies(a, b)
+	fmt.Fprintln(w, a, b) // Output: 1 2
+
+	swap(&a, &b)
+	fmt.Fprintln(w, a, b) // Output: 2 1
+}
+
+// swapCopies swaps its own copies: the caller does not see it.
+func swapCopies(a, b int) {
+	a, b = b, a
+}
+
+func swap(a, b *int) {
+	*a, *b = *b, *a
+}
+
+func getPointerToStruct(w io.Writer) {
+	/*
+	 * Fields are reached through a pointer with a dot: u.Name is short for (*u).Name.
+	 * Copying the pointer does not copy the struct: both pointers reach the same User. */
+	u := &User{Name: "Ana"}
+	other := u
+	other.Name = "Budi"
+	fmt.Fprintln(w, u.Name, (*u).Name) // Output: Budi Budi
+
+	/*
+	 * A method with a pointer receiver changes the struct (see the struct_types/methods lesson).
+	 * On a variable, Go takes the address by itself: v.Rename is (&v).Rename. */
+	v := User{Name: "Citra"}
+	v.Rename("Dewi")
+	u.Rename("Eka")
+	fmt.Fprintln(w, v.Name, other.Name) // Output: Dewi Eka
+}
+
+func getPointerDiagram(env *lesson.Env) {
+	x := 42
+	p := &x
+	pp := &p
+	addrX := env.Addr(uintptr(unsafe.Pointer(&x)))
+	addrP := env.Addr(uintptr(unsafe.Pointer(&p)))
+	addrPP := env.Addr(uintptr(unsafe.Pointer(&pp)))
+
+	/*
+	 * A pointer is a variable too, with its own address, so there can be a pointer to a pointer.
+	 * In the diagram, every box is a variable: its name above, its value inside, its address below.
+	 * The value of p is the address of x, and the value of pp is the address of p.
+	 *
+	 * Output:
+	 * pp                  p                   x
+	 * +--------------+    +--------------+    +----+
+	 * | 0xc00000a0d0 |--->| 0xc00000a0c8 |--->| 42 |
+	 * +--------------+    +--------------+    +----+
+	 * 0xc00000a0d8        0xc00000a0d0        0xc00000a0c8 */
+	drawPointers(env.Out,
+		box{"pp", fmt.Sprintf("%#x", env.Addr(uintptr(unsafe.Pointer(pp)))), addrPP},
+		box{"p", fmt.Sprintf("%#x", env.Addr(uintptr(unsafe.Pointer(p)))), addrP},
+		box{"x", fmt.Sprint(x), addrX},
+	)
+
+	/*
+	 * **pp is x: writing through pp changes x.
+	 * *pp = &y makes p point to another variable, and x is left alone. */
+	**pp = 7
+	y := 8
+	*pp = &y
+	fmt.Fprintln(env.Out, x, *p) // Output: 7 8
+}
+
+// A box is one variable of a pointer diagram: its name, its value as printed, and its address.
+type box struct {
+	name  string
+	value string
+	addr  uintptr
+}
+
+/*
+ * drawPointers draws the boxes from left to right, with an arrow from each box to the next:
+ * the value of each box must be the address of the next one. */
+func drawPointers(w io.Writer, boxes ...box) {
+	const ARROW = "--->"
+
+	var names, borders, values, addrs []string
+	for i, b := range boxes {
+		addr := fmt.Sprintf("%#x", b.addr)
+		width := max(len(b.value)+4, len(b.name), len(addr))
+
+		// The arrow starts at the right side of the box, so a box narrower than its column gets a longer arrow.
+		tail := " "
+		if i < len(boxes)-1 {
+			tail = "-"
+		}
+
+		border := "+" + strings.Repeat("-", len(b.value)+2) + "+"
+		names = append(names, pad(b.name, width))
+		borders = append(borders, pad(border, width))
+		values = append(values, "| "+b.value+" |"+strings.Repeat(tail, width-len(b.value)-4))
+		addrs = append(addrs, pad(addr, width))
+	}
+
+	gap := strings.Repeat(" ", len(ARROW))
+	for _, line := range []string{
+		strings.Join(names, gap),
+		strings.Join(borders, gap),
+		strings.Join(values, ARROW),
+		strings.Join(borders, gap),
+		strings.Join(addrs, gap),
+	} {
+		fmt.Fprintln(w, strings.TrimRight(line, " "))
+	}
+}
+
+// pad adds spaces after s up to width.
+func pad(s string, width int) string {
+	return s + strings.Repeat(" ", width-len(s))
+}
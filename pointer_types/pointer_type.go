@@ -0,0 +1,44 @@
+/*
+ * A pointer holds the address of a variable. data_types lists pointers among the reference types:
+ * copying a pointer copies the address, so both copies reach the same variable.
+ * 1. &x: The address of x, of type *T when x is a T
+ * 2. *p: The variable p points to (dereferencing p)
+ *
+ * The zero value of a pointer is nil: it points to nothing, and dereferencing it panics.
+ * Go has no pointer arithmetic (p + 1 does not compile); see the data_types/memory lesson for unsafe.
+ *
+ * The addresses printed by the lessons go through env.Addr, so they are the same on every run. */
+package pointer_types
+
+import "github.com/fajarstrtn/golang-tutorial/lesson"
+
+/*
+ * This lesson looks at:
+ * 1. & and *, and the nil pointer
+ * 2. new
+ * 3. Pointer parameters, pointers to structs and pointer receivers
+ * 4. A diagram of what points where */
+func GeneratePointers(env *lesson.Env) {
+	getAddressAndDereference(env.Out)
+	getNew(env.Out)
+	getPointerParameters(env.Out)
+	getPointerToStruct(env.Out)
+	getPointerDiagram(env)
+}
+
+/*
+ * The escape analysis decides at compile time whether a variable lives on the stack or on the heap.
+ * Pointers are what make a variable escape, so this lesson shows the decisions of the compiler
+ * (go build -gcflags=-m) for a few functions, next to the heap allocations of one call. */
+func GenerateEscapeAnalysis(env *lesson.Env) {
+	getEscapeAnalysis(env.Out)
+}
+
+/*
+ * Dereferencing a nil pointer panics with a runtime error.
+ * This lesson triggers the panic in a few ways, recovers it, and explains it. */
+func GenerateNilPointers(env *lesson.Env) {
+	getNilDereference(env.Out)
+	getNilStructPointer(env.Out)
+	getNilReceiver(env.Out)
+}
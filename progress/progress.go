@@ -0,0 +1,148 @@
+/*
+ * The progress package remembers what a learner has done:
+ * which lessons were viewed and which exercises were passed, with timestamps.
+ *
+ * Progress is stored as a JSON file in the user config directory
+ * (e.g., ~/.config/golang-tutorial/progress.json on Linux).
+ * The PROGRESS_FILE_ENV environment variable points it to another file,
+ * or switches it off with the value "off". */
+package progress
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	PROGRESS_FILE_ENV = "TUTORIAL_PROGRESS_FILE"
+	PROGRESS_OFF      = "off"
+
+	APP_DIR       = "golang-tutorial"
+	PROGRESS_FILE = "progress.json"
+)
+
+/*
+ * A Store is the progress of one learner:
+ * 1. Viewed: Lesson ID and the last time the lesson was run
+ * 2. Passed: Exercise ID and the first time the exercise was passed
+ *
+ * A Store returned by Open with the path "off" records nothing and never writes a file. */
+type Store struct {
+	Viewed map[string]time.Time `json:"viewed"`
+	Passed map[string]time.Time `json:"passed"`
+
+	path string
+}
+
+/*
+ * DefaultPath returns where the progress is stored:
+ * the value of PROGRESS_FILE_ENV if set, otherwise progress.json in the user config directory. */
+func DefaultPath() (string, error) {
+	if path := os.Getenv(PROGRESS_FILE_ENV); path != "" {
+		return path, nil
+	}
+
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, APP_DIR, PROGRESS_FILE), nil
+}
+
+// Open reads the progress stored at path. A missing file is an empty progress, not an error.
+func Open(path string) (*Store, error) {
+	s := &Store{
+		Viewed: map[string]time.Time{},
+		Passed: map[string]time.Time{},
+		path:   path,
+	}
+
+	if path == PROGRESS_OFF {
+		return s, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, s); err != nil {
+		return nil, err
+	}
+
+	// A file written by hand may miss one of the maps.
+	if s.Viewed == nil {
+		s.Viewed = map[string]time.Time{}
+	}
+	if s.Passed == nil {
+		s.Passed = map[string]time.Time{}
+	}
+
+	return s, nil
+}
+
+// OpenDefault opens the store at DefaultPath.
+func OpenDefault() (*Store, error) {
+	path, err := DefaultPath()
+	if err != nil {
+		return nil, err
+	}
+
+	return Open(path)
+}
+
+// Path returns the file the store is saved to.
+func (s *Store) Path() string {
+	return s.path
+}
+
+// MarkViewed records that the lesson was run at the given time.
+func (s *Store) MarkViewed(lessonID string, at time.Time) {
+	s.Viewed[lessonID] = at
+}
+
+// MarkPassed records that the exercise was passed, keeping the time of the first pass.
+func (s *Store) MarkPassed(exerciseID string, at time.Time) {
+	if _, ok := s.Passed[exerciseID]; !ok {
+		s.Passed[exerciseID] = at
+	}
+}
+
+// Reset forgets everything.
+func (s *Store) Reset() {
+	s.Viewed = map[string]time.Time{}
+	s.Passed = map[string]time.Time{}
+}
+
+/*
+ * Save writes the store back to its file, creating the directory if needed.
+ * The file is written to a temporary name first and then renamed,
+ * so an interrupted save never leaves a half-written file behind. */
+func (s *Store) Save() error {
+	if s.path == PROGRESS_OFF {
+		return nil
+	}
+
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o755); err != nil {
+		return err
+	}
+
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, append(data, '\n'), 0o644); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, s.path)
+}
@@ -0,0 +1,161 @@
+package struct_types
+
+import (
+	"fmt"
+	"io"
+)
+
+// A Person has a name and an age.
+type Person struct {
+	Name string
+	Age  int
+}
+
+// Greet introduces the person.
+func (p Person) Greet() string {
+	return "Hello, I am " + p.Name
+}
+
+// Birthday adds one year to the age.
+func (p *Person) Birthday() {
+	p.Age++
+}
+
+// An Address is where someone lives.
+type Address struct {
+	City    string
+	Country string
+}
+
+// An Employee embeds a Person and an Address: their fields and methods are promoted to Employee.
+type Employee struct {
+	Person
+	Address
+	Title string
+}
+
+// A Manager embeds an Employee, and declares its own Greet, which shadows the promoted one.
+type Manager struct {
+	Employee
+	Reports []string
+}
+
+// Greet introduces the manager with the number of people they manage.
+func (m Manager) Greet() string {
+	return fmt.Sprintf("%s, and I manage %d people", m.Employee.Greet(), len(m.Reports))
+}
+
+// A Company has a Name too, so a struct that embeds both a Person and a Company has two Name fields.
+type Company struct {
+	Name string
+}
+
+// A Contractor embeds two types with a Name field: Contractor.Name is ambiguous.
+type Contractor struct {
+	Person
+	Company
+}
+
+// A Greeter is anything with a Greet method.
+type Greeter interface {
+	Greet() string
+}
+
+// A Version prints itself as v1.2, with a String method.
+type Version struct {
+	Major, Minor int
+}
+
+// String returns the version as v<major>.<minor>.
+func (v Version) String() string {
+	return fmt.Sprintf("v%d.%d", v.Major, v.Minor)
+}
+
+// A Release embeds a Version, so it gets its String method too.
+type Release struct {
+	Version
+	Name string
+}
+
+func getEmbedding(w io.Writer) {
+	/*
+	 * An embedded field is named after its type (Person, Address).
+	 * Its fields are promoted: e.Name is short for e.Person.Name, and e.City for e.Address.City. */
+	e := Employee{
+		Person:  Person{Name: "Ana", Age: 30},
+		Address: Address{City: "Bandung", Country: "Indonesia"},
+		Title:   "Engineer",
+	}
+	fmt.Fprintln(w, e.Name, e.Person.Name, e.City, e.Title) // Output: Ana Ana Bandung Engineer
+
+	e.Age = 31
+	fmt.Fprintln(w, e.Person.Age) // Output: 31
+
+	/*
+	 * The literal must still use the embedded field names:
+	 * Employee{Name: "Ana"} does not compile, because Name is not a field of Employee itself.
+	 *
+	 * Promotion goes through every level: a Manager embeds an Employee, which embeds a Person. */
+	m := Manager{Employee: e, Reports: []string{"Budi", "Citra"}}
+	fmt.Fprintln(w, m.Name, m.Employee.Person.Name, m.Country) // Output: Ana Ana Indonesia
+}
+
+func getPromotedMethods(w io.Writer) {
+	// The methods of the embedded types are promoted too.
+	e := Employee{Person: Person{Name: "Ana", Age: 30}}
+	fmt.Fprintln(w, e.Greet()) // Output: Hello, I am Ana
+
+	// e is addressable, so the pointer method Birthday is called on &e.Person.
+	e.Birthday()
+	fmt.Fprintln(w, e.Age) // Output: 31
+
+	/*
+	 * The promoted methods are part of the method set of Employee, so an Employee is a Greeter.
+	 * Like with receivers, only *Employee gets the pointer methods of an embedded Person.
+	 * Embedding a *Person instead would put them in the method sets of both Employee and *Employee. */
+	var g Greeter = e
+	_, canBirthday := any(e).(interface{ Birthday() })
+	_, pointerCanBirthday := any(&e).(interface{ Birthday() })
+	fmt.Fprintln(w, g.Greet(), canBirthday, pointerCanBirthday) // Output: Hello, I am Ana false true
+}
+
+func getShadowing(w io.Writer) {
+	/*
+	 * A field or method declared on the outer struct shadows a promoted one with the same name:
+	 * the shallower one wins. The shadowed one is still there, through the embedded field name.
+	 * There is no virtual dispatch: Employee.Greet never calls Manager.Greet. */
+	m := Manager{
+		Employee: Employee{Person: Person{Name: "Ana"}},
+		Reports:  []string{"Budi", "Citra"},
+	}
+	fmt.Fprintln(w, m.Greet())          // Output: Hello, I am Ana, and I manage 2 people
+	fmt.Fprintln(w, m.Employee.Greet()) // Output: Hello, I am Ana
+
+	/*
+	 * Two promoted fields with the same name at the same depth are ambiguous:
+	 * c.Name does not compile, and each must be named through its embedded field. */
+	c := Contractor{Person: Person{Name: "Dewi"}, Company: Company{Name: "Gopher Ltd"}}
+	fmt.Fprintln(w, c.Person.Name, "/", c.Company.Name) // Output: Dewi / Gopher Ltd
+}
+
+func getEmbeddingFormatting(w io.Writer) {
+	/*
+	 * Under %+v and %#v an embedded field shows with its type name as the field name,
+	 * which is how the nesting can be seen. */
+	e := Employee{
+		Person:  Person{Name: "Ana", Age: 30},
+		Address: Address{City: "Bandung", Country: "Indonesia"},
+		Title:   "Engineer",
+	}
+	fmt.Fprintf(w, "%v\n", e)  // Output: {{Ana 30} {Bandung Indonesia} Engineer}
+	fmt.Fprintf(w, "%+v\n", e) // Output: {Person:{Name:Ana Age:30} Address:{City:Bandung Country:Indonesia} Title:Engineer}
+	fmt.Fprintf(w, "%#v\n", e) // Output: struct_types.Employee{Person:struct_types.Person{Name:"Ana", Age:30}, Address:struct_types.Address{City:"Bandung", Country:"Indonesia"}, Title:"Engineer"}
+
+	/*
+	 * A promoted String method is a trap: it makes the outer struct a fmt.Stringer,
+	 * so %v and %+v print only the embedded Version and lose the Name.
+	 * %#v does not use String, and still shows every field. */
+	r := Release{Version: Version{1, 2}, Name: "Gopher"}
+	fmt.Fprintf(w, "%v %+v\n", r, r) // Output: v1.2 v1.2
+	fmt.Fprintf(w, "%#v\n", r)       // Output: struct_types.Release{Version:struct_types.Version{Major:1, Minor:2}, Name:"Gopher"}
+}
@@ -0,0 +1,86 @@
+// Code generated by "go run . examples"; DO NOT EDIT.
+
+package struct_types_test
+
+import (
+	"os"
+
+	"github.com/fajarstrtn/golang-tutorial/lesson"
+	"github.com/fajarstrtn/golang-tutorial/struct_types"
+)
+
+// ExampleGenerateEmbedding runs the struct_types/embedding lesson.
+func ExampleGenerateEmbedding() {
+	struct_types.GenerateEmbedding(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// Ana Ana Bandung Engineer
+	// 31
+	// Ana Ana Indonesia
+	// Hello, I am Ana
+	// 31
+	// Hello, I am Ana false true
+	// Hello, I am Ana, and I manage 2 people
+	// Hello, I am Ana
+	// Dewi / Gopher Ltd
+	// {{Ana 30} {Bandung Indonesia} Engineer}
+	// {Person:{Name:Ana Age:30} Address:{City:Bandung Country:Indonesia} Title:Engineer}
+	// struct_types.Employee{Person:struct_types.Person{Name:"Ana", Age:30}, Address:struct_types.Address{City:"Bandung", Country:"Indonesia"}, Title:"Engineer"}
+	// v1.2 v1.2
+	// struct_types.Release{Version:struct_types.Version{Major:1, Minor:2}, Name:"Gopher"}
+}
+
+// ExampleGenerateMethods runs the struct_types/methods lesson.
+func ExampleGenerateMethods() {
+	struct_types.GenerateMethods(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// 5
+	// {4 5} {14 15} {4 5}
+	// 10
+	// 0
+	// 2
+	// struct_types.Counter : IncrementCopy Value
+	// *struct_types.Counter: Increment IncrementCopy Reset Value
+	// false true
+	// 1
+	// 2
+	// 2 3
+	// func(struct_types.Counter) int
+	// func(*struct_types.Counter)
+	// 3
+}
+
+// ExampleGenerateStructs runs the struct_types/structs lesson.
+func ExampleGenerateStructs() {
+	struct_types.GenerateStructs(lesson.NewDeterministicEnv(os.Stdout, os.Stdout))
+	// Output:
+	// {0 0} {1 2} {0 5}
+	// 3 0
+	// {1 2} {100 2}
+	// {10 2} &{0 0}
+	// true 1
+	// localhost 8080
+	// struct { Host string; Port int }
+	// 2 + 3 = 5
+	// 4 + 5 = 9
+	// {0 0} {3 4}
+	// struct_types.Vector
+	// Title  json:"title"           title
+	// Author json:"author,omitempty" author,omitempty
+	// Pages  json:"pages"           pages
+	// ISBN   json:"-"               -
+	// {"title":"The Go Programming Language","pages":380}
+	// {Title:Go in Action Author:William Kennedy Pages:264 ISBN:}
+	// true false
+	// true false
+	// false true
+	// runtime error: comparing uncomparable type struct_types.Team
+	// {1 2}
+	// {X:1 Y:2}
+	// struct_types.Point{X:1, Y:2}
+	// &{1 2} &{X:1 Y:2}
+	// &struct_types.Point{X:1, Y:2}
+	// struct { Host string; Port int }{Host:"localhost", Port:8080}
+	// {gophers [Ana]}
+	// {Name:gophers Members:[Ana]}
+	// struct_types.Team{Name:"gophers", Members:[]string{"Ana"}}
+}
@@ -0,0 +1,100 @@
+package struct_types
+
+import "github.com/fajarstrtn/golang-tutorial/exercise"
+
+func init() {
+	exercise.Register(exercise.Exercise{
+		ID:     "struct_types/structs/json-tags",
+		Lesson: "struct_types/structs",
+		Title:  "Name the JSON fields with tags",
+		Prompt: "Add json tags to Book so the title and pages are written in lowercase, the author is left out when it is empty, and the ISBN is never written. The output must be: {\"title\":\"Go\",\"pages\":300}",
+		Starter: `package main
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type Book struct {
+	Title  string
+	Author string
+	Pages  int
+	ISBN   string
+}
+
+func main() {
+	data, _ := json.Marshal(Book{Title: "Go", Pages: 300, ISBN: "123"})
+	fmt.Println(string(data))
+}
+`,
+		Checks: []exercise.Check{
+			exercise.Calls("json.Marshal", 1),
+			exercise.OutputIs(`{"title":"Go","pages":300}`),
+		},
+	})
+
+	exercise.Register(exercise.Exercise{
+		ID:     "struct_types/methods/pointer-receiver",
+		Lesson: "struct_types/methods",
+		Title:  "Change a struct in a method",
+		Prompt: "Increment has a value receiver, so it increments a copy and the counter stays at 0. Give it a pointer receiver so the program prints: 3",
+		Starter: `package main
+
+import "fmt"
+
+type Counter struct {
+	count int
+}
+
+func (c Counter) Increment() {
+	c.count++
+}
+
+func main() {
+	var c Counter
+	c.Increment()
+	c.Increment()
+	c.Increment()
+	fmt.Println(c.count)
+}
+`,
+		Checks: []exercise.Check{
+			exercise.Calls("c.Increment", 3),
+			exercise.OutputIs("3"),
+		},
+	})
+
+	exercise.Register(exercise.Exercise{
+		ID:     "struct_types/embedding/promote",
+		Lesson: "struct_types/embedding",
+		Title:  "Promote fields and methods by embedding",
+		Prompt: "The starter does not compile because Employee has a person field, not the fields and methods of Person. Embed Person in Employee (and fix the literal) so e.Name and e.Greet() work. The output must be:\nAna\nHello, I am Ana",
+		Starter: `package main
+
+import "fmt"
+
+type Person struct {
+	Name string
+}
+
+func (p Person) Greet() string {
+	return "Hello, I am " + p.Name
+}
+
+type Employee struct {
+	person Person
+	Title  string
+}
+
+func main() {
+	e := Employee{person: Person{Name: "Ana"}, Title: "Engineer"}
+	fmt.Println(e.Name)
+	fmt.Println(e.Greet())
+}
+`,
+		Checks: []exercise.Check{
+			exercise.Calls("e.Greet", 1),
+			exercise.OutputIs("Ana\nHello, I am Ana"),
+		},
+	})
+}
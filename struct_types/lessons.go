@@ -0,0 +1,9 @@
+package struct_types
+
+import "github.com/fajarstrtn/golang-tutorial/lesson"
+
+func init() {
+	lesson.Register(lesson.Lesson{ID: "struct_types/structs", Title: "Structs, anonymous structs and tags", Topic: "struct_types", Order: 700, Run: GenerateStructs})
+	lesson.Register(lesson.Lesson{ID: "struct_types/methods", Title: "Methods, receivers and method sets", Topic: "struct_types", Order: 710, Run: GenerateMethods})
+	lesson.Register(lesson.Lesson{ID: "struct_types/embedding", Title: "Embedding and promoted fields", Topic: "struct_types", Order: 720, Run: GenerateEmbedding})
+}
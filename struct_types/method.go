@@ -0,0 +1,145 @@
+package struct_types
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"strings"
+)
+
+// Distance has a value receiver: it reads a copy of the point.
+func (p Point) Distance() float64 {
+	return math.Hypot(float64(p.X), float64(p.Y))
+}
+
+// Move has a pointer receiver: it changes the point it is called on.
+func (p *Point) Move(dx, dy int) {
+	p.X += dx
+	p.Y += dy
+}
+
+// Moved has a value receiver, so it cannot change the point: it returns a moved copy instead.
+func (p Point) Moved(dx, dy int) Point {
+	p.X += dx
+	p.Y += dy
+	return p
+}
+
+// A Counter counts up from zero; its zero value is ready to use.
+type Counter struct {
+	count int
+}
+
+// Value returns the count.
+func (c Counter) Value() int {
+	return c.count
+}
+
+// IncrementCopy has a value receiver by mistake: it increments a copy, which is then thrown away.
+func (c Counter) IncrementCopy() {
+	c.count++
+}
+
+// Increment adds one to the count.
+func (c *Counter) Increment() {
+	c.count++
+}
+
+// Reset sets the count back to zero.
+func (c *Counter) Reset() {
+	c.count = 0
+}
+
+// An Incrementer is anything with an Increment method.
+type Incrementer interface {
+	Increment()
+}
+
+func getReceivers(w io.Writer) {
+	/*
+	 * A method is called on a value with a dot, like a field.
+	 * 1. Value receiver (p Point)   : The method gets a copy; use it for small values it only reads
+	 * 2. Pointer receiver (p *Point): The method gets the address; use it to change the value,
+	 *    or to avoid copying a large struct
+	 *
+	 * When one method of a type needs a pointer receiver, the convention is to give all of them one. */
+	p := Point{3, 4}
+	fmt.Fprintln(w, p.Distance()) // Output: 5
+
+	// p is a variable, so Go takes its address for the pointer receiver: p.Move is (&p).Move.
+	p.Move(1, 1)
+	fmt.Fprintln(w, p, p.Moved(10, 10), p) // Output: {4 5} {14 15} {4 5}
+
+	// Through a pointer, a value method is called on the value it points to: pp.Distance is (*pp).Distance.
+	pp := &Point{6, 8}
+	fmt.Fprintln(w, pp.Distance()) // Output: 10
+
+	var c Counter
+	c.IncrementCopy()
+	c.IncrementCopy()
+	fmt.Fprintln(w, c.Value()) // Output: 0
+
+	c.Increment()
+	c.Increment()
+	fmt.Fprintln(w, c.Value()) // Output: 2
+}
+
+func getMethodSets(w io.Writer) {
+	/*
+	 * The method set of a type is the methods that can be called on any of its values,
+	 * and it decides which interfaces the type implements:
+	 * 1. T : The methods with a value receiver
+	 * 2. *T: The methods with a value receiver and the methods with a pointer receiver
+	 *
+	 * A T is not always addressable (e.g., a map element, a function result, or a copy inside an interface),
+	 * so Go cannot always take its address, and pointer methods are not in its method set.
+	 *
+	 * Output:
+	 * struct_types.Counter : IncrementCopy Value
+	 * *struct_types.Counter: Increment IncrementCopy Reset Value */
+	for _, t := range []reflect.Type{reflect.TypeFor[Counter](), reflect.TypeFor[*Counter]()} {
+		var names []string
+		for i := range t.NumMethod() {
+			names = append(names, t.Method(i).Name)
+		}
+		fmt.Fprintf(w, "%-21s: %s\n", t, strings.Join(names, " "))
+	}
+
+	// So only a *Counter is an Incrementer: var i Incrementer = Counter{} does not compile.
+	_, valueIs := any(Counter{}).(Incrementer)
+	_, pointerIs := any(&Counter{}).(Incrementer)
+	fmt.Fprintln(w, valueIs, pointerIs) // Output: false true
+
+	/*
+	 * A map element is not addressable, so counters["a"].Increment() does not compile.
+	 * Store pointers in the map to call pointer methods on its elements. */
+	counters := map[string]*Counter{"a": {}}
+	counters["a"].Increment()
+	fmt.Fprintln(w, counters["a"].Value()) // Output: 1
+}
+
+func getMethodValues(w io.Writer) {
+	/*
+	 * A method value (c.Increment) is a function bound to its receiver:
+	 * here to &c, so calling it changes c. */
+	var c Counter
+	increment := c.Increment
+	increment()
+	increment()
+	fmt.Fprintln(w, c.Value()) // Output: 2
+
+	/*
+	 * A value receiver is copied when the method value is made,
+	 * so a later change to c is not seen by it. */
+	value := c.Value
+	c.Increment()
+	fmt.Fprintln(w, value(), c.Value()) // Output: 2 3
+
+	/*
+	 * A method expression (Counter.Value) is a plain function
+	 * that takes the receiver as its first parameter. */
+	fmt.Fprintf(w, "%T\n", Counter.Value)        // Output: func(struct_types.Counter) int
+	fmt.Fprintf(w, "%T\n", (*Counter).Increment) // Output: func(*struct_types.Counter)
+	fmt.Fprintln(w, Counter.Value(c))            // Output: 3
+}
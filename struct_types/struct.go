@@ -0,0 +1,194 @@
+package struct_types
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+)
+
+// A Point is a position on a grid.
+type Point struct {
+	X, Y int
+}
+
+// A Vector has the same fields as a Point, so the two can be converted into each other.
+type Vector struct {
+	X, Y int
+}
+
+/*
+ * A Book has tags: strings after the field types, read by other packages through reflection.
+ * encoding/json uses the json key:
+ * 1. json:"title"          : The name of the field in JSON
+ * 2. json:"author,omitempty": The same, and the field is left out when it is the zero value
+ * 3. json:"-"              : The field is never written */
+type Book struct {
+	Title  string `json:"title"`
+	Author string `json:"author,omitempty"`
+	Pages  int    `json:"pages"`
+	ISBN   string `json:"-"`
+}
+
+// A Team is not comparable: one of its fields is a slice.
+type Team struct {
+	Name    string
+	Members []string
+}
+
+func getStructDeclarations(w io.Writer) {
+	/*
+	 * There are three ways to make a struct value:
+	 * 1. var p Point       : Every field is the zero value
+	 * 2. Point{1, 2}       : Every field, in order (breaks when a field is added, so use it only for small types)
+	 * 3. Point{Y: 5}       : By field name; the missing fields are zero values */
+	var p Point
+	q := Point{1, 2}
+	r := Point{Y: 5}
+	fmt.Fprintln(w, p, q, r) // Output: {0 0} {1 2} {0 5}
+
+	// Fields are read and written with a dot.
+	p.X = 3
+	fmt.Fprintln(w, p.X, p.Y) // Output: 3 0
+
+	// A struct is a value: the copy has its own fields.
+	copied := q
+	copied.X = 100
+	fmt.Fprintln(w, q, copied) // Output: {1 2} {100 2}
+
+	/*
+	 * Through a pointer, a field is still read with a dot: pp.X is short for (*pp).X.
+	 * &Point{...} and new(Point) both make a struct and return its address. */
+	pp := &Point{1, 2}
+	pp.X = 10
+	fmt.Fprintln(w, *pp, new(Point)) // Output: {10 2} &{0 0}
+
+	/*
+	 * The empty struct, struct{}, has no fields and takes no memory.
+	 * A map to struct{} is the usual way to write a set. */
+	seen := map[string]struct{}{}
+	seen["go"] = struct{}{}
+	_, ok := seen["go"]
+	fmt.Fprintln(w, ok, len(seen)) // Output: true 1
+}
+
+func getAnonymousStructs(w io.Writer) {
+	/*
+	 * An anonymous struct is declared and used in one place, without a type name.
+	 * It fits a value that is needed only once (e.g., a configuration, or the rows of a table-driven test). */
+	server := struct {
+		Host string
+		Port int
+	}{"localhost", 8080}
+	fmt.Fprintln(w, server.Host, server.Port) // Output: localhost 8080
+	fmt.Fprintf(w, "%T\n", server)            // Output: struct { Host string; Port int }
+
+	tests := []struct {
+		a, b, want int
+	}{
+		{2, 3, 5},
+		{4, 5, 9},
+	}
+
+	/*
+	 * Output:
+	 * 2 + 3 = 5
+	 * 4 + 5 = 9 */
+	for _, test := range tests {
+		fmt.Fprintf(w, "%d + %d = %d\n", test.a, test.b, test.want)
+	}
+
+	/*
+	 * An anonymous struct can be assigned to a named struct type with the same fields,
+	 * and two named struct types with the same fields (tags ignored) can be converted into each other. */
+	var origin Point = struct{ X, Y int }{0, 0}
+	v := Vector(Point{3, 4})
+	fmt.Fprintln(w, origin, v) // Output: {0 0} {3 4}
+	fmt.Fprintf(w, "%T\n", v)  // Output: struct_types.Vector
+}
+
+func getStructTags(w io.Writer) {
+	bookType := reflect.TypeFor[Book]()
+
+	/*
+	 * reflect reads the tags; Tag.Get returns the value of one key.
+	 *
+	 * Output:
+	 * Title  json:"title"           title
+	 * Author json:"author,omitempty" author,omitempty
+	 * Pages  json:"pages"           pages
+	 * ISBN   json:"-"               - */
+	for i := range bookType.NumField() {
+		field := bookType.Field(i)
+		fmt.Fprintf(w, "%-6s %-22s %s\n", field.Name, field.Tag, field.Tag.Get("json"))
+	}
+
+	// The Author is empty and left out (omitempty), and the ISBN is never written (-).
+	book := Book{Title: "The Go Programming Language", Pages: 380, ISBN: "978-0134190440"}
+	data, _ := json.Marshal(book)
+	fmt.Fprintln(w, string(data)) // Output: {"title":"The Go Programming Language","pages":380}
+
+	var decoded Book
+	json.Unmarshal([]byte(`{"title":"Go in Action","author":"William Kennedy","pages":264}`), &decoded)
+	fmt.Fprintf(w, "%+v\n", decoded) // Output: {Title:Go in Action Author:William Kennedy Pages:264 ISBN:}
+}
+
+func getStructComparison(w io.Writer) {
+	/*
+	 * Two structs of the same type are equal when all their fields are equal.
+	 * Comparable structs can be map keys. */
+	fmt.Fprintln(w, Point{1, 2} == Point{1, 2}, Point{1, 2} == Point{2, 1}) // Output: true false
+
+	visited := map[Point]bool{{0, 0}: true}
+	visited[Point{1, 2}] = true
+	fmt.Fprintln(w, visited[Point{1, 2}], visited[Point{5, 5}]) // Output: true false
+
+	/*
+	 * A struct with a slice, map or function field is not comparable:
+	 * a == b does not compile. Hidden behind any, the comparison compiles but panics.
+	 * reflect.DeepEqual compares such values field by field. */
+	a := Team{"gophers", []string{"Ana", "Budi"}}
+	b := Team{"gophers", []string{"Ana", "Budi"}}
+	fmt.Fprintln(w, reflect.TypeOf(a).Comparable(), reflect.DeepEqual(a, b)) // Output: false true
+	fmt.Fprintln(w, compareAny(a, b))                                        // Output: runtime error: comparing uncomparable type struct_types.Team
+}
+
+// compareAny compares two values through any, and returns the panic instead when they are not comparable.
+func compareAny(a, b any) (result any) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = r
+		}
+	}()
+
+	return a == b
+}
+
+func getStructFormatting(w io.Writer) {
+	/*
+	 * The fmt verbs print a struct in three levels of detail:
+	 * 1. %v : The values only
+	 * 2. %+v: The field names and values
+	 * 3. %#v: Go syntax, with the package and type name and quoted strings */
+	p := Point{1, 2}
+	fmt.Fprintf(w, "%v\n", p)  // Output: {1 2}
+	fmt.Fprintf(w, "%+v\n", p) // Output: {X:1 Y:2}
+	fmt.Fprintf(w, "%#v\n", p) // Output: struct_types.Point{X:1, Y:2}
+
+	// A pointer to a struct is printed as & and the struct, not as an address.
+	fmt.Fprintf(w, "%v %+v\n", &p, &p) // Output: &{1 2} &{X:1 Y:2}
+	fmt.Fprintf(w, "%#v\n", &p)        // Output: &struct_types.Point{X:1, Y:2}
+
+	// %#v of an anonymous struct spells out its type.
+	server := struct {
+		Host string
+		Port int
+	}{"localhost", 8080}
+	fmt.Fprintf(w, "%#v\n", server) // Output: struct { Host string; Port int }{Host:"localhost", Port:8080}
+
+	// Nested slices and strings show the difference best.
+	team := Team{"gophers", []string{"Ana"}}
+	fmt.Fprintf(w, "%v\n", team)  // Output: {gophers [Ana]}
+	fmt.Fprintf(w, "%+v\n", team) // Output: {Name:gophers Members:[Ana]}
+	fmt.Fprintf(w, "%#v\n", team) // Output: struct_types.Team{Name:"gophers", Members:[]string{"Ana"}}
+}
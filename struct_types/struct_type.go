@@ -0,0 +1,47 @@
+/*
+ * A struct groups named fields, of any types, into one value.
+ * This package covers:
+ * 1. Structs  : Declaration, literals, anonymous structs, tags and comparison
+ * 2. Methods  : Value and pointer receivers, and method sets
+ * 3. Embedding: Fields and methods promoted from an embedded type
+ *
+ * Every lesson also shows how its values look under %v, %+v and %#v
+ * (see the format/verbs lesson for the verbs themselves). */
+package struct_types
+
+import "github.com/fajarstrtn/golang-tutorial/lesson"
+
+/*
+ * A struct type lists its fields, each with a name and a type.
+ * A struct is a value like an array: assigning or passing it copies every field. */
+func GenerateStructs(env *lesson.Env) {
+	getStructDeclarations(env.Out)
+	getAnonymousStructs(env.Out)
+	getStructTags(env.Out)
+	getStructComparison(env.Out)
+	getStructFormatting(env.Out)
+}
+
+/*
+ * A method is a function with a receiver, declared outside the struct:
+ * func (p Point) Distance() float64.
+ * This lesson looks at:
+ * 1. Value receivers, which work on a copy, and pointer receivers, which can change the original
+ * 2. Method sets: which methods a T and a *T have, and what that means for interfaces
+ * 3. Method values and method expressions */
+func GenerateMethods(env *lesson.Env) {
+	getReceivers(env.Out)
+	getMethodSets(env.Out)
+	getMethodValues(env.Out)
+}
+
+/*
+ * A field declared with a type but no name is embedded:
+ * its fields and methods are promoted, so they can be used as if they belonged to the outer struct.
+ * Embedding is composition, not inheritance: the outer struct has the inner one, it is not one. */
+func GenerateEmbedding(env *lesson.Env) {
+	getEmbedding(env.Out)
+	getPromotedMethods(env.Out)
+	getShadowing(env.Out)
+	getEmbeddingFormatting(env.Out)
+}
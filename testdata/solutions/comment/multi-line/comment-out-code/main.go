@@ -0,0 +1,11 @@
+package main
+
+import "fmt"
+
+func main() {
+	fmt.Println("Hello Jakarta!")
+	/*
+		fmt.Println("Hello Bandung!")
+		fmt.Println("Hello Surabaya!")
+	*/
+}
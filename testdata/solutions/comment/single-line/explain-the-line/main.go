@@ -0,0 +1,8 @@
+package main
+
+import "fmt"
+
+func main() {
+	// Println prints the greeting followed by a newline.
+	fmt.Println("Hello John Doe!")
+}
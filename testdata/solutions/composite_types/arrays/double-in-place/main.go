@@ -0,0 +1,15 @@
+package main
+
+import "fmt"
+
+func double(numbers *[3]int) {
+	for i := range numbers {
+		numbers[i] *= 2
+	}
+}
+
+func main() {
+	numbers := [3]int{1, 2, 3}
+	double(&numbers)
+	fmt.Println(numbers)
+}
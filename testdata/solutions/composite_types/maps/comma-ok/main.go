@@ -0,0 +1,15 @@
+package main
+
+import "fmt"
+
+func main() {
+	stock := map[string]int{"apple": 0, "banana": 4}
+
+	for _, fruit := range []string{"apple", "banana", "cherry"} {
+		if count, ok := stock[fruit]; ok {
+			fmt.Println(fruit, count)
+		} else {
+			fmt.Println(fruit, "missing")
+		}
+	}
+}
@@ -0,0 +1,11 @@
+package main
+
+import "fmt"
+
+func main() {
+	base := []int{1, 2, 3, 4, 5}
+	head := base[0:2:2]
+	head = append(head, 100)
+
+	fmt.Println(base, head)
+}
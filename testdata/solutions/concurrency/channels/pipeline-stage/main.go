@@ -0,0 +1,33 @@
+package main
+
+import "fmt"
+
+func generate(n int) <-chan int {
+	out := make(chan int)
+	go func() {
+		defer close(out)
+		for i := 1; i <= n; i++ {
+			out <- i
+		}
+	}()
+	return out
+}
+
+func double(in <-chan int) <-chan int {
+	out := make(chan int)
+	go func() {
+		defer close(out)
+		for n := range in {
+			out <- n * 2
+		}
+	}()
+	return out
+}
+
+func main() {
+	var results []int
+	for n := range double(generate(4)) {
+		results = append(results, n)
+	}
+	fmt.Println(results)
+}
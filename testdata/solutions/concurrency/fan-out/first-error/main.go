@@ -0,0 +1,50 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+var ErrNotFound = errors.New("not found")
+
+var SIZES = map[string]int{"/": 512, "/about": 1024, "/contact": 256}
+
+func fetch(path string) (int, error) {
+	time.Sleep(100 * time.Millisecond)
+	size, ok := SIZES[path]
+	if !ok {
+		return 0, fmt.Errorf("fetch %s: %w", path, ErrNotFound)
+	}
+	return size, nil
+}
+
+func fetchAll(paths []string) ([]int, error) {
+	sizes := make([]int, len(paths))
+	errs := make([]error, len(paths))
+
+	var wg sync.WaitGroup
+	for i, path := range paths {
+		wg.Go(func() {
+			sizes[i], errs[i] = fetch(path)
+		})
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return sizes, nil
+}
+
+func main() {
+	start := time.Now()
+	fmt.Println(fetchAll([]string{"/", "/about", "/contact"}))
+	fmt.Println(fetchAll([]string{"/", "/missing", "/contact"}))
+
+	// One after the other, the two calls take 500ms; in parallel, 200ms.
+	fmt.Println("fast:", time.Since(start) < 350*time.Millisecond)
+}
@@ -0,0 +1,23 @@
+package main
+
+import (
+	"fmt"
+	"sync"
+)
+
+func main() {
+	words := []string{"go", "is", "fun", "gopher"}
+	lengths := make([]int, len(words))
+
+	var wg sync.WaitGroup
+	for i, word := range words {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			lengths[i] = len(word)
+		}()
+	}
+	wg.Wait()
+
+	fmt.Println(lengths)
+}
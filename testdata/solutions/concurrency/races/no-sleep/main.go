@@ -0,0 +1,15 @@
+package main
+
+import "fmt"
+
+func main() {
+	var message string
+	done := make(chan struct{})
+	go func() {
+		message = "ready"
+		close(done)
+	}()
+
+	<-done
+	fmt.Println(message)
+}
@@ -0,0 +1,25 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+func receive(ch <-chan string, d time.Duration) (string, error) {
+	select {
+	case s := <-ch:
+		return s, nil
+	case <-time.After(d):
+		return "", errors.New("timed out")
+	}
+}
+
+func main() {
+	ready := make(chan string, 1)
+	ready <- "hello"
+	fmt.Println(receive(ready, time.Second))
+
+	never := make(chan string)
+	fmt.Println(receive(never, 10*time.Millisecond))
+}
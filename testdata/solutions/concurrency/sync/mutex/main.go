@@ -0,0 +1,25 @@
+package main
+
+import (
+	"fmt"
+	"sync"
+)
+
+func main() {
+	counter := 0
+	var mu sync.Mutex
+
+	var wg sync.WaitGroup
+	for range 2 {
+		wg.Go(func() {
+			for range 1000 {
+				mu.Lock()
+				counter++
+				mu.Unlock()
+			}
+		})
+	}
+	wg.Wait()
+
+	fmt.Println(counter)
+}
@@ -0,0 +1,24 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+)
+
+func process(name string) error {
+	fmt.Println("open", name)
+	defer fmt.Println("close", name)
+	if name == "b" {
+		return errors.New(name + " failed")
+	}
+	fmt.Println("work", name)
+	return nil
+}
+
+func main() {
+	for _, name := range []string{"a", "b"} {
+		if err := process(name); err != nil {
+			fmt.Println(err)
+		}
+	}
+}
@@ -0,0 +1,17 @@
+package main
+
+import "fmt"
+
+func main() {
+	numbers := []int{1, 3, 5, 7, 9}
+
+search:
+	for _, a := range numbers {
+		for _, b := range numbers {
+			if a < b && a+b == 10 {
+				fmt.Println(a, b)
+				break search
+			}
+		}
+	}
+}
@@ -0,0 +1,10 @@
+package main
+
+import "fmt"
+
+func main() {
+	for n := 3; n > 0; n-- {
+		fmt.Println(n)
+	}
+	fmt.Println("liftoff")
+}
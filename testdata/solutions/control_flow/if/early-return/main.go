@@ -0,0 +1,19 @@
+package main
+
+import "fmt"
+
+func ticketPrice(age int) int {
+	if age < 5 {
+		return 0
+	}
+	if age >= 65 {
+		return 5
+	}
+	return 10
+}
+
+func main() {
+	fmt.Println(ticketPrice(3))
+	fmt.Println(ticketPrice(30))
+	fmt.Println(ticketPrice(70))
+}
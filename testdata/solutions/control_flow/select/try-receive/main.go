@@ -0,0 +1,20 @@
+package main
+
+import "fmt"
+
+func tryReceive(numbers chan int) (int, bool) {
+	select {
+	case n := <-numbers:
+		return n, true
+	default:
+		return 0, false
+	}
+}
+
+func main() {
+	numbers := make(chan int, 1)
+	numbers <- 42
+
+	fmt.Println(tryReceive(numbers))
+	fmt.Println(tryReceive(numbers))
+}
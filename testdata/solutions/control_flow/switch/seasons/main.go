@@ -0,0 +1,24 @@
+package main
+
+import "fmt"
+
+func season(month int) string {
+	switch month {
+	case 12, 1, 2:
+		return "winter"
+	case 3, 4, 5:
+		return "spring"
+	case 6, 7, 8:
+		return "summer"
+	case 9, 10, 11:
+		return "autumn"
+	default:
+		return "unknown"
+	}
+}
+
+func main() {
+	for _, month := range []int{1, 7, 10, 13} {
+		fmt.Println(season(month))
+	}
+}
@@ -0,0 +1,10 @@
+package main
+
+import "fmt"
+
+func main() {
+	age := 19
+	isAdult := age >= 18
+	isTeen := age < 20
+	fmt.Printf("%t %t\n", isAdult, isTeen)
+}
@@ -0,0 +1,11 @@
+package main
+
+import (
+	"fmt"
+	"math/cmplx"
+)
+
+func main() {
+	z := 3 + 4i
+	fmt.Println(cmplx.Abs(z), cmplx.Sqrt(-4))
+}
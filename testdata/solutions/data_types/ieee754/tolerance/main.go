@@ -0,0 +1,11 @@
+package main
+
+import (
+	"fmt"
+	"math"
+)
+
+func main() {
+	a, b := 0.1, 0.2
+	fmt.Println(math.Abs(a+b-0.3) < 1e-9)
+}
@@ -0,0 +1,17 @@
+package main
+
+import (
+	"fmt"
+	"unsafe"
+)
+
+type Order struct {
+	ID       int64
+	Quantity int32
+	Paid     bool
+	Shipped  bool
+}
+
+func main() {
+	fmt.Println(unsafe.Sizeof(Order{}))
+}
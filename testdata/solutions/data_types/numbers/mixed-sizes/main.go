@@ -0,0 +1,10 @@
+package main
+
+import "fmt"
+
+func main() {
+	var a int8 = 120
+	var b int16 = 16_000
+
+	fmt.Println(int16(a) + b)
+}
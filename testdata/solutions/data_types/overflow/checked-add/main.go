@@ -0,0 +1,19 @@
+package main
+
+import (
+	"fmt"
+	"math"
+)
+
+func addInt8(a, b int8) (int8, bool) {
+	if b > 0 && a > math.MaxInt8-b || b < 0 && a < math.MinInt8-b {
+		return a + b, false
+	}
+	return a + b, true
+}
+
+func main() {
+	fmt.Println(addInt8(100, 27))
+	fmt.Println(addInt8(100, 28))
+	fmt.Println(addInt8(-100, -29))
+}
@@ -0,0 +1,11 @@
+package main
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+func main() {
+	word := "héllo"
+	fmt.Println(len(word), utf8.RuneCountInString(word))
+}
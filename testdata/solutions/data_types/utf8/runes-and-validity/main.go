@@ -0,0 +1,12 @@
+package main
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+func main() {
+	word := "e\u0301"
+	data := "\xff"
+	fmt.Println(len(word), utf8.RuneCountInString(word), utf8.ValidString(data))
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+func parsePort(s string) (int, error) {
+	if s == "" {
+		return 0, errors.New("empty port")
+	}
+
+	port, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, err
+	}
+
+	if port > 65535 {
+		return 0, fmt.Errorf("port %d is out of range", port)
+	}
+
+	return port, nil
+}
+
+func main() {
+	for _, s := range []string{"8080", "", "http", "70000"} {
+		fmt.Println(parsePort(s))
+	}
+}
@@ -0,0 +1,26 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrNegative = errors.New("negative")
+
+func validate(name string, age int) error {
+	var errs []error
+	if name == "" {
+		errs = append(errs, errors.New("name is empty"))
+	}
+	if age < 0 {
+		errs = append(errs, fmt.Errorf("age %d: %w", age, ErrNegative))
+	}
+	return errors.Join(errs...)
+}
+
+func main() {
+	err := validate("", -1)
+	fmt.Println(err)
+	fmt.Println(errors.Is(err, ErrNegative))
+	fmt.Println(validate("Gopher", 16))
+}
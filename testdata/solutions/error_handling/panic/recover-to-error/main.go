@@ -0,0 +1,32 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+)
+
+func safeGet(items []string, i int) (item string, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			e, ok := r.(error)
+			if !ok {
+				e = fmt.Errorf("%v", r)
+			}
+			err = fmt.Errorf("safe get %d: %w", i, e)
+		}
+	}()
+
+	return items[i], nil
+}
+
+func main() {
+	items := []string{"a", "b"}
+	fmt.Println(safeGet(items, 1))
+
+	_, err := safeGet(items, 5)
+	fmt.Println(err)
+
+	var runtimeErr runtime.Error
+	fmt.Println(errors.As(err, &runtimeErr))
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var ErrEmptyName = errors.New("empty name")
+
+func parseName(s string) (string, error) {
+	name := strings.TrimSpace(s)
+	if name == "" {
+		return "", fmt.Errorf("parse name %q: %w", s, ErrEmptyName)
+	}
+	return name, nil
+}
+
+func main() {
+	for _, s := range []string{" Gopher ", "   "} {
+		name, err := parseName(s)
+		if err != nil {
+			fmt.Println("error:", err)
+			if errors.Is(err, ErrEmptyName) {
+				fmt.Println("please type a name")
+			}
+			continue
+		}
+		fmt.Println(name)
+	}
+}
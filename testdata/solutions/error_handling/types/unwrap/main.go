@@ -0,0 +1,36 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrTimeout = errors.New("timeout")
+
+type QueryError struct {
+	Query string
+	Err   error
+}
+
+func (e *QueryError) Error() string {
+	return e.Query + ": " + e.Err.Error()
+}
+
+func (e *QueryError) Unwrap() error {
+	return e.Err
+}
+
+func runQuery(query string) error {
+	return &QueryError{Query: query, Err: ErrTimeout}
+}
+
+func main() {
+	err := fmt.Errorf("load users: %w", runQuery("SELECT * FROM users"))
+	fmt.Println(err)
+	fmt.Println(errors.Is(err, ErrTimeout))
+
+	var queryErr *QueryError
+	if errors.As(err, &queryErr) {
+		fmt.Println(queryErr.Query)
+	}
+}
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrNotFound = errors.New("not found")
+
+var USERS = map[int]string{1: "gopher"}
+
+func findUser(id int) (string, error) {
+	name, ok := USERS[id]
+	if !ok {
+		return "", ErrNotFound
+	}
+	return name, nil
+}
+
+func greetUser(id int) (string, error) {
+	name, err := findUser(id)
+	if err != nil {
+		return "", fmt.Errorf("greet user %d: %w", id, err)
+	}
+	return "Hello, " + name, nil
+}
+
+func main() {
+	fmt.Println(greetUser(1))
+
+	_, err := greetUser(7)
+	fmt.Println(err)
+	fmt.Println(errors.Is(err, ErrNotFound))
+}
@@ -0,0 +1,11 @@
+package main
+
+import (
+	"log"
+	"os"
+)
+
+func main() {
+	logger := log.New(os.Stdout, "app: ", 0)
+	logger.Println("started")
+}
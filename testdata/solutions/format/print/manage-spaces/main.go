@@ -0,0 +1,9 @@
+package main
+
+import "fmt"
+
+func main() {
+	message1, message2 := "Hello, John Doe!", "Welcome to Old Trafford!"
+
+	fmt.Print(message1, " ", message2, "\n")
+}
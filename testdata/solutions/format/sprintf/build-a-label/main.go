@@ -0,0 +1,9 @@
+package main
+
+import "fmt"
+
+func main() {
+	name, age := "John Doe", 20
+	label := fmt.Sprintf("%s (%d)", name, age)
+	fmt.Println(label)
+}
@@ -0,0 +1,10 @@
+package main
+
+import "fmt"
+
+func main() {
+	x := 255
+	fmt.Printf("%b\n", x)
+	fmt.Printf("%#x\n", x)
+	fmt.Printf("|%6d|\n", x)
+}
@@ -0,0 +1,20 @@
+package main
+
+import "fmt"
+
+func accumulator(start int) func(int) int {
+	total := start
+	return func(n int) int {
+		total += n
+		return total
+	}
+}
+
+func main() {
+	a := accumulator(10)
+	b := accumulator(100)
+
+	first := a(5)
+	fmt.Println(first, a(3))
+	fmt.Println(b(0))
+}
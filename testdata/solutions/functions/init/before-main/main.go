@@ -0,0 +1,14 @@
+package main
+
+import "fmt"
+
+var greeting string
+
+func init() {
+	fmt.Println("setting up")
+	greeting = "Hello from init"
+}
+
+func main() {
+	fmt.Println(greeting)
+}
@@ -0,0 +1,18 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+)
+
+func divide(a, b int) (int, error) {
+	if b == 0 {
+		return 0, errors.New("division by zero")
+	}
+	return a / b, nil
+}
+
+func main() {
+	fmt.Println(divide(7, 2))
+	fmt.Println(divide(1, 0))
+}
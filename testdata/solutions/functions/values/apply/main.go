@@ -0,0 +1,21 @@
+package main
+
+import "fmt"
+
+func double(n int) int {
+	return n * 2
+}
+
+func apply(values []int, f func(int) int) []int {
+	result := make([]int, len(values))
+	for i, v := range values {
+		result[i] = f(v)
+	}
+	return result
+}
+
+func main() {
+	values := []int{1, 2, 3}
+	fmt.Println(apply(values, double))
+	fmt.Println(apply(values, func(n int) int { return n * n }))
+}
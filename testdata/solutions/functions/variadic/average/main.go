@@ -0,0 +1,22 @@
+package main
+
+import "fmt"
+
+func average(numbers ...float64) float64 {
+	if len(numbers) == 0 {
+		return 0
+	}
+	total := 0.0
+	for _, n := range numbers {
+		total += n
+	}
+	return total / float64(len(numbers))
+}
+
+func main() {
+	fmt.Println(average(1, 2, 3))
+	fmt.Println(average())
+
+	scores := []float64{1, 2, 3, 4}
+	fmt.Println(average(scores...))
+}
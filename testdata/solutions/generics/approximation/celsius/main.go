@@ -0,0 +1,22 @@
+package main
+
+import "fmt"
+
+type Number interface {
+	~int | ~float64
+}
+
+func Sum[T Number](values ...T) T {
+	var total T
+	for _, v := range values {
+		total += v
+	}
+	return total
+}
+
+type Celsius float64
+
+func main() {
+	fmt.Println(Sum(Celsius(20), Celsius(21.5)))
+	fmt.Println(Sum(1, 2, 3))
+}
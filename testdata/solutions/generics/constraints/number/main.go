@@ -0,0 +1,20 @@
+package main
+
+import "fmt"
+
+type Number interface {
+	int | int64 | float64
+}
+
+func Average[T Number](values ...T) float64 {
+	var total T
+	for _, v := range values {
+		total += v
+	}
+	return float64(total) / float64(len(values))
+}
+
+func main() {
+	fmt.Println(Average(1, 2, 3))
+	fmt.Println(Average(1.5, 3))
+}
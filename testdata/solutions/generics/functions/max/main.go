@@ -0,0 +1,19 @@
+package main
+
+import (
+	"cmp"
+	"fmt"
+)
+
+func Max[T cmp.Ordered](a, b T) T {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func main() {
+	fmt.Println(Max(3, 7))
+	fmt.Println(Max("apple", "pear"))
+	fmt.Println(Max(2.5, -1))
+}
@@ -0,0 +1,12 @@
+package main
+
+import "fmt"
+
+func Zero[T any]() T {
+	var zero T
+	return zero
+}
+
+func main() {
+	fmt.Printf("%v %q %v\n", Zero[int](), Zero[string](), Zero[bool]())
+}
@@ -0,0 +1,15 @@
+package main
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+)
+
+func main() {
+	population := map[string]int{"Jakarta": 10_679_951, "Bandung": 2_444_160, "Medan": 2_435_252}
+
+	cities := slices.Sorted(maps.Keys(population))
+
+	fmt.Println(cities)
+}
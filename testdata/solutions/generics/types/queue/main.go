@@ -0,0 +1,33 @@
+package main
+
+import "fmt"
+
+type Queue[T any] struct {
+	values []T
+}
+
+func (q *Queue[T]) Push(v T) {
+	q.values = append(q.values, v)
+}
+
+func (q *Queue[T]) Pop() (T, bool) {
+	var zero T
+	if len(q.values) == 0 {
+		return zero, false
+	}
+	v := q.values[0]
+	q.values = q.values[1:]
+	return v, true
+}
+
+func main() {
+	var words Queue[string]
+	words.Push("first")
+	words.Push("second")
+	fmt.Println(words.Pop())
+
+	var numbers Queue[int]
+	numbers.Push(1)
+	fmt.Println(numbers.Pop())
+	fmt.Println(numbers.Pop())
+}
@@ -0,0 +1,12 @@
+package main
+
+import "fmt"
+
+const (
+	PI     = 3.14
+	RADIUS = 10
+)
+
+func main() {
+	fmt.Println(PI * RADIUS * RADIUS)
+}
@@ -0,0 +1,9 @@
+package main
+
+import "fmt"
+
+var WelcomeMessage string = "Welcome!"
+
+func main() {
+	fmt.Println(WelcomeMessage)
+}
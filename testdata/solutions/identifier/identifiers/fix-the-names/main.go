@@ -0,0 +1,11 @@
+package main
+
+import "fmt"
+
+func main() {
+	var firstPlace string = "Gold"
+	var secondPlace string = "Silver"
+
+	fmt.Println(firstPlace)
+	fmt.Println(secondPlace)
+}
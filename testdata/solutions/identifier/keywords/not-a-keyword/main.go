@@ -0,0 +1,8 @@
+package main
+
+import "fmt"
+
+func main() {
+	var role string = "admin"
+	fmt.Println(role)
+}
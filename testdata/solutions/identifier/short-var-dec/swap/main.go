@@ -0,0 +1,9 @@
+package main
+
+import "fmt"
+
+func main() {
+	first, second := "left", "right"
+	first, second = second, first
+	fmt.Println(first, second)
+}
@@ -0,0 +1,11 @@
+package main
+
+import "fmt"
+
+func main() {
+	var name string = "Ayu"
+	var age int = 20
+	var isStudent = true
+
+	fmt.Println(name, age, isStudent)
+}
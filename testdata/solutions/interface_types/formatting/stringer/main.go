@@ -0,0 +1,15 @@
+package main
+
+import "fmt"
+
+type Color struct {
+	R, G, B uint8
+}
+
+func (c Color) String() string {
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
+
+func main() {
+	fmt.Println(Color{255, 128, 0}, Color{0, 255, 0})
+}
@@ -0,0 +1,26 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+func transform(v any) any {
+	switch v := v.(type) {
+	case int:
+		return v * 2
+	case string:
+		return strings.ToUpper(v)
+	case nil:
+		return "nil"
+	default:
+		return "other"
+	}
+}
+
+func main() {
+	fmt.Println(transform(21))
+	fmt.Println(transform("go"))
+	fmt.Println(transform(2.5))
+	fmt.Println(transform(nil))
+}
@@ -0,0 +1,28 @@
+package main
+
+import "fmt"
+
+type AgeError struct {
+	Age int
+}
+
+func (e *AgeError) Error() string {
+	return fmt.Sprintf("age %d is invalid", e.Age)
+}
+
+func validate(age int) error {
+	if age < 0 {
+		return &AgeError{Age: age}
+	}
+	return nil
+}
+
+func main() {
+	for _, age := range []int{20, -1} {
+		if err := validate(age); err != nil {
+			fmt.Println(err)
+		} else {
+			fmt.Println("ok")
+		}
+	}
+}
@@ -0,0 +1,24 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+)
+
+type Point struct {
+	X, Y int
+}
+
+//go:noinline
+func newPoint(x, y int) Point {
+	return Point{x, y}
+}
+
+func main() {
+	sum := 0
+	allocs := testing.AllocsPerRun(100, func() {
+		p := newPoint(1, 2)
+		sum = p.X + p.Y
+	})
+	fmt.Println(allocs, "allocs", sum)
+}
@@ -0,0 +1,20 @@
+package main
+
+import "fmt"
+
+type User struct {
+	Name string
+}
+
+func (u *User) DisplayName() string {
+	if u == nil {
+		return "guest"
+	}
+	return u.Name
+}
+
+func main() {
+	users := map[string]*User{"ana": {Name: "Ana"}}
+	fmt.Println(users["ana"].DisplayName())
+	fmt.Println(users["budi"].DisplayName())
+}
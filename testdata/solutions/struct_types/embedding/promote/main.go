@@ -0,0 +1,22 @@
+package main
+
+import "fmt"
+
+type Person struct {
+	Name string
+}
+
+func (p Person) Greet() string {
+	return "Hello, I am " + p.Name
+}
+
+type Employee struct {
+	Person
+	Title string
+}
+
+func main() {
+	e := Employee{Person: Person{Name: "Ana"}, Title: "Engineer"}
+	fmt.Println(e.Name)
+	fmt.Println(e.Greet())
+}
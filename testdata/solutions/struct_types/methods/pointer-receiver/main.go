@@ -0,0 +1,19 @@
+package main
+
+import "fmt"
+
+type Counter struct {
+	count int
+}
+
+func (c *Counter) Increment() {
+	c.count++
+}
+
+func main() {
+	var c Counter
+	c.Increment()
+	c.Increment()
+	c.Increment()
+	fmt.Println(c.count)
+}
@@ -0,0 +1,18 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type Book struct {
+	Title  string `json:"title"`
+	Author string `json:"author,omitempty"`
+	Pages  int    `json:"pages"`
+	ISBN   string `json:"-"`
+}
+
+func main() {
+	data, _ := json.Marshal(Book{Title: "Go", Pages: 300, ISBN: "123"})
+	fmt.Println(string(data))
+}